@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	domainapierrors "github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 )
 
 type APIError struct {
@@ -73,3 +75,8 @@ func (e APIError) errorPayload() *errorPayload {
 
 	return &payload
 }
+
+// FieldErrors returns the field-level validation errors of the api response, if any.
+func (e APIError) FieldErrors() []domainapierrors.FieldError {
+	return domainapierrors.ParseFieldErrors(e.bufferedBody)
+}
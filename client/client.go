@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 )
 
 type Client struct {
@@ -19,6 +21,7 @@ func New(token string, version string, host string) *Client {
 // NewQoveryAPIClient used for tests only
 func NewQoveryAPIClient(token string, version string, host string) *qovery.APIClient {
 	cfg := qovery.NewConfiguration()
+	cfg.HTTPClient = telemetry.NewHTTPClient(telemetry.SubsystemClient)
 	cfg.AddDefaultHeader("Authorization", fmt.Sprintf("Token %s", token))
 
 	cfg.UserAgent = fmt.Sprintf("Terraform provider %s", version)
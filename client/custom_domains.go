@@ -28,12 +28,3 @@ type CustomDomainUpdateRequest struct {
 type CustomDomainDeleteRequest struct {
 	Id string
 }
-
-func customDomainResponseListToArray(list *qovery.CustomDomainResponseList) []*qovery.CustomDomain {
-	vars := make([]*qovery.CustomDomain, 0, len(list.GetResults()))
-	for _, v := range list.GetResults() {
-		cpy := v
-		vars = append(vars, &cpy)
-	}
-	return vars
-}
@@ -0,0 +1,18 @@
+# Retrieve the default advanced settings of applications
+data "qovery_advanced_settings_defaults" "application" {
+  type = "application"
+}
+
+# Override some of the defaults, keeping only the overridden settings
+locals {
+  application_advanced_settings = {
+    for key, value in merge(data.qovery_advanced_settings_defaults.application.advanced_settings, {
+      "network.ingress.proxy_body_size_mb" = 200
+    }) : key => value if value != data.qovery_advanced_settings_defaults.application.advanced_settings[key]
+  }
+}
+
+resource "qovery_application" "example" {
+  # ...
+  advanced_settings_json = jsonencode(local.application_advanced_settings)
+}
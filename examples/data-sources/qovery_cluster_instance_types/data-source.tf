@@ -0,0 +1,14 @@
+# List the ARM64 instance types with at least 8 GB of memory in eu-west-3
+data "qovery_cluster_instance_types" "arm64" {
+  cloud_provider = "AWS"
+  region         = "eu-west-3"
+  architecture   = "ARM64"
+  min_ram_in_gb  = 8
+}
+
+resource "qovery_cluster" "example" {
+  # ...
+  cloud_provider = "AWS"
+  region         = "eu-west-3"
+  instance_type  = data.qovery_cluster_instance_types.arm64.instance_types[0].type
+}
@@ -0,0 +1,10 @@
+data "qovery_cluster_kubernetes_versions" "aws" {
+  cloud_provider = "AWS"
+}
+
+resource "qovery_cluster" "example" {
+  # ...
+  cloud_provider = "AWS"
+  # Existing clusters are upgraded one minor version at a time.
+  kubernetes_version = data.qovery_cluster_kubernetes_versions.aws.latest_version
+}
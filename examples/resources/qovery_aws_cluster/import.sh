@@ -0,0 +1 @@
+terraform import qovery_aws_cluster.my_cluster "<organization_id>,<cluster_id>"
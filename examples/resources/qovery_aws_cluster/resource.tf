@@ -0,0 +1,81 @@
+resource "qovery_aws_credentials" "aws_creds" {
+  organization_id   = qovery_organization.my_organization.id
+  name              = "My AWS credentials"
+  access_key_id     = var.access_key_id
+  secret_access_key = var.secret_access_key
+}
+
+resource "qovery_aws_cluster" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  credentials_id  = qovery_aws_credentials.aws_creds.id
+  name            = "my-aws-cluster"
+  region          = "us-east-2"
+
+  # Optional
+  description        = "My AWS cluster"
+  kubernetes_version = "1.33"
+
+  features = {
+    vpc_subnet = "10.0.0.0/16"
+    static_ip  = true
+    karpenter = {
+      spot_enabled                 = true
+      disk_size_in_gib             = 50
+      default_service_architecture = "AMD64"
+      qovery_node_pools = {
+        requirements = [
+          {
+            key      = "InstanceFamily"
+            operator = "In"
+            values   = ["c6i", "m6i", "r6i", "t3a"]
+          },
+          {
+            key      = "InstanceSize"
+            operator = "In"
+            values   = ["large", "xlarge", "2xlarge"]
+          },
+          {
+            key      = "Arch"
+            operator = "In"
+            values   = ["AMD64"]
+          }
+        ]
+        # GPU node pool for machine learning jobs
+        gpu_override = {
+          requirements = [
+            {
+              key      = "InstanceFamily"
+              operator = "In"
+              values   = ["g5", "g6"]
+            }
+          ]
+          limits = {
+            enabled                 = true
+            max_cpu_in_vcpu         = 64
+            max_memory_in_gibibytes = 256
+            max_gpu                 = 8
+          }
+        }
+      }
+    }
+  }
+
+  keda = {
+    enabled = true
+  }
+
+  labels_group_ids = [qovery_labels_group.cluster_labels.id]
+
+  advanced_settings_json = jsonencode({
+    "aws.vpc.flow_logs_retention_days" : 100,
+  })
+
+  state = "DEPLOYED"
+}
+
+# Migrate an existing qovery_cluster without recreating it (Terraform 1.8 or later).
+moved {
+  from = qovery_cluster.my_cluster
+  to   = qovery_aws_cluster.my_cluster
+}
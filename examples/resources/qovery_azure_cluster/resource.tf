@@ -0,0 +1,27 @@
+# Azure credentials must be created via the Qovery console (provisioning requires server-side scripts).
+data "qovery_azure_credentials" "azure_creds" {
+  id              = var.azure_credentials_id
+  organization_id = qovery_organization.my_organization.id
+}
+
+resource "qovery_azure_cluster" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  credentials_id  = data.qovery_azure_credentials.azure_creds.id
+  name            = "my-azure-cluster"
+  region          = "westeurope"
+
+  # Optional
+  description       = "My AKS cluster"
+  instance_type     = "Standard_B2s_v2"
+  min_running_nodes = 3
+  max_running_nodes = 10
+
+  state = "DEPLOYED"
+}
+
+# Migrate an existing qovery_cluster without recreating it (Terraform 1.8 or later).
+moved {
+  from = qovery_cluster.my_cluster
+  to   = qovery_azure_cluster.my_cluster
+}
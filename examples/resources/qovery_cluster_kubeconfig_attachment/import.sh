@@ -0,0 +1 @@
+terraform import qovery_cluster_kubeconfig_attachment.my_cluster "<organization_id>,<cluster_id>"
@@ -0,0 +1,8 @@
+resource "qovery_cluster_kubeconfig_attachment" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  cluster_id      = var.self_managed_cluster_id
+
+  # Write-only (Terraform 1.11 or later): the kubeconfig is never stored in the Terraform state.
+  kubeconfig_wo = file("${path.module}/kubeconfig.yaml")
+}
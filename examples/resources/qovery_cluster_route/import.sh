@@ -0,0 +1 @@
+terraform import qovery_cluster_route.shared_services "<organization_id>,<cluster_id>,<destination>"
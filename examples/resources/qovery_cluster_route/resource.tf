@@ -0,0 +1,8 @@
+resource "qovery_cluster_route" "shared_services" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  cluster_id      = qovery_cluster.my_cluster.id
+  description     = "VPC peering with the shared services VPC"
+  destination     = "10.1.0.0/16"
+  target          = "pcx-0a1b2c3d4e5f6a7b8"
+}
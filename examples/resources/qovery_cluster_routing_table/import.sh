@@ -0,0 +1 @@
+terraform import qovery_cluster_routing_table.my_cluster "<organization_id>,<cluster_id>"
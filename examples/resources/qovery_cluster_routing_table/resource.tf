@@ -0,0 +1,18 @@
+resource "qovery_cluster_routing_table" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  cluster_id      = qovery_cluster.my_cluster.id
+
+  routes = [
+    {
+      description = "VPC peering with the shared services VPC"
+      destination = "10.1.0.0/16"
+      target      = "pcx-0a1b2c3d4e5f6a7b8"
+    },
+    {
+      description = "On-premise network"
+      destination = "192.168.0.0/20"
+      target      = "tgw-0a1b2c3d4e5f6a7b8"
+    }
+  ]
+}
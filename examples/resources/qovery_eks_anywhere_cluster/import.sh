@@ -0,0 +1 @@
+terraform import qovery_eks_anywhere_cluster.my_cluster "<organization_id>,<cluster_id>"
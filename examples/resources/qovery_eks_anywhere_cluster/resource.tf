@@ -0,0 +1,41 @@
+resource "qovery_aws_credentials" "eks_anywhere_creds" {
+  organization_id   = qovery_organization.my_organization.id
+  name              = "My EKS Anywhere credentials"
+  access_key_id     = var.access_key_id
+  secret_access_key = var.secret_access_key
+}
+
+resource "qovery_eks_anywhere_cluster" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  credentials_id  = qovery_aws_credentials.eks_anywhere_creds.id
+  name            = "my-eks-anywhere-cluster"
+  kubeconfig      = file("${path.module}/kubeconfig.yaml")
+
+  infrastructure_charts_parameters = {
+    nginx_parameters = {
+      replica_count                             = 2
+      default_ssl_certificate                   = "qovery/letsencrypt-acme-qovery-cert"
+      publish_status_address                    = "192.168.1.100"
+      annotation_metal_lb_load_balancer_ips     = "192.168.1.100"
+      annotation_external_dns_kubernetes_target = "192.168.1.100"
+    }
+    cert_manager_parameters = {
+      kubernetes_namespace = "qovery"
+    }
+    metal_lb_parameters = {
+      ip_address_pools = ["192.168.1.100-192.168.1.110"]
+    }
+  }
+
+  # Optional
+  description = "My EKS Anywhere cluster"
+
+  state = "DEPLOYED"
+}
+
+# Migrate an existing qovery_cluster without recreating it (Terraform 1.8 or later).
+moved {
+  from = qovery_cluster.my_cluster
+  to   = qovery_eks_anywhere_cluster.my_cluster
+}
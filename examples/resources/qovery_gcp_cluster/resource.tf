@@ -0,0 +1,37 @@
+resource "qovery_gcp_credentials" "gcp_creds" {
+  organization_id = qovery_organization.my_organization.id
+  name            = "My GCP credentials"
+  gcp_credentials = file("${path.module}/service-account.json")
+}
+
+resource "qovery_gcp_cluster" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  credentials_id  = qovery_gcp_credentials.gcp_creds.id
+  name            = "my-gcp-cluster"
+  region          = "europe-west1"
+
+  # Optional
+  description       = "My GKE Autopilot cluster"
+  instance_type     = "AUTO_PILOT"
+  min_running_nodes = 3
+  max_running_nodes = 200
+
+  features = {
+    gcp_existing_vpc = {
+      vpc_name               = "my-existing-vpc"
+      vpc_project_id         = "my-gcp-project-id"
+      subnetwork_name        = "my-subnetwork"
+      ip_range_services_name = "gke-services"
+      ip_range_pods_name     = "gke-pods"
+    }
+  }
+
+  state = "DEPLOYED"
+}
+
+# Migrate an existing qovery_cluster without recreating it (Terraform 1.8 or later).
+moved {
+  from = qovery_cluster.my_cluster
+  to   = qovery_gcp_cluster.my_cluster
+}
@@ -0,0 +1 @@
+terraform import qovery_scaleway_cluster.my_cluster "<organization_id>,<cluster_id>"
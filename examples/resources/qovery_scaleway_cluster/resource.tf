@@ -0,0 +1,34 @@
+resource "qovery_scaleway_credentials" "scw_creds" {
+  organization_id          = qovery_organization.my_organization.id
+  name                     = "My Scaleway credentials"
+  scaleway_access_key      = var.scaleway_access_key
+  scaleway_secret_key      = var.scaleway_secret_key
+  scaleway_project_id      = var.scaleway_project_id
+  scaleway_organization_id = var.scaleway_organization_id
+}
+
+resource "qovery_scaleway_cluster" "my_cluster" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  credentials_id  = qovery_scaleway_credentials.scw_creds.id
+  name            = "my-scaleway-cluster"
+  region          = "fr-par-2"
+
+  # Optional
+  description       = "My Kapsule cluster"
+  instance_type     = "DEV1-L"
+  min_running_nodes = 3
+  max_running_nodes = 10
+
+  features = {
+    static_ip = true
+  }
+
+  state = "DEPLOYED"
+}
+
+# Migrate an existing qovery_cluster without recreating it (Terraform 1.8 or later).
+moved {
+  from = qovery_cluster.my_cluster
+  to   = qovery_scaleway_cluster.my_cluster
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure applicationService defined types fully satisfy the application.Service interface.
+var _ application.Service = applicationService{}
+
+// applicationService implements the interface application.Service.
+type applicationService struct {
+	applicationRepository        application.Repository
+	applicationDeploymentService deployment.Service
+	variableService              variable.Service
+	secretService                secret.Service
+	deploymentRestrictionService deploymentrestriction.DeploymentRestrictionService
+	externalSecretRepository     variable.ExternalSecretRepository
+	externalSecretFileRepository variable.ExternalSecretFileRepository
+}
+
+// NewApplicationService return a new instance of an application.Service that uses the given application.Repository.
+func NewApplicationService(
+	applicationRepository application.Repository,
+	applicationDeploymentService deployment.Service,
+	variableService variable.Service,
+	secretService secret.Service,
+	deploymentRestrictionService deploymentrestriction.DeploymentRestrictionService,
+	externalSecretRepository variable.ExternalSecretRepository,
+	externalSecretFileRepository variable.ExternalSecretFileRepository,
+) (application.Service, error) {
+	if applicationRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if applicationDeploymentService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if variableService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if secretService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if externalSecretRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if externalSecretFileRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &applicationService{
+		applicationRepository:        applicationRepository,
+		applicationDeploymentService: applicationDeploymentService,
+		variableService:              variableService,
+		secretService:                secretService,
+		deploymentRestrictionService: deploymentRestrictionService,
+		externalSecretRepository:     externalSecretRepository,
+		externalSecretFileRepository: externalSecretFileRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create an application.
+func (s applicationService) Create(ctx context.Context, environmentID string, request application.UpsertServiceRequest) (*application.Application, error) {
+	if err := s.checkEnvironmentID(environmentID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Create(ctx, environmentID, request.ApplicationUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	if err := s.updateApplicationResources(ctx, app.ID.String(), request); err != nil {
+		return nil, partiallyCreated(ctx, app, errors.Wrap(err, application.ErrFailedToCreateApplication.Error()), s.refreshApplication)
+	}
+
+	refreshed, err := s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, common.NewPartialCreateError(app, errors.Wrap(err, application.ErrFailedToCreateApplication.Error()))
+	}
+
+	return refreshed, nil
+}
+
+// Get handles the domain logic to retrieve an application.
+func (s applicationService) Get(ctx context.Context, applicationID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*application.Application, error) {
+	if err := s.checkID(applicationID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Get(ctx, applicationID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	app, err = s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	return app, nil
+}
+
+// Update handles the domain logic to update an application.
+func (s applicationService) Update(ctx context.Context, applicationID string, request application.UpsertServiceRequest) (*application.Application, error) {
+	if err := s.checkID(applicationID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Update(ctx, applicationID, request.ApplicationUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	if err := s.updateApplicationResources(ctx, app.ID.String(), request); err != nil {
+		return nil, partialUpdateError(ctx, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error()), func(ctx context.Context) (*application.Application, error) {
+			return s.refreshApplication(ctx, *app)
+		})
+	}
+
+	app, err = s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	return app, nil
+}
+
+// Delete handles the domain logic to delete an application.
+func (s applicationService) Delete(ctx context.Context, applicationID string) error {
+	if err := s.checkID(applicationID); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	if err := s.applicationRepository.Delete(ctx, applicationID); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	if err := wait(ctx, waitNotFoundFunc(s.applicationDeploymentService, applicationID)); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	return nil
+}
+
+// updateApplicationResources applies the variables, secrets and deployment restrictions diffs of the request to the given application.
+func (s applicationService) updateApplicationResources(ctx context.Context, applicationID string, request application.UpsertServiceRequest) error {
+	overridesAuthorizedScopes := make(map[variable.Scope]struct{})
+	overridesAuthorizedScopes[variable.ScopeProject] = struct{}{}
+	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
+	if _, err := s.variableService.Update(ctx, applicationID, request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes); err != nil {
+		return err
+	}
+
+	if _, err := s.secretService.Update(ctx, applicationID, request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes); err != nil {
+		return err
+	}
+
+	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, applicationID, request.ExternalSecrets); err != nil {
+		return err
+	}
+
+	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, applicationID, request.ExternalSecretFiles); err != nil {
+		return err
+	}
+
+	if request.DeploymentRestrictionsDiff.IsNotEmpty() {
+		if apiErr := s.deploymentRestrictionService.UpdateServiceDeploymentRestrictions(ctx, applicationID, domain.APPLICATION, request.DeploymentRestrictionsDiff); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return nil
+}
+
+func (s applicationService) refreshApplication(ctx context.Context, app application.Application) (*application.Application, error) {
+	var envVars variable.Variables
+	var secrets secret.Secrets
+	var externalSecrets variable.ExternalSecrets
+	var externalSecretFiles variable.ExternalSecretFiles
+	var deploymentRestrictions []deploymentrestriction.ServiceDeploymentRestriction
+	err := fetchConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			envVars, err = s.variableService.List(ctx, app.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			secrets, err = s.secretService.List(ctx, app.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecrets, err = s.externalSecretRepository.List(ctx, app.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecretFiles, err = s.externalSecretFileRepository.List(ctx, app.ID.String())
+			return err
+		},
+		func(ctx context.Context) error {
+			var apiErr *apierrors.APIError
+			deploymentRestrictions, apiErr = s.deploymentRestrictionService.GetServiceDeploymentRestrictions(ctx, app.ID.String(), domain.APPLICATION)
+			if apiErr != nil {
+				return apiErr
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := app.SetEnvironmentVariables(envVars); err != nil {
+		return nil, err
+	}
+
+	if err := app.SetSecrets(secrets); err != nil {
+		return nil, err
+	}
+
+	app.SetExternalSecrets(externalSecrets)
+	app.SetExternalSecretFiles(externalSecretFiles)
+	app.SetDeploymentRestrictions(deploymentRestrictions)
+
+	return &app, nil
+}
+
+// checkEnvironmentID validates that the given environmentID is valid.
+func (s applicationService) checkEnvironmentID(environmentID string) error {
+	if environmentID == "" {
+		return application.ErrInvalidEnvironmentIDParam
+	}
+
+	if _, err := uuid.Parse(environmentID); err != nil {
+		return errors.Wrap(err, application.ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkID validates that the given applicationID is valid.
+func (s applicationService) checkID(applicationID string) error {
+	if applicationID == "" {
+		return application.ErrInvalidApplicationIDParam
+	}
+
+	if _, err := uuid.Parse(applicationID); err != nil {
+		return errors.Wrap(err, application.ErrInvalidApplicationIDParam.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// maxConcurrentDiffOperations bounds the number of api calls run concurrently when applying a variable or secret diff.
+const maxConcurrentDiffOperations = 8
+
+// diffOperation is the change of a single key of a variable or secret diff.
+// apply returns the resulting item, or nil for a deletion.
+type diffOperation[T any] struct {
+	key   string
+	apply func(ctx context.Context) (*T, error)
+}
+
+// applyDiffConcurrently runs the given operations concurrently, at most maxConcurrentDiffOperations at a time.
+// Unlike fetchConcurrently, a failing operation does not cancel the others: every operation is attempted so that
+// report tells exactly which keys were applied and which failed.
+// The items returned by the successful operations are returned in the order of the operations.
+// The applied keys are reported with the given kind.
+func applyDiffConcurrently[T any](ctx context.Context, kind variable.DiffKind, operations []diffOperation[T], report *variable.DiffApplyError) []T {
+	results := make([]*T, len(operations))
+	errs := make([]error, len(operations))
+
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentDiffOperations)
+	for i, operation := range operations {
+		g.Go(func() error {
+			results[i], errs[i] = operation.apply(ctx)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	items := make([]T, 0, len(operations))
+	for i, operation := range operations {
+		if errs[i] != nil {
+			report.Failed = append(report.Failed, variable.DiffApplyFailure{Key: operation.key, Err: errs[i]})
+			continue
+		}
+
+		report.Applied = append(report.Applied, variable.DiffAppliedKey{Kind: kind, Key: operation.key})
+		if results[i] != nil {
+			items = append(items, *results[i])
+		}
+	}
+
+	return items
+}
+
+// applyDiffPhases applies the given phases one after the other, the operations of a phase being applied concurrently.
+// Deletions must come first so that a key can be deleted and created again within the same diff.
+// It stops at the first phase having a failure, the later phases being left unapplied: the items applied so far are
+// then returned along with report, so that the caller can persist them.
+func applyDiffPhases[T any](ctx context.Context, kind variable.DiffKind, report *variable.DiffApplyError, phases ...[]diffOperation[T]) ([]T, error) {
+	var items []T
+	for _, phase := range phases {
+		items = append(items, applyDiffConcurrently(ctx, kind, phase, report)...)
+		if report.HasFailures() {
+			return items, report
+		}
+	}
+
+	return items, nil
+}
+
+// partialUpdateError returns err as a *common.PartialUpdateError holding the service refreshed from the API when err
+// reports a variable or secret diff that was only partially applied: the service has changed, and the caller must keep
+// track of the changes applied before the failure. Otherwise, or if the refresh fails, it returns err as is.
+func partialUpdateError[T any](ctx context.Context, err error, refresh func(ctx context.Context) (*T, error)) error {
+	var report *variable.DiffApplyError
+	if !errors.As(err, &report) {
+		return err
+	}
+
+	refreshed, refreshErr := refresh(ctx)
+	if refreshErr != nil {
+		return err
+	}
+
+	return common.NewPartialUpdateError(refreshed, err)
+}
+
+// deletedKey returns the key reported for a deletion, falling back to the id when the key is unknown.
+func deletedKey(key string, id string) string {
+	if key == "" {
+		return id
+	}
+
+	return key
+}
+
+// ignoreNotFound turns the error of the deletion of an alias or override into nil if the variable was not found:
+// the variable it targets at a higher scope may have been deleted beforehand, deleting the alias or override along with it.
+func ignoreNotFound(err *apierrors.APIError) error {
+	if err == nil || (err.Resp != nil && err.Resp.StatusCode == 404) {
+		return nil
+	}
+
+	return err
+}
+
+// deleteError turns the *apierrors.APIError of a deletion into an error, keeping nil untyped.
+func deleteError(err *apierrors.APIError) error {
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
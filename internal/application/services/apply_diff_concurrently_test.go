@@ -0,0 +1,99 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+func TestApplyDiffPhases(t *testing.T) {
+	t.Parallel()
+
+	newOperation := func(key string, err error) diffOperation[string] {
+		return diffOperation[string]{
+			key: key,
+			apply: func(ctx context.Context) (*string, error) {
+				if err != nil {
+					return nil, err
+				}
+				return &key, nil
+			},
+		}
+	}
+
+	t.Run("returns_the_items_of_every_phase", func(t *testing.T) {
+		t.Parallel()
+
+		report := &variable.DiffApplyError{}
+		items, err := applyDiffPhases(context.Background(), variable.DiffKindValue, report,
+			[]diffOperation[string]{newOperation("UPDATED", nil)},
+			[]diffOperation[string]{newOperation("CREATED", nil)},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"UPDATED", "CREATED"}, items)
+	})
+
+	t.Run("returns_the_items_applied_before_a_failure", func(t *testing.T) {
+		t.Parallel()
+
+		boom := errors.New("boom")
+		report := &variable.DiffApplyError{}
+		items, err := applyDiffPhases(context.Background(), variable.DiffKindAlias, report,
+			[]diffOperation[string]{newOperation("UPDATED", nil)},
+			[]diffOperation[string]{newOperation("CREATED", nil), newOperation("FAILING", boom)},
+			[]diffOperation[string]{newOperation("NOT_APPLIED", nil)},
+		)
+		assert.Equal(t, []string{"UPDATED", "CREATED"}, items)
+		assert.Same(t, report, err)
+		assert.Equal(t, []variable.DiffAppliedKey{{Kind: variable.DiffKindAlias, Key: "UPDATED"}, {Kind: variable.DiffKindAlias, Key: "CREATED"}}, report.Applied)
+	})
+}
+
+func TestPartialUpdateError(t *testing.T) {
+	t.Parallel()
+
+	refresh := func(ctx context.Context) (*string, error) {
+		return new("refreshed"), nil
+	}
+
+	t.Run("partially_applied_diff_holds_the_refreshed_service", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.Wrap(&variable.DiffApplyError{Applied: []variable.DiffAppliedKey{{Kind: variable.DiffKindValue, Key: "KEY"}}, Failed: []variable.DiffApplyFailure{{Key: "OTHER", Err: errors.New("boom")}}}, "failed to update")
+		result := partialUpdateError(context.Background(), err, refresh)
+
+		var partial *common.PartialUpdateError[string]
+		require.ErrorAs(t, result, &partial)
+		assert.Equal(t, "refreshed", *partial.Resource)
+		assert.Same(t, err, partial.Err)
+	})
+
+	t.Run("other_error_is_returned_as_is", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("invalid request")
+		result := partialUpdateError(context.Background(), err, func(ctx context.Context) (*string, error) {
+			panic("the service must not be refreshed")
+		})
+		assert.Same(t, err, result)
+	})
+
+	t.Run("failed_refresh_returns_the_update_error", func(t *testing.T) {
+		t.Parallel()
+
+		err := &variable.DiffApplyError{Failed: []variable.DiffApplyFailure{{Key: "KEY", Err: errors.New("boom")}}}
+		result := partialUpdateError(context.Background(), err, func(ctx context.Context) (*string, error) {
+			return nil, errors.New("api unreachable")
+		})
+		assert.Same(t, err, result)
+	})
+}
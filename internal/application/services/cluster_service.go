@@ -0,0 +1,551 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/polling"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
+)
+
+// clusterWaitTimeout is longer than defaultWaitTimeout as a cluster deployment can take more than an hour.
+const clusterWaitTimeout = 4 * time.Hour
+
+// Ensure clusterService defined types fully satisfy the cluster.Service interface.
+var _ cluster.Service = clusterService{}
+
+// clusterService implements the interface cluster.Service.
+type clusterService struct {
+	clusterRepository cluster.Repository
+	// routingTableLocks holds a *sync.Mutex per cluster id, so that the routes of a cluster are not edited concurrently.
+	routingTableLocks *sync.Map
+}
+
+// NewClusterService return a new instance of a cluster.Service that uses the given cluster.Repository.
+func NewClusterService(clusterRepository cluster.Repository) (cluster.Service, error) {
+	if clusterRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &clusterService{
+		clusterRepository: clusterRepository,
+		routingTableLocks: &sync.Map{},
+	}, nil
+}
+
+// Create handles the domain logic to create a cluster and bring it to its desired state.
+func (s clusterService) Create(ctx context.Context, organizationID string, request cluster.UpsertServiceRequest) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	if err := request.ValidateCreation(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	c, err := s.clusterRepository.Create(ctx, organizationID, request.ClusterUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	refresh := func(ctx context.Context, c cluster.Cluster) (*cluster.Cluster, error) {
+		return s.clusterRepository.Get(ctx, organizationID, c.ID.String(), c.AdvancedSettingsJson, false)
+	}
+
+	if request.ClusterUpsertRequest.IsPartiallyManaged() {
+		if err := s.clusterRepository.SetKubeconfig(ctx, organizationID, c.ID.String(), *request.Kubeconfig); err != nil {
+			return nil, partiallyCreated(ctx, c, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error()), refresh)
+		}
+	}
+
+	updated, err := s.updateState(ctx, c, request.DesiredState, request.ForceUpdate)
+	if err != nil {
+		return nil, partiallyCreated(ctx, c, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error()), refresh)
+	}
+
+	updated, err = s.upgradeKubernetesVersion(ctx, updated, request.KubernetesVersion, request.DesiredState)
+	if err != nil {
+		return nil, partiallyCreated(ctx, c, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error()), refresh)
+	}
+
+	return updated, nil
+}
+
+// Get handles the domain logic to retrieve a cluster.
+func (s clusterService) Get(ctx context.Context, organizationID string, clusterID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	c, err := s.clusterRepository.Get(ctx, organizationID, clusterID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	return c, nil
+}
+
+// Update handles the domain logic to update a cluster and bring it to its desired state.
+func (s clusterService) Update(ctx context.Context, organizationID string, clusterID string, request cluster.UpsertServiceRequest) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if request.ClusterUpsertRequest.HasKarpenter() {
+		current, err := s.clusterRepository.Get(ctx, organizationID, clusterID, request.ClusterUpsertRequest.AdvancedSettingsJson, false)
+		if err != nil {
+			return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+		}
+		if !current.HasKarpenter() {
+			return nil, errors.Wrap(cluster.ErrKarpenterMigrationNotSupported, cluster.ErrFailedToUpdateCluster.Error())
+		}
+	}
+
+	c, err := s.clusterRepository.Update(ctx, organizationID, clusterID, request.ClusterUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if request.ClusterUpsertRequest.IsPartiallyManaged() {
+		// Only push the kubeconfig when it differs from the one Qovery knows about.
+		currentKubeconfig, err := s.clusterRepository.GetKubeconfig(ctx, organizationID, clusterID)
+		if err != nil || currentKubeconfig != *request.Kubeconfig {
+			if err := s.clusterRepository.SetKubeconfig(ctx, organizationID, clusterID, *request.Kubeconfig); err != nil {
+				return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+			}
+		}
+	}
+
+	c, err = s.updateState(ctx, c, request.DesiredState, request.ForceUpdate)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	c, err = s.upgradeKubernetesVersion(ctx, c, request.KubernetesVersion, request.DesiredState)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	return c, nil
+}
+
+// Delete handles the domain logic to delete a cluster.
+func (s clusterService) Delete(ctx context.Context, organizationID string, clusterID string) error {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	// Wait until no operation is running on the cluster, otherwise the deletion would be refused.
+	if err := s.wait(ctx, s.waitFinalStateFunc(organizationID, clusterID)); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := s.clusterRepository.Delete(ctx, organizationID, clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := s.wait(ctx, s.waitStateFunc(organizationID, clusterID, cluster.StateDeleted)); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	return nil
+}
+
+// GetKubeconfig handles the domain logic to retrieve the kubeconfig of a cluster.
+func (s clusterService) GetKubeconfig(ctx context.Context, organizationID string, clusterID string) (string, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return "", errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return "", errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	kubeconfig, err := s.clusterRepository.GetKubeconfig(ctx, organizationID, clusterID)
+	if err != nil {
+		return "", errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	return kubeconfig, nil
+}
+
+// SetKubeconfig handles the domain logic to validate and set the kubeconfig of a cluster.
+func (s clusterService) SetKubeconfig(ctx context.Context, organizationID string, clusterID string, kubeconfig string) error {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := cluster.ValidateKubeconfig(kubeconfig); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := s.clusterRepository.SetKubeconfig(ctx, organizationID, clusterID, kubeconfig); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	return nil
+}
+
+// GetRoutingTable handles the domain logic to retrieve the routing table of a cluster.
+func (s clusterService) GetRoutingTable(ctx context.Context, organizationID string, clusterID string) (cluster.RoutingTable, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetRoutingTable.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetRoutingTable.Error())
+	}
+
+	routingTable, err := s.clusterRepository.GetRoutingTable(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetRoutingTable.Error())
+	}
+
+	return routingTable, nil
+}
+
+// EditRoutingTable handles the domain logic to replace the whole routing table of a cluster.
+func (s clusterService) EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable cluster.RoutingTable) (cluster.RoutingTable, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	if err := s.checkID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	if err := routingTable.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	defer s.lockRoutingTable(clusterID)()
+
+	edited, err := s.clusterRepository.EditRoutingTable(ctx, organizationID, clusterID, routingTable)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	return edited, nil
+}
+
+// GetRoute handles the domain logic to retrieve the route of a cluster for the given destination.
+func (s clusterService) GetRoute(ctx context.Context, organizationID string, clusterID string, destination string) (*cluster.Route, error) {
+	routingTable, err := s.GetRoutingTable(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := routingTable.Find(destination)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetRoutingTable.Error())
+	}
+
+	return route, nil
+}
+
+// AddRoute handles the domain logic to add a route to the routing table of a cluster, keeping its other routes.
+func (s clusterService) AddRoute(ctx context.Context, organizationID string, clusterID string, route cluster.Route) (*cluster.Route, error) {
+	return s.upsertRoute(ctx, organizationID, clusterID, route, false)
+}
+
+// UpdateRoute handles the domain logic to update the route of a cluster with the destination of the given route, keeping its other routes.
+func (s clusterService) UpdateRoute(ctx context.Context, organizationID string, clusterID string, route cluster.Route) (*cluster.Route, error) {
+	return s.upsertRoute(ctx, organizationID, clusterID, route, true)
+}
+
+// DeleteRoute handles the domain logic to remove the route of a cluster for the given destination, keeping its other routes.
+func (s clusterService) DeleteRoute(ctx context.Context, organizationID string, clusterID string, destination string) error {
+	defer s.lockRoutingTable(clusterID)()
+
+	routingTable, err := s.GetRoutingTable(ctx, organizationID, clusterID)
+	if err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	// The route has already been removed.
+	if _, err := routingTable.Find(destination); err != nil {
+		return nil
+	}
+
+	if _, err := s.clusterRepository.EditRoutingTable(ctx, organizationID, clusterID, routingTable.WithoutRoute(destination)); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	return nil
+}
+
+// upsertRoute adds the given route to the routing table of a cluster, or replaces the existing route for its destination when update is set.
+// The whole routing table is validated, so that the route is refused if its destination is the one of another route.
+func (s clusterService) upsertRoute(ctx context.Context, organizationID string, clusterID string, route cluster.Route, update bool) (*cluster.Route, error) {
+	if err := route.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	defer s.lockRoutingTable(clusterID)()
+
+	routingTable, err := s.GetRoutingTable(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	_, err = routingTable.Find(route.Destination)
+	if update && err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+	if !update && err == nil {
+		return nil, errors.Wrap(errors.Wrapf(cluster.ErrRouteAlreadyExists, "destination %s", route.Destination), cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	routingTable = routingTable.WithRoute(route)
+	if err := routingTable.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	edited, err := s.clusterRepository.EditRoutingTable(ctx, organizationID, clusterID, routingTable)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateRoutingTable.Error())
+	}
+
+	return edited.Find(route.Destination)
+}
+
+// lockRoutingTable locks the routing table of the given cluster and returns the function to unlock it.
+func (s clusterService) lockRoutingTable(clusterID string) func() {
+	lock, _ := s.routingTableLocks.LoadOrStore(clusterID, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// updateState deploys or stops the cluster when its current state differs from the desired one.
+// A DEPLOYED cluster is redeployed when forceUpdate is set, so that changes only applied on deploy are taken into account.
+func (s clusterService) updateState(ctx context.Context, c *cluster.Cluster, desiredState cluster.State, forceUpdate bool) (*cluster.Cluster, error) {
+	organizationID := c.OrganizationID.String()
+	clusterID := c.ID.String()
+
+	// Wait until no operation is running on the cluster, otherwise a deploy or a stop would be refused.
+	if err := s.wait(ctx, s.waitFinalStateFunc(organizationID, clusterID)); err != nil {
+		return nil, err
+	}
+
+	state, err := s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if *state != desiredState || (*state == cluster.StateDeployed && forceUpdate) {
+		switch desiredState {
+		case cluster.StateDeployed:
+			state, err = s.deploy(ctx, organizationID, clusterID)
+		case cluster.StateStopped:
+			state, err = s.stop(ctx, organizationID, clusterID, *state)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.State = state
+
+	// The infrastructure outputs may have changed with the deployment.
+	if refreshed, err := s.clusterRepository.Get(ctx, organizationID, clusterID, c.AdvancedSettingsJson, false); err == nil {
+		c.InfrastructureOutputs = refreshed.InfrastructureOutputs
+	}
+
+	return c, nil
+}
+
+// upgradeKubernetesVersion upgrades the cluster to the target kubernetes version when it runs another one.
+// The upgrade is only allowed to the next minor version of a cluster to be DEPLOYED, and waits until the cluster is DEPLOYED again.
+func (s clusterService) upgradeKubernetesVersion(ctx context.Context, c *cluster.Cluster, targetVersion *string, desiredState cluster.State) (*cluster.Cluster, error) {
+	if targetVersion == nil {
+		return c, nil
+	}
+
+	organizationID := c.OrganizationID.String()
+	clusterID := c.ID.String()
+
+	current, err := s.clusterRepository.Get(ctx, organizationID, clusterID, c.AdvancedSettingsJson, false)
+	if err != nil {
+		return nil, err
+	}
+	if current.KubernetesVersion == nil {
+		return nil, errors.Wrapf(cluster.ErrUnknownKubernetesVersion, "cluster %s", clusterID)
+	}
+	c.KubernetesVersion = current.KubernetesVersion
+	if cluster.IsSameKubernetesVersion(*current.KubernetesVersion, *targetVersion) {
+		return c, nil
+	}
+
+	if err := cluster.ValidateKubernetesVersionUpgrade(*current.KubernetesVersion, *targetVersion); err != nil {
+		return nil, err
+	}
+	if desiredState != cluster.StateDeployed {
+		return nil, cluster.ErrKubernetesVersionUpgradeRequiresDeployedCluster
+	}
+
+	// Wait until no operation is running on the cluster, otherwise the upgrade would be refused.
+	if err := s.wait(ctx, s.waitFinalStateFunc(organizationID, clusterID)); err != nil {
+		return nil, err
+	}
+
+	if err := s.clusterRepository.Upgrade(ctx, organizationID, clusterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.wait(ctx, s.waitStateFunc(organizationID, clusterID, cluster.StateDeployed)); err != nil {
+		return nil, err
+	}
+
+	upgraded, err := s.clusterRepository.Get(ctx, organizationID, clusterID, c.AdvancedSettingsJson, false)
+	if err != nil {
+		return nil, err
+	}
+	if upgraded.KubernetesVersion == nil || !cluster.IsSameKubernetesVersion(*upgraded.KubernetesVersion, *targetVersion) {
+		return nil, errors.Wrapf(cluster.ErrUnexpectedKubernetesVersion, "cluster %s: expected %s but got %s", clusterID, *targetVersion, upgraded.GetKubernetesVersion())
+	}
+
+	state, err := s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.KubernetesVersion = upgraded.KubernetesVersion
+	c.State = state
+	c.InfrastructureOutputs = upgraded.InfrastructureOutputs
+
+	return c, nil
+}
+
+func (s clusterService) deploy(ctx context.Context, organizationID string, clusterID string) (*cluster.State, error) {
+	if err := s.clusterRepository.Deploy(ctx, organizationID, clusterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.wait(ctx, s.waitStateFunc(organizationID, clusterID, cluster.StateDeployed)); err != nil {
+		return nil, err
+	}
+
+	return s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+}
+
+func (s clusterService) stop(ctx context.Context, organizationID string, clusterID string, currentState cluster.State) (*cluster.State, error) {
+	if currentState == cluster.StateStopped || currentState == cluster.StateReady {
+		return new(cluster.StateStopped), nil
+	}
+
+	if err := s.clusterRepository.Stop(ctx, organizationID, clusterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.wait(ctx, s.waitStateFunc(organizationID, clusterID, cluster.StateStopped)); err != nil {
+		return nil, err
+	}
+
+	return s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+}
+
+func (s clusterService) wait(ctx context.Context, f waitFunc) error {
+	return waitWithStrategy(ctx, f, polling.ClusterStrategy, clusterWaitTimeout)
+}
+
+// waitStateFunc returns a waitFunc that is done once the cluster reaches the expected state.
+// It fails as soon as the cluster ends up in an error state, unless an error state is expected.
+func (s clusterService) waitStateFunc(organizationID string, clusterID string, expected cluster.State) waitFunc {
+	progress := telemetry.NewProgressTracker(fmt.Sprintf("Waiting for cluster to be %s", expected))
+	return func(ctx context.Context) (bool, error) {
+		state, err := s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+		if err != nil {
+			// Once deleted, the status endpoint of a cluster answers with either a 404 or a 400.
+			if expected == cluster.StateDeleted && (apierrors.IsErrNotFound(errors.Cause(err)) || apierrors.IsErrBadRequest(errors.Cause(err))) {
+				return true, nil
+			}
+			return false, err
+		}
+		observeClusterState(ctx, progress, clusterID, *state)
+
+		if *state == expected {
+			return true, nil
+		}
+
+		if state.IsError() && !expected.IsError() {
+			return false, errors.Wrapf(cluster.ErrUnexpectedState, "cluster %s: expected %s but got %s", clusterID, expected, *state)
+		}
+
+		return false, nil
+	}
+}
+
+// waitFinalStateFunc returns a waitFunc that is done once no operation is running or pending on the cluster.
+func (s clusterService) waitFinalStateFunc(organizationID string, clusterID string) waitFunc {
+	progress := telemetry.NewProgressTracker("Waiting for cluster final state")
+	return func(ctx context.Context) (bool, error) {
+		state, err := s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+		if err != nil {
+			return false, err
+		}
+		observeClusterState(ctx, progress, clusterID, *state)
+
+		return state.IsFinal(), nil
+	}
+}
+
+// observeClusterState reports the state of a cluster read while waiting to the progress tracker of the current wait.
+func observeClusterState(ctx context.Context, progress *telemetry.ProgressTracker, clusterID string, state cluster.State) {
+	progress.Observe(ctx, "cluster "+clusterID, state.String())
+	progress.Heartbeat(ctx, func(state string) bool {
+		return !cluster.State(state).IsFinal()
+	})
+}
+
+// checkOrganizationID validates that the given organizationID is valid.
+func (s clusterService) checkOrganizationID(organizationID string) error {
+	if organizationID == "" {
+		return cluster.ErrInvalidOrganizationIDParam
+	}
+
+	if _, err := uuid.Parse(organizationID); err != nil {
+		return errors.Wrap(err, cluster.ErrInvalidOrganizationIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkID validates that the given clusterID is valid.
+func (s clusterService) checkID(clusterID string) error {
+	if clusterID == "" {
+		return cluster.ErrInvalidClusterIDParam
+	}
+
+	if _, err := uuid.Parse(clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrInvalidClusterIDParam.Error())
+	}
+
+	return nil
+}
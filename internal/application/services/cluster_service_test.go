@@ -0,0 +1,437 @@
+//go:build unit && !integration
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func newClusterUpsertServiceRequest(desiredState cluster.State) cluster.UpsertServiceRequest {
+	return cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: cluster.UpsertRepositoryRequest{
+			Name:           "my-cluster",
+			CloudProvider:  "SCW",
+			Region:         "fr-par",
+			KubernetesMode: "MANAGED",
+		},
+		DesiredState: desiredState,
+	}
+}
+
+func TestNewClusterService(t *testing.T) {
+	t.Parallel()
+	svc, err := services.NewClusterService(nil)
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+	assert.Nil(t, svc)
+
+	svc, err = services.NewClusterService(mocks_test.NewClusterRepository(t))
+	assert.NoError(t, err)
+	assert.NotNil(t, svc)
+}
+
+func TestClusterServiceCreate(t *testing.T) {
+	t.Parallel()
+	organizationID := uuid.NewString()
+	clusterID := uuid.NewString()
+	newCluster := func() *cluster.Cluster {
+		return &cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID), Name: "my-cluster"}
+	}
+
+	t.Run("invalid organization id", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		c, err := svc.Create(context.Background(), "not-a-uuid", newClusterUpsertServiceRequest(cluster.StateReady))
+		assert.Nil(t, c)
+		assert.ErrorContains(t, err, cluster.ErrInvalidOrganizationIDParam.Error())
+	})
+
+	t.Run("new aws managed cluster requires karpenter", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		req := newClusterUpsertServiceRequest(cluster.StateReady)
+		req.ClusterUpsertRequest.CloudProvider = "AWS"
+		c, err := svc.Create(context.Background(), organizationID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrKarpenterRequired)
+	})
+
+	t.Run("partially managed cluster requires kubeconfig", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		req := newClusterUpsertServiceRequest(cluster.StateReady)
+		req.ClusterUpsertRequest.KubernetesMode = "PARTIALLY_MANAGED"
+		c, err := svc.Create(context.Background(), organizationID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrPartiallyManagedKubeconfigRequired)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateReady)
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(nil, errors.New("boom"))
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Create(context.Background(), organizationID, req)
+		assert.Nil(t, c)
+		assert.ErrorContains(t, err, cluster.ErrFailedToCreateCluster.Error())
+	})
+
+	t.Run("ready cluster is not deployed", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateReady)
+		outputs := &qovery.InfrastructureOutputs{}
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(newCluster(), nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateReady), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{InfrastructureOutputs: outputs}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Create(context.Background(), organizationID, req)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.StateReady, *c.State)
+		assert.Same(t, outputs, c.InfrastructureOutputs)
+	})
+
+	t.Run("deployed cluster is deployed", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateDeployed)
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(newCluster(), nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateReady), nil).Times(2)
+		repo.EXPECT().Deploy(mock.Anything, organizationID, clusterID).Return(nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(newCluster(), nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Create(context.Background(), organizationID, req)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.StateDeployed, *c.State)
+	})
+
+	t.Run("deployment error", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateDeployed)
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(newCluster(), nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateReady), nil).Times(2)
+		repo.EXPECT().Deploy(mock.Anything, organizationID, clusterID).Return(nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeploymentError), nil)
+		deploymentError := newCluster()
+		deploymentError.State = new(cluster.StateDeploymentError)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(deploymentError, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Create(context.Background(), organizationID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrUnexpectedState)
+
+		// The cluster exists, so it is returned as partially created to be saved into the state.
+		var partial *common.PartialCreateError[cluster.Cluster]
+		require.ErrorAs(t, err, &partial)
+		assert.Same(t, deploymentError, partial.Resource)
+	})
+
+	t.Run("kubernetes version older than the created one is refused once the cluster is created", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateReady)
+		req.KubernetesVersion = new("1.32")
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(newCluster(), nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateReady), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.33")}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Create(context.Background(), organizationID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrKubernetesVersionDowngrade)
+
+		var partial *common.PartialCreateError[cluster.Cluster]
+		require.ErrorAs(t, err, &partial)
+	})
+
+	t.Run("partially created cluster is kept when it cannot be read back", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateDeployed)
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Create(mock.Anything, organizationID, req.ClusterUpsertRequest).Return(newCluster(), nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(nil, errors.New("boom"))
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(nil, errors.New("boom"))
+		svc, _ := services.NewClusterService(repo)
+		_, err := svc.Create(context.Background(), organizationID, req)
+
+		var partial *common.PartialCreateError[cluster.Cluster]
+		require.ErrorAs(t, err, &partial)
+		assert.Equal(t, clusterID, partial.Resource.ID.String())
+	})
+}
+
+func TestClusterServiceUpdate(t *testing.T) {
+	t.Parallel()
+	organizationID := uuid.NewString()
+	clusterID := uuid.NewString()
+
+	t.Run("migration to karpenter is refused", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateDeployed)
+		req.ClusterUpsertRequest.CloudProvider = "AWS"
+		req.ClusterUpsertRequest.Features = []qovery.ClusterRequestFeaturesInner{{Id: new(cluster.FeatureIDKarpenter)}}
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrKarpenterMigrationNotSupported)
+	})
+
+	t.Run("stopped cluster is not stopped again", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateStopped)
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Update(mock.Anything, organizationID, clusterID, req.ClusterUpsertRequest).
+			Return(&cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID)}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateStopped), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(nil, errors.New("boom"))
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.StateStopped, *c.State)
+	})
+
+	t.Run("kubernetes version is upgraded", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateDeployed)
+		req.KubernetesVersion = new("1.32")
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Update(mock.Anything, organizationID, clusterID, req.ClusterUpsertRequest).
+			Return(&cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID)}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil).Times(3)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.31")}, nil).Times(2)
+		repo.EXPECT().Upgrade(mock.Anything, organizationID, clusterID).Return(nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.32.1")}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		require.NoError(t, err)
+		assert.Equal(t, "1.32.1", c.GetKubernetesVersion())
+		assert.Equal(t, cluster.StateDeployed, *c.State)
+	})
+
+	t.Run("kubernetes version is not upgraded when it is the same minor version", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateStopped)
+		req.KubernetesVersion = new("1.31")
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Update(mock.Anything, organizationID, clusterID, req.ClusterUpsertRequest).
+			Return(&cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID)}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateStopped), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.31.4")}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		require.NoError(t, err)
+		assert.Equal(t, "1.31.4", c.GetKubernetesVersion())
+	})
+
+	t.Run("kubernetes version downgrade is refused", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateStopped)
+		req.KubernetesVersion = new("1.30")
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Update(mock.Anything, organizationID, clusterID, req.ClusterUpsertRequest).
+			Return(&cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID)}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateStopped), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.31")}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrKubernetesVersionDowngrade)
+	})
+
+	t.Run("kubernetes version upgrade requires a deployed cluster", func(t *testing.T) {
+		req := newClusterUpsertServiceRequest(cluster.StateStopped)
+		req.KubernetesVersion = new("1.32")
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().Update(mock.Anything, organizationID, clusterID, req.ClusterUpsertRequest).
+			Return(&cluster.Cluster{ID: uuid.MustParse(clusterID), OrganizationID: uuid.MustParse(organizationID)}, nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateStopped), nil)
+		repo.EXPECT().Get(mock.Anything, organizationID, clusterID, "", false).Return(&cluster.Cluster{KubernetesVersion: new("1.31")}, nil)
+		svc, _ := services.NewClusterService(repo)
+		c, err := svc.Update(context.Background(), organizationID, clusterID, req)
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, cluster.ErrKubernetesVersionUpgradeRequiresDeployedCluster)
+	})
+}
+
+func TestClusterServiceDelete(t *testing.T) {
+	t.Parallel()
+	organizationID := uuid.NewString()
+	clusterID := uuid.NewString()
+
+	t.Run("invalid cluster id", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		err := svc.Delete(context.Background(), organizationID, "")
+		assert.ErrorContains(t, err, cluster.ErrInvalidClusterIDParam.Error())
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil)
+		repo.EXPECT().Delete(mock.Anything, organizationID, clusterID).Return(errors.New("boom"))
+		svc, _ := services.NewClusterService(repo)
+		err := svc.Delete(context.Background(), organizationID, clusterID)
+		assert.ErrorContains(t, err, cluster.ErrFailedToDeleteCluster.Error())
+	})
+
+	t.Run("waits until the cluster is not found", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil).Once()
+		repo.EXPECT().Delete(mock.Anything, organizationID, clusterID).Return(nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(nil, apierrors.NewNotFoundAPIError(apierrors.APIResourceClusterStatus, clusterID))
+		svc, _ := services.NewClusterService(repo)
+		require.NoError(t, svc.Delete(context.Background(), organizationID, clusterID))
+	})
+
+	t.Run("waits until the status of the cluster is a bad request", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).Return(new(cluster.StateDeployed), nil).Once()
+		repo.EXPECT().Delete(mock.Anything, organizationID, clusterID).Return(nil)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).
+			Return(nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterStatus, clusterID, &http.Response{StatusCode: http.StatusBadRequest}, errors.New("400 Bad Request")))
+		svc, _ := services.NewClusterService(repo)
+		require.NoError(t, svc.Delete(context.Background(), organizationID, clusterID))
+	})
+
+	t.Run("bad request is an error when the cluster is not deleted", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetStatus(mock.Anything, organizationID, clusterID).
+			Return(nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterStatus, clusterID, &http.Response{StatusCode: http.StatusBadRequest}, errors.New("400 Bad Request")))
+		svc, _ := services.NewClusterService(repo)
+		err := svc.Delete(context.Background(), organizationID, clusterID)
+		assert.True(t, apierrors.IsErrBadRequest(err), err)
+	})
+}
+
+func TestClusterServiceSetKubeconfig(t *testing.T) {
+	t.Parallel()
+	organizationID := uuid.NewString()
+	clusterID := uuid.NewString()
+	const kubeconfig = `clusters:
+  - name: my-cluster
+    cluster:
+      server: https://10.0.0.1:6443
+contexts:
+  - name: my-context
+    context:
+      cluster: my-cluster
+      user: my-user
+users:
+  - name: my-user
+    user:
+      token: my-token
+`
+
+	t.Run("invalid cluster id", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		err := svc.SetKubeconfig(context.Background(), organizationID, "", kubeconfig)
+		assert.ErrorContains(t, err, cluster.ErrInvalidClusterIDParam.Error())
+	})
+
+	t.Run("invalid kubeconfig is not sent", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		err := svc.SetKubeconfig(context.Background(), organizationID, clusterID, "apiVersion: v1")
+		assert.ErrorContains(t, err, cluster.ErrFailedToUpdateCluster.Error())
+		assert.ErrorContains(t, err, cluster.ErrInvalidKubeconfig.Error())
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().SetKubeconfig(mock.Anything, organizationID, clusterID, kubeconfig).Return(errors.New("boom"))
+		svc, _ := services.NewClusterService(repo)
+		err := svc.SetKubeconfig(context.Background(), organizationID, clusterID, kubeconfig)
+		assert.ErrorContains(t, err, cluster.ErrFailedToUpdateCluster.Error())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().SetKubeconfig(mock.Anything, organizationID, clusterID, kubeconfig).Return(nil)
+		svc, _ := services.NewClusterService(repo)
+		require.NoError(t, svc.SetKubeconfig(context.Background(), organizationID, clusterID, kubeconfig))
+	})
+}
+
+func TestClusterServiceRoutingTable(t *testing.T) {
+	t.Parallel()
+	organizationID := uuid.NewString()
+	clusterID := uuid.NewString()
+	peering := cluster.Route{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-1234"}
+	vpn := cluster.Route{Description: "vpn", Destination: "10.2.0.0/16", Target: "vgw-1234"}
+
+	t.Run("edit refuses routes with the same destination", func(t *testing.T) {
+		svc, _ := services.NewClusterService(mocks_test.NewClusterRepository(t))
+		duplicate := vpn
+		duplicate.Destination = peering.Destination
+		_, err := svc.EditRoutingTable(context.Background(), organizationID, clusterID, cluster.RoutingTable{peering, duplicate})
+		assert.ErrorIs(t, err, cluster.ErrDuplicateRouteDestination)
+	})
+
+	t.Run("edit accepts nested routes", func(t *testing.T) {
+		nested := vpn
+		nested.Destination = "10.0.0.0/8"
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().EditRoutingTable(mock.Anything, organizationID, clusterID, cluster.RoutingTable{peering, nested}).Return(cluster.RoutingTable{peering, nested}, nil)
+		svc, _ := services.NewClusterService(repo)
+		edited, err := svc.EditRoutingTable(context.Background(), organizationID, clusterID, cluster.RoutingTable{peering, nested})
+		require.NoError(t, err)
+		assert.Equal(t, cluster.RoutingTable{peering, nested}, edited)
+	})
+
+	t.Run("add keeps the other routes", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{peering}, nil)
+		repo.EXPECT().EditRoutingTable(mock.Anything, organizationID, clusterID, cluster.RoutingTable{peering, vpn}).Return(cluster.RoutingTable{peering, vpn}, nil)
+		svc, _ := services.NewClusterService(repo)
+		route, err := svc.AddRoute(context.Background(), organizationID, clusterID, vpn)
+		require.NoError(t, err)
+		assert.Equal(t, vpn, *route)
+	})
+
+	t.Run("add refuses an existing destination", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{peering}, nil)
+		svc, _ := services.NewClusterService(repo)
+		_, err := svc.AddRoute(context.Background(), organizationID, clusterID, peering)
+		assert.ErrorIs(t, err, cluster.ErrRouteAlreadyExists)
+	})
+
+	t.Run("add accepts a route nested in another one", func(t *testing.T) {
+		nested := vpn
+		nested.Destination = "10.1.1.0/24"
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{peering}, nil)
+		repo.EXPECT().EditRoutingTable(mock.Anything, organizationID, clusterID, cluster.RoutingTable{peering, nested}).Return(cluster.RoutingTable{peering, nested}, nil)
+		svc, _ := services.NewClusterService(repo)
+		route, err := svc.AddRoute(context.Background(), organizationID, clusterID, nested)
+		require.NoError(t, err)
+		assert.Equal(t, nested, *route)
+	})
+
+	t.Run("update requires the route to exist", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{peering}, nil)
+		svc, _ := services.NewClusterService(repo)
+		_, err := svc.UpdateRoute(context.Background(), organizationID, clusterID, vpn)
+		assert.ErrorIs(t, err, cluster.ErrRouteNotFound)
+	})
+
+	t.Run("delete keeps the other routes", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{peering, vpn}, nil)
+		repo.EXPECT().EditRoutingTable(mock.Anything, organizationID, clusterID, cluster.RoutingTable{vpn}).Return(cluster.RoutingTable{vpn}, nil)
+		svc, _ := services.NewClusterService(repo)
+		require.NoError(t, svc.DeleteRoute(context.Background(), organizationID, clusterID, peering.Destination))
+	})
+
+	t.Run("delete of a missing route does nothing", func(t *testing.T) {
+		repo := mocks_test.NewClusterRepository(t)
+		repo.EXPECT().GetRoutingTable(mock.Anything, organizationID, clusterID).Return(cluster.RoutingTable{vpn}, nil)
+		svc, _ := services.NewClusterService(repo)
+		require.NoError(t, svc.DeleteRoute(context.Background(), organizationID, clusterID, peering.Destination))
+	})
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
@@ -81,28 +83,28 @@ func (s containerService) Create(ctx context.Context, environmentID string, requ
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, cont.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToCreateContainer.Error())
+		return nil, partiallyCreated(ctx, cont, errors.Wrap(err, container.ErrFailedToCreateContainer.Error()), s.refreshContainer)
 	}
 
 	_, err = s.secretService.Update(ctx, cont.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToCreateContainer.Error())
+		return nil, partiallyCreated(ctx, cont, errors.Wrap(err, container.ErrFailedToCreateContainer.Error()), s.refreshContainer)
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, cont.ID.String(), request.ExternalSecrets); err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToCreateContainer.Error())
+		return nil, partiallyCreated(ctx, cont, errors.Wrap(err, container.ErrFailedToCreateContainer.Error()), s.refreshContainer)
 	}
 
 	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, cont.ID.String(), request.ExternalSecretFiles); err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToCreateContainer.Error())
+		return nil, partiallyCreated(ctx, cont, errors.Wrap(err, container.ErrFailedToCreateContainer.Error()), s.refreshContainer)
 	}
 
-	cont, err = s.refreshContainer(ctx, *cont)
+	refreshed, err := s.refreshContainer(ctx, *cont)
 	if err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToCreateContainer.Error())
+		return nil, common.NewPartialCreateError(cont, errors.Wrap(err, container.ErrFailedToCreateContainer.Error()))
 	}
 
-	return cont, nil
+	return refreshed, nil
 }
 
 // Get handles the domain logic to retrieve a container.
@@ -144,12 +146,16 @@ func (s containerService) Update(ctx context.Context, containerID string, reques
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, cont.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToUpdateContainer.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, container.ErrFailedToUpdateContainer.Error()), func(ctx context.Context) (*container.Container, error) {
+			return s.refreshContainer(ctx, *cont)
+		})
 	}
 
 	_, err = s.secretService.Update(ctx, cont.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, container.ErrFailedToUpdateContainer.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, container.ErrFailedToUpdateContainer.Error()), func(ctx context.Context) (*container.Container, error) {
+			return s.refreshContainer(ctx, *cont)
+		})
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, cont.ID.String(), request.ExternalSecrets); err != nil {
@@ -186,27 +192,33 @@ func (s containerService) Delete(ctx context.Context, containerID string) error
 }
 
 func (s containerService) refreshContainer(ctx context.Context, cont container.Container) (*container.Container, error) {
-	envVars, err := s.variableService.List(ctx, cont.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	secrets, err := s.secretService.List(ctx, cont.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecrets, err := s.externalSecretRepository.List(ctx, cont.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, cont.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	status, err := s.containerDeploymentService.GetStatus(ctx, cont.ID.String())
+	var envVars variable.Variables
+	var secrets secret.Secrets
+	var externalSecrets variable.ExternalSecrets
+	var externalSecretFiles variable.ExternalSecretFiles
+	var deploymentStatus *status.Status
+	err := fetchConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			envVars, err = s.variableService.List(ctx, cont.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			secrets, err = s.secretService.List(ctx, cont.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecrets, err = s.externalSecretRepository.List(ctx, cont.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecretFiles, err = s.externalSecretFileRepository.List(ctx, cont.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			deploymentStatus, err = s.containerDeploymentService.GetStatus(ctx, cont.ID.String())
+			return err
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +234,7 @@ func (s containerService) refreshContainer(ctx context.Context, cont container.C
 	cont.SetExternalSecrets(externalSecrets)
 	cont.SetExternalSecretFiles(externalSecretFiles)
 
-	if err := cont.SetState(status.State); err != nil {
+	if err := cont.SetState(deploymentStatus.State); err != nil {
 		return nil, err
 	}
 
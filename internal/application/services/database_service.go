@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/polling"
+)
+
+// databaseDeleteWaitTimeout is longer than defaultWaitTimeout as the deletion of a managed database can take hours.
+const databaseDeleteWaitTimeout = 4 * time.Hour
+
+// Ensure databaseService defined types fully satisfy the database.Service interface.
+var _ database.Service = databaseService{}
+
+// databaseService implements the interface database.Service.
+type databaseService struct {
+	databaseRepository           database.Repository
+	databaseDeploymentRepository deployment.Repository
+}
+
+// NewDatabaseService return a new instance of a database.Service that uses the given database.Repository.
+func NewDatabaseService(databaseRepository database.Repository, databaseDeploymentRepository deployment.Repository) (database.Service, error) {
+	if databaseRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if databaseDeploymentRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &databaseService{
+		databaseRepository:           databaseRepository,
+		databaseDeploymentRepository: databaseDeploymentRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create a database.
+func (s databaseService) Create(ctx context.Context, environmentID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	if err := s.checkEnvironmentID(environmentID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Create(ctx, environmentID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Get handles the domain logic to retrieve a database.
+func (s databaseService) Get(ctx context.Context, databaseID string) (*database.Database, error) {
+	if err := s.checkID(databaseID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToGetDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Get(ctx, databaseID)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToGetDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Update handles the domain logic to update a database.
+func (s databaseService) Update(ctx context.Context, databaseID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	if err := s.checkID(databaseID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Update(ctx, databaseID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Delete handles the domain logic to delete a database.
+func (s databaseService) Delete(ctx context.Context, databaseID string) error {
+	if err := s.checkID(databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	if err := s.databaseRepository.Delete(ctx, databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	if err := waitWithStrategy(ctx, waitNotFoundFunc(s.databaseDeploymentRepository, databaseID), polling.ServiceStrategy, databaseDeleteWaitTimeout); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	return nil
+}
+
+// checkEnvironmentID validates that the given environmentID is valid.
+func (s databaseService) checkEnvironmentID(environmentID string) error {
+	if environmentID == "" {
+		return database.ErrInvalidEnvironmentIDParam
+	}
+
+	if _, err := uuid.Parse(environmentID); err != nil {
+		return errors.Wrap(err, database.ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkID validates that the given databaseID is valid.
+func (s databaseService) checkID(databaseID string) error {
+	if databaseID == "" {
+		return database.ErrInvalidDatabaseIDParam
+	}
+
+	if _, err := uuid.Parse(databaseID); err != nil {
+		return errors.Wrap(err, database.ErrInvalidDatabaseIDParam.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+//go:build unit && !integration
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestNewDatabaseService(t *testing.T) {
+	t.Parallel()
+	svc, err := services.NewDatabaseService(nil, mocks_test.NewDeploymentRepository(t))
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+	assert.Nil(t, svc)
+
+	svc, err = services.NewDatabaseService(mocks_test.NewDatabaseRepository(t), nil)
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+	assert.Nil(t, svc)
+
+	svc, err = services.NewDatabaseService(mocks_test.NewDatabaseRepository(t), mocks_test.NewDeploymentRepository(t))
+	assert.NoError(t, err)
+	assert.NotNil(t, svc)
+}
+
+func TestDatabaseServiceCreate(t *testing.T) {
+	t.Parallel()
+	environmentID := uuid.NewString()
+	validReq := database.UpsertRepositoryRequest{Name: "my-database", Type: "REDIS", Version: "7", Mode: "CONTAINER"}
+
+	t.Run("invalid environment id", func(t *testing.T) {
+		svc, _ := services.NewDatabaseService(mocks_test.NewDatabaseRepository(t), mocks_test.NewDeploymentRepository(t))
+		db, err := svc.Create(context.Background(), "not-a-uuid", validReq)
+		assert.Nil(t, db)
+		assert.ErrorContains(t, err, database.ErrInvalidEnvironmentIDParam.Error())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		svc, _ := services.NewDatabaseService(mocks_test.NewDatabaseRepository(t), mocks_test.NewDeploymentRepository(t))
+		invalidReq := validReq
+		invalidReq.Type = "ORACLE"
+		db, err := svc.Create(context.Background(), environmentID, invalidReq)
+		assert.Nil(t, db)
+		assert.ErrorContains(t, err, database.ErrInvalidTypeParam.Error())
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := mocks_test.NewDatabaseRepository(t)
+		repo.EXPECT().Create(mock.Anything, environmentID, validReq).Return(nil, errors.New("boom"))
+		svc, _ := services.NewDatabaseService(repo, mocks_test.NewDeploymentRepository(t))
+		db, err := svc.Create(context.Background(), environmentID, validReq)
+		assert.Nil(t, db)
+		assert.ErrorContains(t, err, database.ErrFailedToCreateDatabase.Error())
+	})
+
+	t.Run("repository success", func(t *testing.T) {
+		repo := mocks_test.NewDatabaseRepository(t)
+		expected := &database.Database{ID: uuid.New(), EnvironmentID: uuid.MustParse(environmentID), Name: validReq.Name}
+		repo.EXPECT().Create(mock.Anything, environmentID, validReq).Return(expected, nil)
+		svc, _ := services.NewDatabaseService(repo, mocks_test.NewDeploymentRepository(t))
+		db, err := svc.Create(context.Background(), environmentID, validReq)
+		require.NoError(t, err)
+		assert.Equal(t, expected, db)
+	})
+}
+
+func TestDatabaseServiceDelete(t *testing.T) {
+	t.Parallel()
+	databaseID := uuid.NewString()
+
+	t.Run("invalid database id", func(t *testing.T) {
+		svc, _ := services.NewDatabaseService(mocks_test.NewDatabaseRepository(t), mocks_test.NewDeploymentRepository(t))
+		err := svc.Delete(context.Background(), "")
+		assert.ErrorContains(t, err, database.ErrInvalidDatabaseIDParam.Error())
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := mocks_test.NewDatabaseRepository(t)
+		repo.EXPECT().Delete(mock.Anything, databaseID).Return(errors.New("boom"))
+		svc, _ := services.NewDatabaseService(repo, mocks_test.NewDeploymentRepository(t))
+		err := svc.Delete(context.Background(), databaseID)
+		assert.ErrorContains(t, err, database.ErrFailedToDeleteDatabase.Error())
+	})
+
+	t.Run("waits until the database is not found", func(t *testing.T) {
+		repo := mocks_test.NewDatabaseRepository(t)
+		repo.EXPECT().Delete(mock.Anything, databaseID).Return(nil)
+		deploymentRepo := mocks_test.NewDeploymentRepository(t)
+		deploymentRepo.EXPECT().GetStatus(mock.Anything, databaseID).Return(nil, apierrors.NewNotFoundAPIError(apierrors.APIResourceDatabaseStatus, databaseID))
+		svc, _ := services.NewDatabaseService(repo, deploymentRepo)
+		require.NoError(t, svc.Delete(context.Background(), databaseID))
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,8 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/polling"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 )
 
 const (
@@ -172,12 +175,14 @@ func (c deploymentService) wait(ctx context.Context, f waitFunc) error {
 }
 
 func (c deploymentService) waitDesiredStateFunc(resourceID string, desiredState status.State) waitFunc {
+	progress := telemetry.NewProgressTracker(fmt.Sprintf("Waiting for service to be %s", desiredState))
 	return func(ctx context.Context) (bool, error) {
 		for range defaultWaitMaxRetries {
 			currentStatus, err := c.deploymentRepository.GetStatus(ctx, resourceID)
 			if err != nil {
 				return false, err
 			}
+			observeStatus(ctx, progress, resourceID, *currentStatus)
 
 			isExpectedState := currentStatus.State == desiredState
 			if !isExpectedState && currentStatus.IsFinalState() {
@@ -196,16 +201,26 @@ func (c deploymentService) waitFinalStateFunc(resourceID string) waitFunc {
 }
 
 func waitFinalStateFunc(deploymentRepository deployment.Repository, resourceID string) waitFunc {
+	progress := telemetry.NewProgressTracker("Waiting for final state")
 	return func(ctx context.Context) (bool, error) {
 		currentStatus, err := deploymentRepository.GetStatus(ctx, resourceID)
 		if err != nil {
 			return false, err
 		}
+		observeStatus(ctx, progress, resourceID, *currentStatus)
 
 		return currentStatus.IsFinalState(), nil
 	}
 }
 
+// observeStatus reports the status read while waiting to the progress tracker of the current wait.
+func observeStatus(ctx context.Context, progress *telemetry.ProgressTracker, resourceID string, currentStatus status.Status) {
+	progress.Observe(ctx, resourceID, currentStatus.State.String())
+	progress.Heartbeat(ctx, func(state string) bool {
+		return !status.Status{State: status.State(state)}.IsFinalState()
+	})
+}
+
 func waitNotFoundFunc(deploymentRepository deployment.Repository, resourceID string) waitFunc {
 	return func(ctx context.Context) (bool, error) {
 		_, err := deploymentRepository.GetStatus(ctx, resourceID)
@@ -221,32 +236,10 @@ func waitNotFoundFunc(deploymentRepository deployment.Repository, resourceID str
 }
 
 func wait(ctx context.Context, f waitFunc) error {
-	timeout := new(defaultWaitTimeout)
-
-	// Run the function once before waiting
-	ok, err := f(ctx)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
-	}
-
-	ticker := time.NewTicker(10 * time.Second)
-	timeoutTicker := time.NewTicker(*timeout)
+	return waitWithStrategy(ctx, f, polling.ServiceStrategy, defaultWaitTimeout)
+}
 
-	for {
-		select {
-		case <-timeoutTicker.C:
-			return nil
-		case <-ticker.C:
-			ok, err := f(ctx)
-			if err != nil {
-				return err
-			}
-			if ok {
-				return nil
-			}
-		}
-	}
+// waitWithStrategy polls f following the given polling strategy until it is done or the timeout is reached.
+func waitWithStrategy(ctx context.Context, f waitFunc, strategy polling.Strategy, timeout time.Duration) error {
+	return polling.Wait(ctx, f, strategy, polling.TransientErrorStrategy, timeout)
 }
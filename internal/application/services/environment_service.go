@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
@@ -80,28 +81,28 @@ func (s environmentService) Create(ctx context.Context, projectID string, reques
 	overridesAuthorizedScopes[variable.ScopeProject] = struct{}{}
 	_, err = s.variableService.Update(ctx, env.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error())
+		return nil, partiallyCreated(ctx, env, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error()), s.refreshEnvironment)
 	}
 
 	_, err = s.secretService.Update(ctx, env.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error())
+		return nil, partiallyCreated(ctx, env, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error()), s.refreshEnvironment)
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, env.ID.String(), request.ExternalSecrets); err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error())
+		return nil, partiallyCreated(ctx, env, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error()), s.refreshEnvironment)
 	}
 
 	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, env.ID.String(), request.ExternalSecretFiles); err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error())
+		return nil, partiallyCreated(ctx, env, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error()), s.refreshEnvironment)
 	}
 
-	env, err = s.refreshEnvironment(ctx, *env)
+	refreshed, err := s.refreshEnvironment(ctx, *env)
 	if err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error())
+		return nil, common.NewPartialCreateError(env, errors.Wrap(err, environment.ErrFailedToCreateEnvironment.Error()))
 	}
 
-	return env, nil
+	return refreshed, nil
 }
 
 // Get handles the domain logic to retrieve an aws cluster environment.
@@ -142,12 +143,16 @@ func (s environmentService) Update(ctx context.Context, environmentID string, re
 	overridesAuthorizedScopes[variable.ScopeProject] = struct{}{}
 	_, err = s.variableService.Update(ctx, env.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToUpdateEnvironment.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, environment.ErrFailedToUpdateEnvironment.Error()), func(ctx context.Context) (*environment.Environment, error) {
+			return s.refreshEnvironment(ctx, *env)
+		})
 	}
 
 	_, err = s.secretService.Update(ctx, env.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, environment.ErrFailedToUpdateEnvironment.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, environment.ErrFailedToUpdateEnvironment.Error()), func(ctx context.Context) (*environment.Environment, error) {
+			return s.refreshEnvironment(ctx, *env)
+		})
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, env.ID.String(), request.ExternalSecrets); err != nil {
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentReads bounds the number of api reads run concurrently when refreshing a single service.
+const maxConcurrentReads = 4
+
+// fetchConcurrently runs the given reads concurrently, at most maxConcurrentReads at a time.
+// The first read failing cancels the context of the others and its error is returned.
+// Reads must only write to variables they own: results are combined once fetchConcurrently returns.
+func fetchConcurrently(ctx context.Context, reads ...func(ctx context.Context) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentReads)
+
+	for _, read := range reads {
+		g.Go(func() error {
+			return read(ctx)
+		})
+	}
+
+	return g.Wait()
+}
@@ -0,0 +1,85 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package services
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchConcurrently(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs_all_reads", func(t *testing.T) {
+		t.Parallel()
+
+		var first, second string
+		err := fetchConcurrently(context.Background(),
+			func(ctx context.Context) error {
+				first = "first"
+				return nil
+			},
+			func(ctx context.Context) error {
+				second = "second"
+				return nil
+			},
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "first", first)
+		assert.Equal(t, "second", second)
+	})
+
+	t.Run("returns_the_error_and_cancels_other_reads", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("read failed")
+		var canceled atomic.Bool
+		err := fetchConcurrently(context.Background(),
+			func(ctx context.Context) error {
+				return expectedErr
+			},
+			func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					canceled.Store(true)
+				case <-time.After(5 * time.Second):
+				}
+				return nil
+			},
+		)
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.True(t, canceled.Load())
+	})
+
+	t.Run("bounds_parallelism", func(t *testing.T) {
+		t.Parallel()
+
+		var running, maxRunning atomic.Int32
+		reads := make([]func(ctx context.Context) error, 0, 3*maxConcurrentReads)
+		for range 3 * maxConcurrentReads {
+			reads = append(reads, func(ctx context.Context) error {
+				current := running.Add(1)
+				for {
+					observed := maxRunning.Load()
+					if current <= observed || maxRunning.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				running.Add(-1)
+				return nil
+			})
+		}
+
+		assert.NoError(t, fetchConcurrently(context.Background(), reads...))
+		assert.LessOrEqual(t, maxRunning.Load(), int32(maxConcurrentReads))
+	})
+}
@@ -6,11 +6,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/client/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/helm"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
@@ -93,34 +96,34 @@ func (s helmService) Create(ctx context.Context, environmentID string, request h
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, newHelm.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error())
+		return nil, partiallyCreated(ctx, newHelm, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error()), s.refreshHelm)
 	}
 
 	_, err = s.secretService.Update(ctx, newHelm.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error())
+		return nil, partiallyCreated(ctx, newHelm, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error()), s.refreshHelm)
 	}
 
 	if request.DeploymentRestrictionsDiff.IsNotEmpty() {
 		if apiErr := s.deploymentRestrictionService.UpdateServiceDeploymentRestrictions(ctx, newHelm.ID.String(), domain.HELM, request.DeploymentRestrictionsDiff); apiErr != nil {
-			return nil, apiErr
+			return nil, partiallyCreated(ctx, newHelm, apiErr, s.refreshHelm)
 		}
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, newHelm.ID.String(), request.ExternalSecrets); err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error())
+		return nil, partiallyCreated(ctx, newHelm, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error()), s.refreshHelm)
 	}
 
 	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, newHelm.ID.String(), request.ExternalSecretFiles); err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error())
+		return nil, partiallyCreated(ctx, newHelm, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error()), s.refreshHelm)
 	}
 
-	newHelm, err = s.refreshHelm(ctx, *newHelm)
+	refreshed, err := s.refreshHelm(ctx, *newHelm)
 	if err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error())
+		return nil, common.NewPartialCreateError(newHelm, errors.Wrap(err, helm.ErrFailedToCreateHelm.Error()))
 	}
 
-	return newHelm, nil
+	return refreshed, nil
 }
 
 // Get handles the domain logic to retrieve a helm.
@@ -162,12 +165,16 @@ func (s helmService) Update(ctx context.Context, helmID string, request helm.Ups
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, updateHelm.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToUpdateHelm.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, helm.ErrFailedToUpdateHelm.Error()), func(ctx context.Context) (*helm.Helm, error) {
+			return s.refreshHelm(ctx, *updateHelm)
+		})
 	}
 
 	_, err = s.secretService.Update(ctx, updateHelm.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, helm.ErrFailedToUpdateHelm.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, helm.ErrFailedToUpdateHelm.Error()), func(ctx context.Context) (*helm.Helm, error) {
+			return s.refreshHelm(ctx, *updateHelm)
+		})
 	}
 
 	if request.DeploymentRestrictionsDiff.IsNotEmpty() {
@@ -210,36 +217,46 @@ func (s helmService) Delete(ctx context.Context, helmID string) error {
 }
 
 func (s helmService) refreshHelm(ctx context.Context, helm helm.Helm) (*helm.Helm, error) {
-	envVars, err := s.variableService.List(ctx, helm.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	secrets, err := s.secretService.List(ctx, helm.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecrets, err := s.externalSecretRepository.List(ctx, helm.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, helm.ID.String())
+	var envVars variable.Variables
+	var secrets secret.Secrets
+	var externalSecrets variable.ExternalSecrets
+	var externalSecretFiles variable.ExternalSecretFiles
+	var deploymentStatus *status.Status
+	var deploymentRestrictions []deploymentrestriction.ServiceDeploymentRestriction
+	err := fetchConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			envVars, err = s.variableService.List(ctx, helm.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			secrets, err = s.secretService.List(ctx, helm.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecrets, err = s.externalSecretRepository.List(ctx, helm.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecretFiles, err = s.externalSecretFileRepository.List(ctx, helm.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			deploymentStatus, err = s.helmDeploymentService.GetStatus(ctx, helm.ID.String())
+			return err
+		},
+		func(ctx context.Context) error {
+			var apiErr *apierrors.APIError
+			deploymentRestrictions, apiErr = s.deploymentRestrictionService.GetServiceDeploymentRestrictions(ctx, helm.ID.String(), domain.HELM)
+			if apiErr != nil {
+				return apiErr
+			}
+			return nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	status, err := s.helmDeploymentService.GetStatus(ctx, helm.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	deploymentRestrictions, apiErr := s.deploymentRestrictionService.GetServiceDeploymentRestrictions(ctx, helm.ID.String(), domain.HELM)
-	if apiErr != nil {
-		return nil, apiErr
-	}
-
 	if err := helm.SetEnvironmentVariables(envVars); err != nil {
 		return nil, err
 	}
@@ -251,7 +268,7 @@ func (s helmService) refreshHelm(ctx context.Context, helm helm.Helm) (*helm.Hel
 	helm.SetExternalSecrets(externalSecrets)
 	helm.SetExternalSecretFiles(externalSecretFiles)
 
-	if err := helm.SetState(status.State); err != nil {
+	if err := helm.SetState(deploymentStatus.State); err != nil {
 		return nil, err
 	}
 
@@ -6,11 +6,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/client/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
@@ -93,34 +96,34 @@ func (s jobService) Create(ctx context.Context, environmentID string, request jo
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, newJob.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+		return nil, partiallyCreated(ctx, newJob, errors.Wrap(err, job.ErrFailedToCreateJob.Error()), s.refreshJob)
 	}
 
 	_, err = s.secretService.Update(ctx, newJob.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+		return nil, partiallyCreated(ctx, newJob, errors.Wrap(err, job.ErrFailedToCreateJob.Error()), s.refreshJob)
 	}
 
 	if request.DeploymentRestrictionsDiff.IsNotEmpty() {
 		if apiErr := s.deploymentRestrictionService.UpdateServiceDeploymentRestrictions(ctx, newJob.ID.String(), domain.JOB, request.DeploymentRestrictionsDiff); apiErr != nil {
-			return nil, apiErr
+			return nil, partiallyCreated(ctx, newJob, apiErr, s.refreshJob)
 		}
 	}
 
 	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, newJob.ID.String(), request.ExternalSecrets); err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+		return nil, partiallyCreated(ctx, newJob, errors.Wrap(err, job.ErrFailedToCreateJob.Error()), s.refreshJob)
 	}
 
 	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, newJob.ID.String(), request.ExternalSecretFiles); err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+		return nil, partiallyCreated(ctx, newJob, errors.Wrap(err, job.ErrFailedToCreateJob.Error()), s.refreshJob)
 	}
 
-	newJob, err = s.refreshJob(ctx, *newJob)
+	refreshed, err := s.refreshJob(ctx, *newJob)
 	if err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+		return nil, common.NewPartialCreateError(newJob, errors.Wrap(err, job.ErrFailedToCreateJob.Error()))
 	}
 
-	return newJob, nil
+	return refreshed, nil
 }
 
 // Get handles the domain logic to retrieve a job.
@@ -162,12 +165,16 @@ func (s jobService) Update(ctx context.Context, jobID string, request job.Upsert
 	overridesAuthorizedScopes[variable.ScopeEnvironment] = struct{}{}
 	_, err = s.variableService.Update(ctx, updateJob.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, request.EnvironmentVariableOverrides, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToUpdateJob.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, job.ErrFailedToUpdateJob.Error()), func(ctx context.Context) (*job.Job, error) {
+			return s.refreshJob(ctx, *updateJob)
+		})
 	}
 
 	_, err = s.secretService.Update(ctx, updateJob.ID.String(), request.Secrets, request.SecretAliases, request.SecretOverrides, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, job.ErrFailedToUpdateJob.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, job.ErrFailedToUpdateJob.Error()), func(ctx context.Context) (*job.Job, error) {
+			return s.refreshJob(ctx, *updateJob)
+		})
 	}
 
 	if request.DeploymentRestrictionsDiff.IsNotEmpty() {
@@ -210,36 +217,46 @@ func (s jobService) Delete(ctx context.Context, jobID string) error {
 }
 
 func (s jobService) refreshJob(ctx context.Context, job job.Job) (*job.Job, error) {
-	envVars, err := s.variableService.List(ctx, job.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	secrets, err := s.secretService.List(ctx, job.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecrets, err := s.externalSecretRepository.List(ctx, job.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, job.ID.String())
+	var envVars variable.Variables
+	var secrets secret.Secrets
+	var externalSecrets variable.ExternalSecrets
+	var externalSecretFiles variable.ExternalSecretFiles
+	var deploymentStatus *status.Status
+	var deploymentRestrictions []deploymentrestriction.ServiceDeploymentRestriction
+	err := fetchConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			envVars, err = s.variableService.List(ctx, job.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			secrets, err = s.secretService.List(ctx, job.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecrets, err = s.externalSecretRepository.List(ctx, job.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecretFiles, err = s.externalSecretFileRepository.List(ctx, job.ID.String())
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			deploymentStatus, err = s.jobDeploymentService.GetStatus(ctx, job.ID.String())
+			return err
+		},
+		func(ctx context.Context) error {
+			var apiErr *apierrors.APIError
+			deploymentRestrictions, apiErr = s.deploymentRestrictionService.GetServiceDeploymentRestrictions(ctx, job.ID.String(), domain.JOB)
+			if apiErr != nil {
+				return apiErr
+			}
+			return nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	status, err := s.jobDeploymentService.GetStatus(ctx, job.ID.String())
-	if err != nil {
-		return nil, err
-	}
-
-	deploymentRestrictions, apiErr := s.deploymentRestrictionService.GetServiceDeploymentRestrictions(ctx, job.ID.String(), domain.JOB)
-	if apiErr != nil {
-		return nil, apiErr
-	}
-
 	if err := job.SetEnvironmentVariables(envVars); err != nil {
 		return nil, err
 	}
@@ -251,7 +268,7 @@ func (s jobService) refreshJob(ctx context.Context, job job.Job) (*job.Job, erro
 	job.SetExternalSecrets(externalSecrets)
 	job.SetExternalSecretFiles(externalSecretFiles)
 
-	if err := job.SetState(status.State); err != nil {
+	if err := job.SetState(deploymentStatus.State); err != nil {
 		return nil, err
 	}
 
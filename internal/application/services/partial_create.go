@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+// partiallyCreated returns err as a *common.PartialCreateError holding the created resource, for a failure happening
+// once the resource exists. The resource is refreshed first so that it tells which of the later steps were applied,
+// the created resource being kept as is if the refresh fails too.
+// err is returned as is if it already holds a partially created resource.
+func partiallyCreated[T any](ctx context.Context, created *T, err error, refresh func(ctx context.Context, resource T) (*T, error)) error {
+	var partial *common.PartialCreateError[T]
+	if errors.As(err, &partial) {
+		return err
+	}
+
+	// The refresh must run even if the creation failed because the context was canceled.
+	if refresh != nil && created != nil {
+		if refreshed, refreshErr := refresh(context.WithoutCancel(ctx), *created); refreshErr == nil {
+			created = refreshed
+		}
+	}
+
+	return common.NewPartialCreateError(created, err)
+}
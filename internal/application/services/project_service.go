@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
@@ -71,20 +72,20 @@ func (s projectService) Create(ctx context.Context, organizationID string, reque
 	overridesAuthorizedScopes := make(map[variable.Scope]struct{})
 	_, err = s.variableService.Update(ctx, proj.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, emptyRequest, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, project.ErrFailedToCreateProject.Error())
+		return nil, partiallyCreated(ctx, proj, errors.Wrap(err, project.ErrFailedToCreateProject.Error()), s.refreshProject)
 	}
 
 	_, err = s.secretService.Update(ctx, proj.ID.String(), request.Secrets, request.SecretAliases, emptySecretRequest, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, project.ErrFailedToCreateProject.Error())
+		return nil, partiallyCreated(ctx, proj, errors.Wrap(err, project.ErrFailedToCreateProject.Error()), s.refreshProject)
 	}
 
-	proj, err = s.refreshProject(ctx, *proj)
+	refreshed, err := s.refreshProject(ctx, *proj)
 	if err != nil {
-		return nil, errors.Wrap(err, project.ErrFailedToCreateProject.Error())
+		return nil, common.NewPartialCreateError(proj, errors.Wrap(err, project.ErrFailedToCreateProject.Error()))
 	}
 
-	return proj, nil
+	return refreshed, nil
 }
 
 // Get handles the domain logic to retrieve an aws cluster project.
@@ -135,12 +136,16 @@ func (s projectService) Update(ctx context.Context, projectID string, request pr
 	overridesAuthorizedScopes := make(map[variable.Scope]struct{})
 	_, err = s.variableService.Update(ctx, proj.ID.String(), request.EnvironmentVariables, request.EnvironmentVariableAliases, emptyRequest, request.EnvironmentVariableFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, project.ErrFailedToUpdateProject.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, project.ErrFailedToUpdateProject.Error()), func(ctx context.Context) (*project.Project, error) {
+			return s.refreshProject(ctx, *proj)
+		})
 	}
 
 	_, err = s.secretService.Update(ctx, proj.ID.String(), request.Secrets, request.SecretAliases, emptySecretRequest, request.SecretFiles, overridesAuthorizedScopes)
 	if err != nil {
-		return nil, errors.Wrap(err, project.ErrFailedToUpdateProject.Error())
+		return nil, partialUpdateError(ctx, errors.Wrap(err, project.ErrFailedToUpdateProject.Error()), func(ctx context.Context) (*project.Project, error) {
+			return s.refreshProject(ctx, *proj)
+		})
 	}
 
 	proj, err = s.refreshProject(ctx, *proj)
@@ -61,19 +61,19 @@ func (c secretService) Update(
 		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
 	}
 
-	secrets, err := c.updateSecrets(ctx, resourceID, secretsRequest)
+	// The report is shared by every diff so that a failure tells which keys were applied so far.
+	report := &variable.DiffApplyError{}
+
+	secrets, err := c.updateSecrets(ctx, resourceID, variable.DiffKindValue, secretsRequest, report)
 	if err != nil {
-		return nil, err
+		return secrets, err
 	}
 
 	// The purpose is to get every variable for the current scope.
 	// We need them to be able to create aliases & overrides from a higher scope
-	if err != nil {
-		return nil, errors.Wrap(err, variable.ErrFailedToListVariables.Error())
-	}
 	secretsForCurrentScope, err := c.secretRepository.List(ctx, resourceID)
 	if err != nil {
-		return nil, err
+		return secrets, err
 	}
 	secretsByNameForAliases := make(map[string]secret.Secret)
 	secretsByNameForOverrides := make(map[string]secret.Secret)
@@ -87,152 +87,133 @@ func (c secretService) Update(
 		}
 	}
 
-	secretAliases, err := c.updateSecretAliases(ctx, resourceID, secretAliasesRequest, secretsByNameForAliases)
+	secretAliases, err := c.updateSecretAliases(ctx, resourceID, secretAliasesRequest, secretsByNameForAliases, report)
+	secrets = append(secrets, secretAliases...)
 	if err != nil {
-		return nil, err
+		return secrets, err
 	}
-	secretOverrides, err := c.updateSecretOverrides(ctx, resourceID, secretOverridesRequest, secretsByNameForOverrides)
+	secretOverrides, err := c.updateSecretOverrides(ctx, resourceID, secretOverridesRequest, secretsByNameForOverrides, report)
+	secrets = append(secrets, secretOverrides...)
 	if err != nil {
-		return nil, err
+		return secrets, err
 	}
-	secretFiles, err := c.updateSecrets(ctx, resourceID, secretFilesRequest)
+	secretFiles, err := c.updateSecrets(ctx, resourceID, variable.DiffKindFile, secretFilesRequest, report)
+	secrets = append(secrets, secretFiles...)
 	if err != nil {
-		return nil, err
+		return secrets, err
 	}
 
-	secrets = append(secrets, secretAliases...)
-	secrets = append(secrets, secretOverrides...)
-	secrets = append(secrets, secretFiles...)
-
 	return secrets, nil
 }
 
-func (c secretService) updateSecrets(ctx context.Context, resourceID string, secretsRequest secret.DiffRequest) (secret.Secrets, error) {
-	secrets := make(secret.Secrets, 0, len(secretsRequest.Create)+len(secretsRequest.Update))
+func (c secretService) updateSecrets(ctx context.Context, resourceID string, kind variable.DiffKind, secretsRequest secret.DiffRequest, report *variable.DiffApplyError) (secret.Secrets, error) {
+	deletions := make([]diffOperation[secret.Secret], 0, len(secretsRequest.Delete))
 	for _, toDelete := range secretsRequest.Delete {
-		err := c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID)
-		if err != nil {
-			return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
-		}
+		deletions = append(deletions, diffOperation[secret.Secret]{
+			key: deletedKey(toDelete.Key, toDelete.SecretID),
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				return nil, deleteError(c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID))
+			},
+		})
 	}
 
+	updates := make([]diffOperation[secret.Secret], 0, len(secretsRequest.Update))
 	for _, toUpdate := range secretsRequest.Update {
-		v, err := c.secretRepository.Update(ctx, resourceID, toUpdate.SecretID, toUpdate.UpsertRequest)
-		if err != nil {
-			return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
-		}
-
-		secrets = append(secrets, *v)
+		updates = append(updates, diffOperation[secret.Secret]{
+			key: toUpdate.Key,
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				return c.secretRepository.Update(ctx, resourceID, toUpdate.SecretID, toUpdate.UpsertRequest)
+			},
+		})
 	}
 
+	creations := make([]diffOperation[secret.Secret], 0, len(secretsRequest.Create))
 	for _, toCreate := range secretsRequest.Create {
-		v, err := c.secretRepository.Create(ctx, resourceID, toCreate.UpsertRequest)
-		if err != nil {
-			return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
-		}
+		creations = append(creations, diffOperation[secret.Secret]{
+			key: toCreate.Key,
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				return c.secretRepository.Create(ctx, resourceID, toCreate.UpsertRequest)
+			},
+		})
+	}
 
-		secrets = append(secrets, *v)
+	secrets, err := applyDiffPhases(ctx, kind, report, deletions, updates, creations)
+	if err != nil {
+		return secrets, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
 	}
 
 	return secrets, nil
 }
 
-func (c secretService) updateSecretAliases(ctx context.Context, resourceID string, request secret.DiffRequest, secretsByName map[string]secret.Secret) (secret.Secrets, error) {
+func (c secretService) updateSecretAliases(ctx context.Context, resourceID string, request secret.DiffRequest, secretsByName map[string]secret.Secret, report *variable.DiffApplyError) (secret.Secrets, error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	aliases := make(secret.Secrets, 0, len(request.Create)+len(request.Update))
-
-	for _, toDelete := range request.Delete {
-		err := c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID)
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if err != nil && err.Resp == nil || (err != nil && err.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-	}
-
-	for _, toUpdate := range request.Update {
-		// If the variable alias value has been updated, it means it targets a new aliased variable.
-		// So delete it firstly and re-create it
-		errDelete := c.secretRepository.Delete(ctx, resourceID, toUpdate.SecretID)
-
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if errDelete != nil && errDelete.Resp == nil || (errDelete != nil && errDelete.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(errDelete, variable.ErrFailedToUpdateVariables.Error())
-		}
-		// The alias variable value contains the name of the aliased variable
-		aliasedSecretId := secretsByName[toUpdate.Value].ID
-		v, err := c.secretRepository.CreateAlias(ctx, resourceID, toUpdate.UpsertRequest, aliasedSecretId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		aliases = append(aliases, *v)
-	}
-
-	for _, toCreate := range request.Create {
-		// The alias variable value contains the name of the aliased variable
-		aliasedSecretId := secretsByName[toCreate.Value].ID
-		v, err := c.secretRepository.CreateAlias(ctx, resourceID, toCreate.UpsertRequest, aliasedSecretId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		aliases = append(aliases, *v)
-	}
-
-	return aliases, nil
+	// The alias secret value contains the name of the aliased secret
+	return c.updateSecretLinks(ctx, resourceID, variable.DiffKindAlias, request, report, func(ctx context.Context, request secret.UpsertRequest) (*secret.Secret, error) {
+		aliasedSecretId := secretsByName[request.Value].ID
+		return c.secretRepository.CreateAlias(ctx, resourceID, request, aliasedSecretId.String())
+	})
 }
 
-func (c secretService) updateSecretOverrides(ctx context.Context, resourceID string, request secret.DiffRequest, secretsByName map[string]secret.Secret) (secret.Secrets, error) {
+func (c secretService) updateSecretOverrides(ctx context.Context, resourceID string, request secret.DiffRequest, secretsByName map[string]secret.Secret, report *variable.DiffApplyError) (secret.Secrets, error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	overrides := make(secret.Secrets, 0, len(request.Create)+len(request.Update))
+	// The override secret value contains the name of the overridden secret
+	return c.updateSecretLinks(ctx, resourceID, variable.DiffKindOverride, request, report, func(ctx context.Context, request secret.UpsertRequest) (*secret.Secret, error) {
+		overriddenSecretId := secretsByName[request.Key].ID
+		return c.secretRepository.CreateOverride(ctx, resourceID, request, overriddenSecretId.String())
+	})
+}
+
+// updateSecretLinks applies a diff of aliases or overrides, which are created using the given create function.
+func (c secretService) updateSecretLinks(ctx context.Context, resourceID string, kind variable.DiffKind, request secret.DiffRequest, report *variable.DiffApplyError, create func(ctx context.Context, request secret.UpsertRequest) (*secret.Secret, error)) (secret.Secrets, error) {
+	deletions := make([]diffOperation[secret.Secret], 0, len(request.Delete))
 	for _, toDelete := range request.Delete {
-		err := c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID)
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if err != nil && err.Resp == nil || (err != nil && err.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		deletions = append(deletions, diffOperation[secret.Secret]{
+			key: deletedKey(toDelete.Key, toDelete.SecretID),
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				return nil, ignoreNotFound(c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID))
+			},
+		})
 	}
 
+	updates := make([]diffOperation[secret.Secret], 0, len(request.Update))
 	for _, toUpdate := range request.Update {
-		// If the variable override value has been updated, it means it targets a new overridden variable.
-		// So delete it firstly and re-create it
-		errDelete := c.secretRepository.Delete(ctx, resourceID, toUpdate.SecretID)
-
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if errDelete != nil && errDelete.Resp == nil || (errDelete != nil && errDelete.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(errDelete, variable.ErrFailedToUpdateVariables.Error())
-		}
-		// The override variable value contains the name of the overridden variable
-		overriddenSecretId := secretsByName[toUpdate.Key].ID
-		v, err := c.secretRepository.CreateOverride(ctx, resourceID, toUpdate.UpsertRequest, overriddenSecretId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		overrides = append(overrides, *v)
-	}
-
+		updates = append(updates, diffOperation[secret.Secret]{
+			key: toUpdate.Key,
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				// If the value has been updated, it means it targets a new secret.
+				// So delete it firstly and re-create it
+				if err := ignoreNotFound(c.secretRepository.Delete(ctx, resourceID, toUpdate.SecretID)); err != nil {
+					return nil, err
+				}
+				return create(ctx, toUpdate.UpsertRequest)
+			},
+		})
+	}
+
+	creations := make([]diffOperation[secret.Secret], 0, len(request.Create))
 	for _, toCreate := range request.Create {
-		// The override variable value contains the name of the overridden variable
-		overriddenSecretId := secretsByName[toCreate.Key].ID
-		v, err := c.secretRepository.CreateOverride(ctx, resourceID, toCreate.UpsertRequest, overriddenSecretId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		creations = append(creations, diffOperation[secret.Secret]{
+			key: toCreate.Key,
+			apply: func(ctx context.Context) (*secret.Secret, error) {
+				return create(ctx, toCreate.UpsertRequest)
+			},
+		})
+	}
 
-		overrides = append(overrides, *v)
+	links, err := applyDiffPhases(ctx, kind, report, deletions, updates, creations)
+	if err != nil {
+		return links, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	return overrides, nil
+	return links, nil
 }
 
-// checkResourceID validates that the given resourceID is valid.
 func (c secretService) checkResourceID(resourceID string) error {
 	if resourceID == "" {
 		return secret.ErrInvalidResourceIDParam
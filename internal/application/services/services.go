@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/annotations_group"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apitoken"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdCredentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdDestinationClusterMapping"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/labels_group"
@@ -16,9 +17,11 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/helmRepository"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/customrole"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/member"
@@ -50,7 +53,10 @@ type Services struct {
 	CredentialsAzure                credentials.AzureService
 	CredentialsEksAnywhereVsphere   credentials.EksAnywhereVsphereService
 	Organization                    organization.Service
+	Cluster                         cluster.Service
 	Project                         project.Service
+	Application                     application.Service
+	Database                        database.Service
 	Container                       container.Service
 	Job                             job.Service
 	ContainerRegistry               registry.Service
@@ -120,6 +126,11 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
+	clusterService, err := NewClusterService(services.repos.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	projectEnvironmentVariableService, err := NewVariableService(services.repos.ProjectEnvironmentVariable)
 	if err != nil {
 		return nil, err
@@ -180,6 +191,31 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
+	applicationDeploymentService, err := NewDeploymentService(services.repos.ApplicationDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationEnvironmentVariableService, err := NewVariableService(services.repos.ApplicationEnvironmentVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationSecretService, err := NewSecretService(services.repos.ApplicationSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationService, err := NewApplicationService(services.repos.Application, applicationDeploymentService, applicationEnvironmentVariableService, applicationSecretService, deploymentRestrictionService, services.repos.ApplicationExternalSecret, services.repos.ApplicationExternalSecretFile)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseService, err := NewDatabaseService(services.repos.Database, services.repos.DatabaseDeployment)
+	if err != nil {
+		return nil, err
+	}
+
 	containerRegistryService, err := NewContainerRegistryService(services.repos.ContainerRegistry)
 	if err != nil {
 		return nil, err
@@ -291,7 +327,10 @@ func New(configs ...Configuration) (*Services, error) {
 	services.CredentialsAzure = credentialsAzureService
 	services.CredentialsEksAnywhereVsphere = credentialsEksAnywhereVsphereService
 	services.Organization = organizationService
+	services.Cluster = clusterService
 	services.Project = projectService
+	services.Application = applicationService
+	services.Database = databaseService
 	services.Container = containerService
 	services.Job = jobService
 	services.ContainerRegistry = containerRegistryService
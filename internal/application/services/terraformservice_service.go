@@ -56,25 +56,21 @@ func (s terraformServiceService) Create(ctx context.Context, environmentID strin
 		return nil, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error())
 	}
 
-	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, newTerraformService.ID.String(), request.ExternalSecrets); err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error())
+	refresh := func(ctx context.Context, terraformService terraformservice.TerraformService) (*terraformservice.TerraformService, error) {
+		return &terraformService, s.refreshExternalSecrets(ctx, &terraformService, terraformService.ID.String())
 	}
 
-	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, newTerraformService.ID.String(), request.ExternalSecretFiles); err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error())
+	if err := applyExternalSecretsDiff(ctx, s.externalSecretRepository, newTerraformService.ID.String(), request.ExternalSecrets); err != nil {
+		return nil, partiallyCreated(ctx, newTerraformService, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error()), refresh)
 	}
 
-	externalSecrets, err := s.externalSecretRepository.List(ctx, newTerraformService.ID.String())
-	if err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error())
+	if err := applyExternalSecretFilesDiff(ctx, s.externalSecretFileRepository, newTerraformService.ID.String(), request.ExternalSecretFiles); err != nil {
+		return nil, partiallyCreated(ctx, newTerraformService, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error()), refresh)
 	}
-	newTerraformService.SetExternalSecrets(externalSecrets)
 
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, newTerraformService.ID.String())
-	if err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error())
+	if err := s.refreshExternalSecrets(ctx, newTerraformService, newTerraformService.ID.String()); err != nil {
+		return nil, partiallyCreated(ctx, newTerraformService, errors.Wrap(err, terraformservice.ErrFailedToCreateTerraformService.Error()), nil)
 	}
-	newTerraformService.SetExternalSecretFiles(externalSecretFiles)
 
 	return newTerraformService, nil
 }
@@ -90,17 +86,9 @@ func (s terraformServiceService) Get(ctx context.Context, terraformServiceID str
 		return nil, errors.Wrap(err, terraformservice.ErrFailedToGetTerraformService.Error())
 	}
 
-	externalSecrets, err := s.externalSecretRepository.List(ctx, terraformServiceID)
-	if err != nil {
+	if err := s.refreshExternalSecrets(ctx, fetchedTerraformService, terraformServiceID); err != nil {
 		return nil, errors.Wrap(err, terraformservice.ErrFailedToGetTerraformService.Error())
 	}
-	fetchedTerraformService.SetExternalSecrets(externalSecrets)
-
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, terraformServiceID)
-	if err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToGetTerraformService.Error())
-	}
-	fetchedTerraformService.SetExternalSecretFiles(externalSecretFiles)
 
 	return fetchedTerraformService, nil
 }
@@ -128,17 +116,9 @@ func (s terraformServiceService) Update(ctx context.Context, terraformServiceID
 		return nil, errors.Wrap(err, terraformservice.ErrFailedToUpdateTerraformService.Error())
 	}
 
-	externalSecrets, err := s.externalSecretRepository.List(ctx, terraformServiceID)
-	if err != nil {
+	if err := s.refreshExternalSecrets(ctx, updatedTerraformService, terraformServiceID); err != nil {
 		return nil, errors.Wrap(err, terraformservice.ErrFailedToUpdateTerraformService.Error())
 	}
-	updatedTerraformService.SetExternalSecrets(externalSecrets)
-
-	externalSecretFiles, err := s.externalSecretFileRepository.List(ctx, terraformServiceID)
-	if err != nil {
-		return nil, errors.Wrap(err, terraformservice.ErrFailedToUpdateTerraformService.Error())
-	}
-	updatedTerraformService.SetExternalSecretFiles(externalSecretFiles)
 
 	return updatedTerraformService, nil
 }
@@ -170,6 +150,30 @@ func (s terraformServiceService) List(ctx context.Context, environmentID string)
 	return terraformServices, nil
 }
 
+// refreshExternalSecrets fetches the external secrets and external secret files of the terraform service concurrently.
+func (s terraformServiceService) refreshExternalSecrets(ctx context.Context, terraformService *terraformservice.TerraformService, terraformServiceID string) error {
+	var externalSecrets variable.ExternalSecrets
+	var externalSecretFiles variable.ExternalSecretFiles
+	err := fetchConcurrently(ctx,
+		func(ctx context.Context) (err error) {
+			externalSecrets, err = s.externalSecretRepository.List(ctx, terraformServiceID)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			externalSecretFiles, err = s.externalSecretFileRepository.List(ctx, terraformServiceID)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	terraformService.SetExternalSecrets(externalSecrets)
+	terraformService.SetExternalSecretFiles(externalSecretFiles)
+
+	return nil
+}
+
 // checkEnvironmentID validates that the given environmentID is valid.
 func (s terraformServiceService) checkEnvironmentID(environmentID string) error {
 	if environmentID == "" {
@@ -56,19 +56,19 @@ func (c variableService) Update(
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	environmentVariables, err := c.updateEnvironmentVariables(ctx, resourceID, environmentVariablesRequest)
+	// The report is shared by every diff so that a failure tells which keys were applied so far.
+	report := &variable.DiffApplyError{}
+
+	environmentVariables, err := c.updateEnvironmentVariables(ctx, resourceID, variable.DiffKindValue, environmentVariablesRequest, report)
 	if err != nil {
-		return nil, err
+		return environmentVariables, err
 	}
 
 	// The purpose is to get every variable for the current scope.
 	// We need them to be able to create aliases & overrides from a higher scope
-	if err != nil {
-		return nil, errors.Wrap(err, variable.ErrFailedToListVariables.Error())
-	}
 	environmentVariablesForCurrentScope, err := c.variableRepository.List(ctx, resourceID)
 	if err != nil {
-		return nil, err
+		return environmentVariables, err
 	}
 	// TODO (mzo) set authorized scopes in current method params (for env & prj)
 	environmentVariablesByNameForAliases := make(map[string]variable.Variable)
@@ -83,153 +83,135 @@ func (c variableService) Update(
 		}
 	}
 
-	environmentVariableAliases, err := c.updateEnvironmentVariableAliases(ctx, resourceID, environmentVariableAliasesRequest, environmentVariablesByNameForAliases)
+	environmentVariableAliases, err := c.updateEnvironmentVariableAliases(ctx, resourceID, environmentVariableAliasesRequest, environmentVariablesByNameForAliases, report)
+	environmentVariables = append(environmentVariables, environmentVariableAliases...)
 	if err != nil {
-		return nil, err
+		return environmentVariables, err
 	}
-	environmentVariableOverrides, err := c.updateEnvironmentVariableOverrides(ctx, resourceID, environmentVariableOverridesRequest, environmentVariablesByNameForOverrides)
+	environmentVariableOverrides, err := c.updateEnvironmentVariableOverrides(ctx, resourceID, environmentVariableOverridesRequest, environmentVariablesByNameForOverrides, report)
+	environmentVariables = append(environmentVariables, environmentVariableOverrides...)
 	if err != nil {
-		return nil, err
+		return environmentVariables, err
 	}
-	environmentVariableFiles, err := c.updateEnvironmentVariables(ctx, resourceID, environmentVariableFilesRequest)
+	environmentVariableFiles, err := c.updateEnvironmentVariables(ctx, resourceID, variable.DiffKindFile, environmentVariableFilesRequest, report)
+	environmentVariables = append(environmentVariables, environmentVariableFiles...)
 	if err != nil {
-		return nil, err
+		return environmentVariables, err
 	}
 
-	environmentVariables = append(environmentVariables, environmentVariableAliases...)
-	environmentVariables = append(environmentVariables, environmentVariableOverrides...)
-	environmentVariables = append(environmentVariables, environmentVariableFiles...)
-
 	return environmentVariables, nil
 }
 
-func (c variableService) updateEnvironmentVariables(ctx context.Context, resourceID string, request variable.DiffRequest) (variable.Variables, error) {
+func (c variableService) updateEnvironmentVariables(ctx context.Context, resourceID string, kind variable.DiffKind, request variable.DiffRequest, report *variable.DiffApplyError) (variable.Variables, error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	variables := make(variable.Variables, 0, len(request.Create)+len(request.Update))
+	deletions := make([]diffOperation[variable.Variable], 0, len(request.Delete))
 	for _, toDelete := range request.Delete {
-		err := c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID)
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		deletions = append(deletions, diffOperation[variable.Variable]{
+			key: deletedKey(toDelete.Key, toDelete.VariableID),
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				return nil, deleteError(c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID))
+			},
+		})
 	}
 
+	updates := make([]diffOperation[variable.Variable], 0, len(request.Update))
 	for _, toUpdate := range request.Update {
-		v, err := c.variableRepository.Update(ctx, resourceID, toUpdate.VariableID, toUpdate.UpsertRequest)
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		variables = append(variables, *v)
+		updates = append(updates, diffOperation[variable.Variable]{
+			key: toUpdate.Key,
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				return c.variableRepository.Update(ctx, resourceID, toUpdate.VariableID, toUpdate.UpsertRequest)
+			},
+		})
 	}
 
+	creations := make([]diffOperation[variable.Variable], 0, len(request.Create))
 	for _, toCreate := range request.Create {
-		v, err := c.variableRepository.Create(ctx, resourceID, toCreate.UpsertRequest)
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		creations = append(creations, diffOperation[variable.Variable]{
+			key: toCreate.Key,
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				return c.variableRepository.Create(ctx, resourceID, toCreate.UpsertRequest)
+			},
+		})
+	}
 
-		variables = append(variables, *v)
+	variables, err := applyDiffPhases(ctx, kind, report, deletions, updates, creations)
+	if err != nil {
+		return variables, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
 	return variables, nil
 }
 
-func (c variableService) updateEnvironmentVariableAliases(ctx context.Context, resourceID string, request variable.DiffRequest, environmentVariablesByName map[string]variable.Variable) (variable.Variables, error) {
+func (c variableService) updateEnvironmentVariableAliases(ctx context.Context, resourceID string, request variable.DiffRequest, environmentVariablesByName map[string]variable.Variable, report *variable.DiffApplyError) (variable.Variables, error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	aliases := make(variable.Variables, 0, len(request.Create)+len(request.Update))
-
-	for _, toDelete := range request.Delete {
-		err := c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID)
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if err != nil && err.Resp == nil || (err != nil && err.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-	}
-
-	for _, toUpdate := range request.Update {
-		// If the variable alias value has been updated, it means it targets a new aliased variable.
-		// So delete it firstly and re-create it
-		errDelete := c.variableRepository.Delete(ctx, resourceID, toUpdate.VariableID)
-
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if errDelete != nil && errDelete.Resp == nil || (errDelete != nil && errDelete.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(errDelete, variable.ErrFailedToUpdateVariables.Error())
-		}
-		// The alias variable value contains the name of the aliased variable
-		aliasedVariableId := environmentVariablesByName[toUpdate.Value].ID
-		v, err := c.variableRepository.CreateAlias(ctx, resourceID, toUpdate.UpsertRequest, aliasedVariableId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		aliases = append(aliases, *v)
-	}
-
-	for _, toCreate := range request.Create {
-		// The alias variable value contains the name of the aliased variable
-		aliasedVariableId := environmentVariablesByName[toCreate.Value].ID
-		v, err := c.variableRepository.CreateAlias(ctx, resourceID, toCreate.UpsertRequest, aliasedVariableId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		aliases = append(aliases, *v)
-	}
-
-	return aliases, nil
+	// The alias variable value contains the name of the aliased variable
+	return c.updateEnvironmentVariableLinks(ctx, resourceID, variable.DiffKindAlias, request, report, func(ctx context.Context, request variable.UpsertRequest) (*variable.Variable, error) {
+		aliasedVariableId := environmentVariablesByName[request.Value].ID
+		return c.variableRepository.CreateAlias(ctx, resourceID, request, aliasedVariableId.String())
+	})
 }
 
-func (c variableService) updateEnvironmentVariableOverrides(ctx context.Context, resourceID string, request variable.DiffRequest, environmentVariablesByName map[string]variable.Variable) (variable.Variables, error) {
+func (c variableService) updateEnvironmentVariableOverrides(ctx context.Context, resourceID string, request variable.DiffRequest, environmentVariablesByName map[string]variable.Variable, report *variable.DiffApplyError) (variable.Variables, error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	overrides := make(variable.Variables, 0, len(request.Create)+len(request.Update))
+	// The override variable value contains the name of the overridden variable
+	return c.updateEnvironmentVariableLinks(ctx, resourceID, variable.DiffKindOverride, request, report, func(ctx context.Context, request variable.UpsertRequest) (*variable.Variable, error) {
+		overriddenVariableId := environmentVariablesByName[request.Key].ID
+		return c.variableRepository.CreateOverride(ctx, resourceID, request, overriddenVariableId.String())
+	})
+}
+
+// updateEnvironmentVariableLinks applies a diff of aliases or overrides, which are created using the given create function.
+func (c variableService) updateEnvironmentVariableLinks(ctx context.Context, resourceID string, kind variable.DiffKind, request variable.DiffRequest, report *variable.DiffApplyError, create func(ctx context.Context, request variable.UpsertRequest) (*variable.Variable, error)) (variable.Variables, error) {
+	deletions := make([]diffOperation[variable.Variable], 0, len(request.Delete))
 	for _, toDelete := range request.Delete {
-		err := c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID)
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if err != nil && err.Resp == nil || (err != nil && err.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		deletions = append(deletions, diffOperation[variable.Variable]{
+			key: deletedKey(toDelete.Key, toDelete.VariableID),
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				return nil, ignoreNotFound(c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID))
+			},
+		})
 	}
 
+	updates := make([]diffOperation[variable.Variable], 0, len(request.Update))
 	for _, toUpdate := range request.Update {
-		// If the variable override value has been updated, it means it targets a new overridden variable.
-		// So delete it firstly and re-create it
-		errDelete := c.variableRepository.Delete(ctx, resourceID, toUpdate.VariableID)
-
-		// if 404 then ignore (the higher scoped variable could have been deleted, deleting the current scope variable previously so 404 is normal)
-		if errDelete != nil && errDelete.Resp == nil || (errDelete != nil && errDelete.Resp.StatusCode != 404) {
-			return nil, errors.Wrap(errDelete, variable.ErrFailedToUpdateVariables.Error())
-		}
-		// The override variable value contains the name of the overridden variable
-		overriddenVariableId := environmentVariablesByName[toUpdate.Key].ID
-		v, err := c.variableRepository.CreateOverride(ctx, resourceID, toUpdate.UpsertRequest, overriddenVariableId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
-
-		overrides = append(overrides, *v)
-	}
-
+		updates = append(updates, diffOperation[variable.Variable]{
+			key: toUpdate.Key,
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				// If the value has been updated, it means it targets a new variable.
+				// So delete it firstly and re-create it
+				if err := ignoreNotFound(c.variableRepository.Delete(ctx, resourceID, toUpdate.VariableID)); err != nil {
+					return nil, err
+				}
+				return create(ctx, toUpdate.UpsertRequest)
+			},
+		})
+	}
+
+	creations := make([]diffOperation[variable.Variable], 0, len(request.Create))
 	for _, toCreate := range request.Create {
-		// The override variable value contains the name of the overridden variable
-		overriddenVariableId := environmentVariablesByName[toCreate.Key].ID
-		v, err := c.variableRepository.CreateOverride(ctx, resourceID, toCreate.UpsertRequest, overriddenVariableId.String())
-		if err != nil {
-			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
-		}
+		creations = append(creations, diffOperation[variable.Variable]{
+			key: toCreate.Key,
+			apply: func(ctx context.Context) (*variable.Variable, error) {
+				return create(ctx, toCreate.UpsertRequest)
+			},
+		})
+	}
 
-		overrides = append(overrides, *v)
+	links, err := applyDiffPhases(ctx, kind, report, deletions, updates, creations)
+	if err != nil {
+		return links, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	return overrides, nil
+	return links, nil
 }
 
 // checkResourceID validates that the given resourceID is valid.
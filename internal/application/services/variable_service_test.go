@@ -0,0 +1,123 @@
+//go:build unit && !integration
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestVariableServiceUpdate(t *testing.T) {
+	t.Parallel()
+	resourceID := uuid.NewString()
+
+	newVariable := func(key string) *variable.Variable {
+		return &variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Key: key, Type: "VALUE"}
+	}
+
+	t.Run("applies every change", func(t *testing.T) {
+		repo := mocks_test.NewVariableRepository(t)
+		repo.EXPECT().Delete(mock.Anything, resourceID, "old-id").Return(nil)
+		repo.EXPECT().Update(mock.Anything, resourceID, "updated-id", variable.UpsertRequest{Key: "UPDATED"}).Return(newVariable("UPDATED"), nil)
+		repo.EXPECT().Create(mock.Anything, resourceID, variable.UpsertRequest{Key: "CREATED_1"}).Return(newVariable("CREATED_1"), nil)
+		repo.EXPECT().Create(mock.Anything, resourceID, variable.UpsertRequest{Key: "CREATED_2"}).Return(newVariable("CREATED_2"), nil)
+		repo.EXPECT().List(mock.Anything, resourceID).Return(variable.Variables{}, nil)
+		svc, _ := services.NewVariableService(repo)
+
+		vars, err := svc.Update(context.Background(), resourceID, variable.DiffRequest{
+			Create: []variable.DiffCreateRequest{
+				{UpsertRequest: variable.UpsertRequest{Key: "CREATED_1"}},
+				{UpsertRequest: variable.UpsertRequest{Key: "CREATED_2"}},
+			},
+			Update: []variable.DiffUpdateRequest{{UpsertRequest: variable.UpsertRequest{Key: "UPDATED"}, VariableID: "updated-id"}},
+			Delete: []variable.DiffDeleteRequest{{VariableID: "old-id", Key: "OLD"}},
+		}, variable.DiffRequest{}, variable.DiffRequest{}, variable.DiffRequest{}, nil)
+		require.NoError(t, err)
+
+		keys := make([]string, 0, len(vars))
+		for _, v := range vars {
+			keys = append(keys, v.Key)
+		}
+		assert.Equal(t, []string{"UPDATED", "CREATED_1", "CREATED_2"}, keys)
+	})
+
+	t.Run("returns the variables applied before a failure", func(t *testing.T) {
+		boom := errors.New("boom")
+		repo := mocks_test.NewVariableRepository(t)
+		repo.EXPECT().Delete(mock.Anything, resourceID, "old-id").Return(nil)
+		repo.EXPECT().Create(mock.Anything, resourceID, variable.UpsertRequest{Key: "CREATED"}).Return(newVariable("CREATED"), nil)
+		repo.EXPECT().Create(mock.Anything, resourceID, variable.UpsertRequest{Key: "FAILING"}).Return(nil, boom)
+		svc, _ := services.NewVariableService(repo)
+
+		vars, err := svc.Update(context.Background(), resourceID, variable.DiffRequest{
+			Create: []variable.DiffCreateRequest{
+				{UpsertRequest: variable.UpsertRequest{Key: "FAILING"}},
+				{UpsertRequest: variable.UpsertRequest{Key: "CREATED"}},
+			},
+			Delete: []variable.DiffDeleteRequest{{VariableID: "old-id", Key: "OLD"}},
+		}, variable.DiffRequest{}, variable.DiffRequest{}, variable.DiffRequest{}, nil)
+		require.Len(t, vars, 1)
+		assert.Equal(t, "CREATED", vars[0].Key)
+		assert.ErrorContains(t, err, variable.ErrFailedToUpdateVariables.Error())
+		assert.ErrorIs(t, err, boom)
+
+		var report *variable.DiffApplyError
+		require.ErrorAs(t, err, &report)
+		assert.Equal(t, []string{"OLD", "CREATED"}, report.AppliedKeys(variable.DiffKindValue))
+		assert.Empty(t, report.AppliedKeys(variable.DiffKindAlias))
+		require.Len(t, report.Failed, 1)
+		assert.Equal(t, "FAILING", report.Failed[0].Key)
+	})
+
+	t.Run("later phases are not applied after a failure", func(t *testing.T) {
+		repo := mocks_test.NewVariableRepository(t)
+		repo.EXPECT().Delete(mock.Anything, resourceID, "old-id").Return(apierrors.NewDeleteAPIError(apierrors.APIResourceApplicationEnvironmentVariable, "old-id", nil, errors.New("boom")))
+		svc, _ := services.NewVariableService(repo)
+
+		_, err := svc.Update(context.Background(), resourceID, variable.DiffRequest{
+			Create: []variable.DiffCreateRequest{{UpsertRequest: variable.UpsertRequest{Key: "OLD"}}},
+			Delete: []variable.DiffDeleteRequest{{VariableID: "old-id", Key: "OLD"}},
+		}, variable.DiffRequest{}, variable.DiffRequest{}, variable.DiffRequest{}, nil)
+
+		var report *variable.DiffApplyError
+		require.ErrorAs(t, err, &report)
+		assert.Empty(t, report.Applied)
+		require.Len(t, report.Failed, 1)
+		assert.Equal(t, "OLD", report.Failed[0].Key)
+	})
+	t.Run("reports the applied keys with the kind of their diff", func(t *testing.T) {
+		boom := errors.New("boom")
+		created := newVariable("KEY")
+		repo := mocks_test.NewVariableRepository(t)
+		repo.EXPECT().Create(mock.Anything, resourceID, variable.UpsertRequest{Key: "KEY"}).Return(created, nil)
+		repo.EXPECT().List(mock.Anything, resourceID).Return(variable.Variables{*created}, nil)
+		repo.EXPECT().CreateOverride(mock.Anything, resourceID, variable.UpsertRequest{Key: "KEY", Value: "override"}, created.ID.String()).Return(nil, boom)
+		svc, _ := services.NewVariableService(repo)
+
+		_, err := svc.Update(context.Background(), resourceID,
+			variable.DiffRequest{Create: []variable.DiffCreateRequest{{UpsertRequest: variable.UpsertRequest{Key: "KEY"}}}},
+			variable.DiffRequest{},
+			variable.DiffRequest{Create: []variable.DiffCreateRequest{{UpsertRequest: variable.UpsertRequest{Key: "KEY", Value: "override"}}}},
+			variable.DiffRequest{},
+			map[variable.Scope]struct{}{variable.ScopeApplication: {}},
+		)
+
+		var report *variable.DiffApplyError
+		require.ErrorAs(t, err, &report)
+		assert.Equal(t, []string{"KEY"}, report.AppliedKeys(variable.DiffKindValue))
+		assert.Empty(t, report.AppliedKeys(variable.DiffKindOverride))
+		require.Len(t, report.Failed, 1)
+		assert.Equal(t, "KEY", report.Failed[0].Key)
+	})
+}
@@ -2,10 +2,10 @@ package advanced_settings
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
-	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
@@ -14,29 +14,28 @@ import (
 type ClusterAdvancedSettingsService struct {
 	apiConfig *qovery.Configuration
 
-	// defaultKeysCache caches the set of valid cluster advanced setting keys. The default
-	// set is static for a provider run. It is held behind a pointer so that value-receiver
-	// method copies share the same cache.
-	defaultKeysCache *clusterDefaultKeysCache
+	// defaultsCache caches the default cluster advanced settings, whose keys form the set of
+	// valid cluster advanced setting keys.
+	defaultsCache DefaultsCache
 }
 
-// clusterDefaultKeysCache holds the lazily-fetched set of valid cluster advanced setting keys.
-type clusterDefaultKeysCache struct {
-	mu   sync.Mutex
-	keys map[string]struct{}
+func NewClusterAdvancedSettingsService(apiConfig *qovery.Configuration) *ClusterAdvancedSettingsService {
+	return NewClusterAdvancedSettingsServiceWithCache(apiConfig, newMemoryDefaultsCache())
 }
 
-func NewClusterAdvancedSettingsService(apiConfig *qovery.Configuration) *ClusterAdvancedSettingsService {
+// NewClusterAdvancedSettingsServiceWithCache returns a ClusterAdvancedSettingsService fetching the default
+// cluster advanced settings through the given cache, so that they are shared with the other users of the cache.
+func NewClusterAdvancedSettingsServiceWithCache(apiConfig *qovery.Configuration, cache DefaultsCache) *ClusterAdvancedSettingsService {
 	return &ClusterAdvancedSettingsService{
-		apiConfig:        apiConfig,
-		defaultKeysCache: &clusterDefaultKeysCache{},
+		apiConfig:     apiConfig,
+		defaultsCache: cache,
 	}
 }
 
 // fetchDefaultClusterAdvancedSettings fetches and parses the default cluster advanced
 // settings, whose keys form the set of valid cluster advanced setting keys.
 func (c ClusterAdvancedSettingsService) fetchDefaultClusterAdvancedSettings() (map[string]any, error) {
-	httpClient := &http.Client{}
+	httpClient := httpClientFor(c.apiConfig)
 	apiToken := c.apiConfig.DefaultHeader["Authorization"]
 	host := c.apiConfig.Servers[0].URL
 
@@ -72,20 +71,23 @@ func (c ClusterAdvancedSettingsService) fetchDefaultClusterAdvancedSettings() (m
 	return defaultAdvancedSettingsHashMap, nil
 }
 
-// defaultSettingKeys returns the set of valid cluster advanced setting keys, fetched once
-// and cached for the lifetime of the service.
-func (c ClusterAdvancedSettingsService) defaultSettingKeys() (map[string]struct{}, error) {
-	cache := c.defaultKeysCache
+// defaultSettings returns the default cluster advanced settings through the defaults cache, so that they are
+// fetched only once. The returned map is shared and must not be modified.
+func (c ClusterAdvancedSettingsService) defaultSettings() (map[string]any, error) {
+	return c.defaultsCache.FetchDefaults(context.Background(), "cluster_advanced_settings_defaults", func(context.Context) (map[string]any, error) {
+		return c.fetchDefaultClusterAdvancedSettings()
+	})
+}
 
-	cache.mu.Lock()
-	if cache.keys != nil {
-		cached := cache.keys
-		cache.mu.Unlock()
-		return cached, nil
-	}
-	cache.mu.Unlock()
+// DefaultSettings returns the default cluster advanced settings, keyed by their JSON key.
+// The returned map is shared and must not be modified.
+func (c ClusterAdvancedSettingsService) DefaultSettings() (map[string]any, error) {
+	return c.defaultSettings()
+}
 
-	defaults, err := c.fetchDefaultClusterAdvancedSettings()
+// defaultSettingKeys returns the set of valid cluster advanced setting keys.
+func (c ClusterAdvancedSettingsService) defaultSettingKeys() (map[string]struct{}, error) {
+	defaults, err := c.defaultSettings()
 	if err != nil {
 		return nil, err
 	}
@@ -94,13 +96,7 @@ func (c ClusterAdvancedSettingsService) defaultSettingKeys() (map[string]struct{
 	for k := range defaults {
 		keys[k] = struct{}{}
 	}
-
-	cache.mu.Lock()
-	defer cache.mu.Unlock()
-	if cache.keys == nil {
-		cache.keys = keys
-	}
-	return cache.keys, nil
+	return keys, nil
 }
 
 // UnknownSettingKeys returns the advanced setting keys present in advancedSettingsJson that
@@ -124,6 +120,27 @@ func (c ClusterAdvancedSettingsService) UnknownSettingKeys(advancedSettingsJson
 	return computeUnknownKeys(validKeys, provided), nil
 }
 
+// InvalidSettingValues returns the cluster advanced settings present in advancedSettingsJson
+// whose value does not match the type, the obvious range or the enum values of the setting,
+// as given by the default cluster settings. It returns nil for an empty input.
+func (c ClusterAdvancedSettingsService) InvalidSettingValues(advancedSettingsJson string) ([]InvalidSettingValue, error) {
+	if advancedSettingsJson == "" || advancedSettingsJson == "{}" {
+		return nil, nil
+	}
+
+	defaults, err := c.defaultSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	provided := make(map[string]any)
+	if err := json.Unmarshal([]byte(advancedSettingsJson), &provided); err != nil {
+		return nil, err
+	}
+
+	return computeInvalidValues(defaults, provided), nil
+}
+
 // ReadClusterAdvancedSettings returns only overridden advanced settings.
 func (c ClusterAdvancedSettingsService) ReadClusterAdvancedSettings(
 	organizationId string,
@@ -131,7 +148,7 @@ func (c ClusterAdvancedSettingsService) ReadClusterAdvancedSettings(
 	advancedSettingsJsonFromState string,
 	isTriggeredFromImport bool,
 ) (*string, error) {
-	httpClient := &http.Client{}
+	httpClient := httpClientFor(c.apiConfig)
 	apiToken := c.apiConfig.DefaultHeader["Authorization"]
 	host := c.apiConfig.Servers[0].URL
 
@@ -208,7 +225,7 @@ func (c ClusterAdvancedSettingsService) ReadClusterAdvancedSettings(
 
 // UpdateClusterAdvancedSettings updates advanced settings by computing the whole HTTP body.
 func (c ClusterAdvancedSettingsService) UpdateClusterAdvancedSettings(organizationId string, clusterId string, advancedSettingsJsonParam string) error {
-	httpClient := &http.Client{}
+	httpClient := httpClientFor(c.apiConfig)
 	apiToken := c.apiConfig.DefaultHeader["Authorization"]
 	host := c.apiConfig.Servers[0].URL
 
@@ -0,0 +1,51 @@
+package advanced_settings
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultsCache caches the default advanced settings, which are static for a provider run.
+// The caches shared by the repositories or persisted on disk are implemented by the infrastructure layer.
+type DefaultsCache interface {
+	// FetchDefaults returns the default advanced settings cached for the given key, or calls fetch to retrieve them.
+	// The returned map is shared and must not be modified.
+	FetchDefaults(ctx context.Context, key string, fetch func(ctx context.Context) (map[string]any, error)) (map[string]any, error)
+}
+
+// memoryDefaultsCache is the DefaultsCache of the services built without one: it keeps the defaults for the lifetime
+// of the service. It is a pointer so that value-receiver method copies of the services share the same cache.
+type memoryDefaultsCache struct {
+	mu       sync.Mutex
+	defaults map[string]map[string]any
+}
+
+func newMemoryDefaultsCache() *memoryDefaultsCache {
+	return &memoryDefaultsCache{
+		defaults: make(map[string]map[string]any),
+	}
+}
+
+// FetchDefaults returns the defaults cached for the given key, or calls fetch to retrieve them.
+// The lock is released during the fetch so that the defaults of other keys can be served meanwhile.
+func (c *memoryDefaultsCache) FetchDefaults(ctx context.Context, key string, fetch func(ctx context.Context) (map[string]any, error)) (map[string]any, error) {
+	c.mu.Lock()
+	cached, ok := c.defaults[key]
+	c.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	defaults, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.defaults[key]; ok {
+		return cached, nil
+	}
+	c.defaults[key] = defaults
+	return defaults, nil
+}
@@ -2,11 +2,12 @@ package advanced_settings
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"sort"
-	"sync"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
@@ -17,21 +18,32 @@ import (
 type ServiceAdvancedSettingsService struct {
 	apiConfig *qovery.Configuration
 
-	// defaultKeysCache caches the set of valid advanced setting keys per service type.
-	// The default set is static for a provider run. It is a reference-type map guarded by
-	// a pointer mutex so that value-receiver method copies share the same cache.
-	defaultKeysCache map[int]map[string]struct{}
-	cacheMu          *sync.Mutex
+	// defaultsCache caches the default advanced settings per service type.
+	defaultsCache DefaultsCache
 }
 
 func NewServiceAdvancedSettingsService(apiConfig *qovery.Configuration) *ServiceAdvancedSettingsService {
+	return NewServiceAdvancedSettingsServiceWithCache(apiConfig, newMemoryDefaultsCache())
+}
+
+// NewServiceAdvancedSettingsServiceWithCache returns a ServiceAdvancedSettingsService fetching the default
+// advanced settings through the given cache, so that they are shared with the other users of the cache.
+func NewServiceAdvancedSettingsServiceWithCache(apiConfig *qovery.Configuration, cache DefaultsCache) *ServiceAdvancedSettingsService {
 	return &ServiceAdvancedSettingsService{
-		apiConfig:        apiConfig,
-		defaultKeysCache: make(map[int]map[string]struct{}),
-		cacheMu:          &sync.Mutex{},
+		apiConfig:     apiConfig,
+		defaultsCache: cache,
 	}
 }
 
+// httpClientFor returns the HTTP client configured on the qovery-client configuration so that advanced settings
+// calls go through the same transport as the generated client, or a default client when none is set.
+func httpClientFor(apiConfig *qovery.Configuration) *http.Client {
+	if apiConfig.HTTPClient != nil {
+		return apiConfig.HTTPClient
+	}
+	return &http.Client{}
+}
+
 // Compute the URL to GET or PUT advanced settings for any service type
 func (c ServiceAdvancedSettingsService) computeServiceAdvancedSettingsUrl(serviceType int, serviceId string) (*string, error) {
 	host := c.apiConfig.Servers[0].URL
@@ -80,7 +92,7 @@ func (c ServiceAdvancedSettingsService) computeDefaultServiceAdvancedSettingsUrl
 
 // ReadServiceAdvancedSettings Get only overridden advanced settings
 func (c ServiceAdvancedSettingsService) ReadServiceAdvancedSettings(serviceType int, serviceId string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*string, error) {
-	httpClient := &http.Client{}
+	httpClient := httpClientFor(c.apiConfig)
 	apiToken := c.apiConfig.DefaultHeader["Authorization"]
 
 	var serviceAdvancedSettingsState string
@@ -133,7 +145,7 @@ func (c ServiceAdvancedSettingsService) ReadServiceAdvancedSettings(serviceType
 		return nil, err
 	}
 
-	defaultAdvancedSettingsHashMap, err := c.fetchDefaultAdvancedSettings(serviceType)
+	defaultAdvancedSettingsHashMap, err := c.defaultAdvancedSettings(serviceType)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +171,7 @@ func (c ServiceAdvancedSettingsService) ReadServiceAdvancedSettings(serviceType
 // UpdateServiceAdvancedSettings Update advanced settings by computing the whole http body
 func (c ServiceAdvancedSettingsService) UpdateServiceAdvancedSettings(serviceType int, serviceId string, advancedSettingsJsonFromPlan string) error {
 	apiToken := c.apiConfig.DefaultHeader["Authorization"]
-	httpClient := &http.Client{}
+	httpClient := httpClientFor(c.apiConfig)
 
 	var advancedSettingsStrFromPlan string
 	if advancedSettingsJsonFromPlan == "" {
@@ -265,7 +277,7 @@ func (c ServiceAdvancedSettingsService) fetchDefaultAdvancedSettings(serviceType
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", c.apiConfig.UserAgent)
 
-	resp, err := (&http.Client{}).Do(req)
+	resp, err := httpClientFor(c.apiConfig).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -287,19 +299,24 @@ func (c ServiceAdvancedSettingsService) fetchDefaultAdvancedSettings(serviceType
 	return defaults, nil
 }
 
-// defaultSettingKeys returns the set of valid advanced setting keys for a service type,
-// caching the result per service type. The cache lock is released during the HTTP fetch so
-// concurrent plan-time validations for other service types are not serialized behind it; a
-// double-check on re-lock avoids storing a redundant result if another goroutine raced us.
-func (c ServiceAdvancedSettingsService) defaultSettingKeys(serviceType int) (map[string]struct{}, error) {
-	c.cacheMu.Lock()
-	cached, ok := c.defaultKeysCache[serviceType]
-	c.cacheMu.Unlock()
-	if ok {
-		return cached, nil
-	}
+// defaultAdvancedSettings returns the default advanced settings of a service type through the defaults cache,
+// so that they are fetched only once. The returned map is shared and must not be modified.
+func (c ServiceAdvancedSettingsService) defaultAdvancedSettings(serviceType int) (map[string]any, error) {
+	key := "advanced_settings_defaults/" + strconv.Itoa(serviceType)
+	return c.defaultsCache.FetchDefaults(context.Background(), key, func(context.Context) (map[string]any, error) {
+		return c.fetchDefaultAdvancedSettings(serviceType)
+	})
+}
+
+// DefaultSettings returns the default advanced settings of a service type, keyed by their JSON key.
+// The returned map is shared and must not be modified.
+func (c ServiceAdvancedSettingsService) DefaultSettings(serviceType int) (map[string]any, error) {
+	return c.defaultAdvancedSettings(serviceType)
+}
 
-	defaults, err := c.fetchDefaultAdvancedSettings(serviceType)
+// defaultSettingKeys returns the set of valid advanced setting keys for a service type.
+func (c ServiceAdvancedSettingsService) defaultSettingKeys(serviceType int) (map[string]struct{}, error) {
+	defaults, err := c.defaultAdvancedSettings(serviceType)
 	if err != nil {
 		return nil, err
 	}
@@ -308,13 +325,6 @@ func (c ServiceAdvancedSettingsService) defaultSettingKeys(serviceType int) (map
 	for k := range defaults {
 		keys[k] = struct{}{}
 	}
-
-	c.cacheMu.Lock()
-	defer c.cacheMu.Unlock()
-	if cached, ok := c.defaultKeysCache[serviceType]; ok {
-		return cached, nil
-	}
-	c.defaultKeysCache[serviceType] = keys
 	return keys, nil
 }
 
@@ -351,3 +361,24 @@ func (c ServiceAdvancedSettingsService) UnknownSettingKeys(serviceType int, adva
 
 	return computeUnknownKeys(validKeys, provided), nil
 }
+
+// InvalidSettingValues returns the advanced settings present in advancedSettingsJson whose value
+// does not match the type, the obvious range or the enum values of the setting, as given by the
+// default settings of the service type. It returns nil for an empty input.
+func (c ServiceAdvancedSettingsService) InvalidSettingValues(serviceType int, advancedSettingsJson string) ([]InvalidSettingValue, error) {
+	if advancedSettingsJson == "" || advancedSettingsJson == "{}" {
+		return nil, nil
+	}
+
+	defaults, err := c.defaultAdvancedSettings(serviceType)
+	if err != nil {
+		return nil, err
+	}
+
+	provided := make(map[string]any)
+	if err := json.Unmarshal([]byte(advancedSettingsJson), &provided); err != nil {
+		return nil, err
+	}
+
+	return computeInvalidValues(defaults, provided), nil
+}
@@ -0,0 +1,149 @@
+package advanced_settings
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// InvalidSettingValue is an advanced setting whose value is suspected not to match the one expected by the API.
+// The expected type, integer-ness, range and enum values of a setting are inferred from its default value, its key
+// or a hand-maintained list, and may not match what the API actually accepts.
+type InvalidSettingValue struct {
+	// Key is the exact JSON key of the advanced setting.
+	Key string
+	// Reason tells why the value is suspected to be invalid.
+	Reason string
+}
+
+// settingValueRange is the range of the values accepted by a numeric advanced setting.
+type settingValueRange struct {
+	min float64
+	max float64
+}
+
+// settingValueRangesBySuffix are the obvious ranges of numeric advanced settings, inferred from the suffix of their key.
+// A suffix may match a setting it was not meant for (e.g. "port" matches any key ending in "port"), so values out of
+// these ranges are only reported as warnings.
+var settingValueRangesBySuffix = []struct {
+	suffix string
+	rng    settingValueRange
+}{
+	{suffix: "_percent", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_percentage", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "port", rng: settingValueRange{min: 1, max: 65535}},
+	{suffix: "_seconds", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_sec", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_ms", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_days", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_mib", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_mb", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_gb", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_in_milli", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_count", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+	{suffix: "_replicas", rng: settingValueRange{min: 0, max: math.MaxInt32}},
+}
+
+// settingEnumValues are the values accepted by the advanced settings taking one of a fixed set of strings,
+// which cannot be inferred from their default value.
+var settingEnumValues = map[string][]string{
+	"deployment.update_strategy.type":         {"RollingUpdate", "Recreate"},
+	"network.ingress.proxy_buffering":         {"on", "off"},
+	"network.ingress.proxy_request_buffering": {"on", "off"},
+	"nginx.controller.log_format_escaping":    {"default", "json", "none"},
+	"registry.mirroring_mode":                 {"Service", "Cluster"},
+}
+
+// computeInvalidValues returns the advanced settings whose value does not match the type of their default value,
+// falls outside the obvious range of the setting or is not one of its enum values, sorted by key for deterministic
+// output. Unknown keys, null values and settings whose default is null are not checked.
+func computeInvalidValues(defaults map[string]any, advancedSettings map[string]any) []InvalidSettingValue {
+	invalid := make([]InvalidSettingValue, 0)
+	for key, value := range advancedSettings {
+		defaultValue, ok := defaults[key]
+		if !ok || value == nil || defaultValue == nil {
+			continue
+		}
+
+		if reason := invalidValueReason(key, defaultValue, value); reason != "" {
+			invalid = append(invalid, InvalidSettingValue{Key: key, Reason: reason})
+		}
+	}
+	sort.Slice(invalid, func(i, j int) bool {
+		return invalid[i].Key < invalid[j].Key
+	})
+	return invalid
+}
+
+// invalidValueReason returns why value is not valid for the advanced setting of the given key, or "" if it is valid.
+func invalidValueReason(key string, defaultValue any, value any) string {
+	expected := jsonTypeName(defaultValue)
+	if actual := jsonTypeName(value); actual != expected {
+		return fmt.Sprintf("expected a value of type %s, got a value of type %s", expected, actual)
+	}
+
+	switch v := value.(type) {
+	case float64:
+		// A default value without a fractional part does not guarantee that the setting only accepts integers.
+		if isInteger(defaultValue.(float64)) && !isInteger(v) {
+			return fmt.Sprintf("expected an integer, got %v", v)
+		}
+		if rng, ok := settingValueRangeOf(key); ok && (v < rng.min || v > rng.max) {
+			return fmt.Sprintf("expected a value between %v and %v, got %v", rng.min, rng.max, v)
+		}
+	case string:
+		if values, ok := settingEnumValues[key]; ok && !slices.Contains(values, v) {
+			return fmt.Sprintf("expected one of [%s], got %q", strings.Join(values, ", "), v)
+		}
+	case []any:
+		// The elements are expected to have the type of the elements of the default value, if any.
+		defaultValues := defaultValue.([]any)
+		if len(defaultValues) == 0 || defaultValues[0] == nil {
+			return ""
+		}
+		expectedElement := jsonTypeName(defaultValues[0])
+		for i, element := range v {
+			if actual := jsonTypeName(element); actual != expectedElement {
+				return fmt.Sprintf("expected a list of values of type %s, got a value of type %s at index %d", expectedElement, actual, i)
+			}
+		}
+	}
+
+	return ""
+}
+
+// jsonTypeName returns the name of the JSON type of a value decoded by encoding/json.
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// settingValueRangeOf returns the obvious range of the numeric advanced setting of the given key, if any.
+func settingValueRangeOf(key string) (settingValueRange, bool) {
+	for _, r := range settingValueRangesBySuffix {
+		if strings.HasSuffix(key, r.suffix) {
+			return r.rng, true
+		}
+	}
+	return settingValueRange{}, false
+}
+
+func isInteger(value float64) bool {
+	return value == math.Trunc(value)
+}
@@ -0,0 +1,115 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package advanced_settings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]any{
+		"network.ingress.cors_enabled":            false,
+		"network.ingress.proxy_body_size_mb":      float64(100),
+		"network.ingress.proxy_buffering":         "on",
+		"liveness_probe.initial_delay_seconds":    float64(30),
+		"hpa.cpu.average_utilization_percent":     float64(60),
+		"network.ingress.whitelist_source_range":  "0.0.0.0/0",
+		"deployment.affinity.node.required":       map[string]any{},
+		"deployment.toleration.effects":           []any{"NoSchedule"},
+		"security.service_account_name":           nil,
+		"deployment.termination_grace_period_sec": float64(60),
+	}
+
+	testCases := []struct {
+		testName         string
+		advancedSettings map[string]any
+		expected         []InvalidSettingValue
+	}{
+		{
+			testName: "valid_values_returns_empty",
+			advancedSettings: map[string]any{
+				"network.ingress.cors_enabled":           true,
+				"network.ingress.proxy_body_size_mb":     float64(250),
+				"network.ingress.proxy_buffering":        "off",
+				"hpa.cpu.average_utilization_percent":    float64(150),
+				"deployment.affinity.node.required":      map[string]any{"a": "b"},
+				"deployment.toleration.effects":          []any{"NoExecute"},
+				"network.ingress.whitelist_source_range": "10.0.0.0/8",
+			},
+			expected: []InvalidSettingValue{},
+		},
+		{
+			testName: "wrong_types_returned_sorted",
+			advancedSettings: map[string]any{
+				"network.ingress.proxy_body_size_mb": "100",
+				"network.ingress.cors_enabled":       "true",
+				"deployment.affinity.node.required":  []any{},
+			},
+			expected: []InvalidSettingValue{
+				{Key: "deployment.affinity.node.required", Reason: "expected a value of type object, got a value of type list"},
+				{Key: "network.ingress.cors_enabled", Reason: "expected a value of type boolean, got a value of type string"},
+				{Key: "network.ingress.proxy_body_size_mb", Reason: "expected a value of type number, got a value of type string"},
+			},
+		},
+		{
+			testName: "integer_expected",
+			advancedSettings: map[string]any{
+				"liveness_probe.initial_delay_seconds": float64(1.5),
+			},
+			expected: []InvalidSettingValue{
+				{Key: "liveness_probe.initial_delay_seconds", Reason: "expected an integer, got 1.5"},
+			},
+		},
+		{
+			testName: "out_of_range",
+			advancedSettings: map[string]any{
+				"deployment.termination_grace_period_sec": float64(-1),
+			},
+			expected: []InvalidSettingValue{
+				{Key: "deployment.termination_grace_period_sec", Reason: "expected a value between 0 and 2.147483647e+09, got -1"},
+			},
+		},
+		{
+			testName: "not_an_enum_value",
+			advancedSettings: map[string]any{
+				"network.ingress.proxy_buffering": "yes",
+			},
+			expected: []InvalidSettingValue{
+				{Key: "network.ingress.proxy_buffering", Reason: `expected one of [on, off], got "yes"`},
+			},
+		},
+		{
+			testName: "wrong_list_element_type",
+			advancedSettings: map[string]any{
+				"deployment.toleration.effects": []any{"NoSchedule", float64(1)},
+			},
+			expected: []InvalidSettingValue{
+				{Key: "deployment.toleration.effects", Reason: "expected a list of values of type string, got a value of type number at index 1"},
+			},
+		},
+		{
+			testName: "unknown_keys_null_values_and_null_defaults_are_not_checked",
+			advancedSettings: map[string]any{
+				"bogus.key":                     "x",
+				"network.ingress.cors_enabled":  nil,
+				"security.service_account_name": "my-account",
+			},
+			expected: []InvalidSettingValue{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+			result := computeInvalidValues(defaults, tc.advancedSettings)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("computeInvalidValues() = %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
@@ -12,4 +12,5 @@ const (
 	APIActionDeploy   APIAction = "deploy"
 	APIActionStop     APIAction = "stop"
 	APIActionRedeploy APIAction = "redeploy"
+	APIActionUpgrade  APIAction = "upgrade"
 )
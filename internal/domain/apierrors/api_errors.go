@@ -180,6 +180,11 @@ func NewDeployAPIError(resource APIResource, resourceID string, resp *http.Respo
 	return NewAPIError(APIActionDeploy, resource, resourceID, resp, err)
 }
 
+// NewUpgradeAPIError returns a new instance of APIError for an `upgrade` action with the given parameters.
+func NewUpgradeAPIError(resource APIResource, resourceID string, resp *http.Response, err error) *APIError {
+	return NewAPIError(APIActionUpgrade, resource, resourceID, resp, err)
+}
+
 // NewNotFoundAPIError returns a new instance of APIError for a `not_found` resource with the given parameters.
 func NewNotFoundAPIError(resource APIResource, resourceID string) *APIError {
 	return NewAPIError(APIActionRead, resource, resourceID, &http.Response{
@@ -5,8 +5,11 @@ package apierrors
 
 import (
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -55,3 +58,71 @@ func TestAPIError_Detail_BodyReadableMultipleTimes(t *testing.T) {
 	assert.True(t, apiErr.IsNotFound())
 	assert.Contains(t, apiErr.Error(), "organization does not exist")
 }
+
+func TestIsErrTransient(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Err      error
+		Expected bool
+	}{
+		{
+			TestName: "server_error",
+			Err:      NewReadAPIError(APIResourceCluster, "some-id", &http.Response{StatusCode: http.StatusBadGateway}, errors.New("502 Bad Gateway")),
+			Expected: true,
+		},
+		{
+			TestName: "rate_limiting",
+			Err:      NewReadAPIError(APIResourceCluster, "some-id", &http.Response{StatusCode: http.StatusTooManyRequests}, errors.New("429 Too Many Requests")),
+			Expected: true,
+		},
+		{
+			TestName: "dns_error",
+			Err:      NewReadAPIError(APIResourceCluster, "some-id", nil, &url.Error{Op: "Get", URL: "https://api.qovery.com", Err: &net.DNSError{Err: "no such host", Name: "api.qovery.com"}}),
+			Expected: true,
+		},
+		{
+			TestName: "connection_reset",
+			Err:      NewReadAPIError(APIResourceCluster, "some-id", nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}),
+			Expected: true,
+		},
+		{
+			TestName: "wrapped_by_a_service",
+			Err:      errors.Wrap(NewReadAPIError(APIResourceCluster, "some-id", nil, io.ErrUnexpectedEOF), "failed to get cluster"),
+			Expected: true,
+		},
+		{
+			TestName: "network_error_without_api_error",
+			Err:      &net.DNSError{Err: "no such host", Name: "api.qovery.com", IsTemporary: true},
+			Expected: true,
+		},
+		{
+			TestName: "not_found",
+			Err:      NewNotFoundAPIError(APIResourceCluster, "some-id"),
+			Expected: false,
+		},
+		{
+			TestName: "bad_request",
+			Err:      NewReadAPIError(APIResourceCluster, "some-id", &http.Response{StatusCode: http.StatusBadRequest}, errors.New("400 Bad Request")),
+			Expected: false,
+		},
+		{
+			TestName: "other_error",
+			Err:      errors.New("boom"),
+			Expected: false,
+		},
+		{
+			TestName: "nil_error",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.Expected, IsErrTransient(tc.Err))
+		})
+	}
+}
@@ -12,8 +12,10 @@ const (
 	APIResourceApplicationSecret                  APIResource = "application secret"
 	APIResourceApplicationStatus                  APIResource = "application status"
 	APIResourceCluster                            APIResource = "cluster"
+	APIResourceClusterAdvancedSettings            APIResource = "cluster advanced settings"
 	APIResourceClusterCloudProvider               APIResource = "cluster cloud provider"
 	APIResourceClusterInstanceType                APIResource = "cluster instance type"
+	APIResourceClusterKubeconfig                  APIResource = "cluster kubeconfig"
 	APIResourceClusterRoutingTable                APIResource = "cluster routing table"
 	APIResourceClusterStatus                      APIResource = "cluster status"
 	APIResourceContainer                          APIResource = "container"
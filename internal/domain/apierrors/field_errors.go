@@ -0,0 +1,127 @@
+package apierrors
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldError is a validation error the api reported on a single field of the request payload.
+type FieldError struct {
+	// Field is the path of the invalid field in the request payload, as reported by the api (e.g. `ports[1].external_port` or `/ports/1/external_port`).
+	Field string
+	// Message explains why the field is invalid.
+	Message string
+}
+
+// fieldErrorListKeys are the keys of the error payload that may hold field-level validation errors.
+var fieldErrorListKeys = []string{"errors", "field_errors", "fieldErrors", "invalid_params", "invalidParams", "violations"}
+
+// fieldKeys are the keys holding the field path in a field-level validation error.
+var fieldKeys = []string{"field", "name", "pointer", "path", "property", "propertyPath"}
+
+// messageKeys are the keys holding the message in a field-level validation error.
+var messageKeys = []string{"message", "reason", "detail", "description", "title"}
+
+// ParseFieldErrors extracts the field-level validation errors from an api error body.
+// Both list payloads (`{"errors": [{"field": "...", "message": "..."}]}`) and map payloads
+// (`{"errors": {"field": ["message"]}}`) are supported. It returns nil when the body holds no field error.
+func ParseFieldErrors(body []byte) []FieldError {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for _, key := range fieldErrorListKeys {
+		raw, ok := payload[key]
+		if !ok {
+			continue
+		}
+		fieldErrors = append(fieldErrors, parseFieldErrorList(raw)...)
+		fieldErrors = append(fieldErrors, parseFieldErrorMap(raw)...)
+	}
+
+	return fieldErrors
+}
+
+// FieldErrors returns the field-level validation errors of the api response, if any.
+func (e APIError) FieldErrors() []FieldError {
+	return ParseFieldErrors(e.bufferedBody)
+}
+
+func parseFieldErrorList(raw json.RawMessage) []FieldError {
+	var items []map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil
+	}
+
+	fieldErrors := make([]FieldError, 0, len(items))
+	for _, item := range items {
+		field := firstString(item, fieldKeys)
+		if field == "" {
+			continue
+		}
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   field,
+			Message: firstString(item, messageKeys),
+		})
+	}
+
+	return fieldErrors
+}
+
+func parseFieldErrorMap(raw json.RawMessage) []FieldError {
+	var items map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(items))
+	for field := range items {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	fieldErrors := make([]FieldError, 0, len(items))
+	for _, field := range fields {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   field,
+			Message: messageOf(items[field]),
+		})
+	}
+
+	return fieldErrors
+}
+
+func firstString(item map[string]any, keys []string) string {
+	for _, key := range keys {
+		if value, ok := item[key].(string); ok && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func messageOf(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []any:
+		messages := make([]string, 0, len(v))
+		for _, item := range v {
+			messages = append(messages, messageOf(item))
+		}
+		return strings.Join(messages, ", ")
+	case map[string]any:
+		return firstString(v, messageKeys)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,86 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package apierrors
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Body     string
+		Expected []FieldError
+	}{
+		{
+			TestName: "empty_body",
+			Body:     "",
+			Expected: nil,
+		},
+		{
+			TestName: "no_field_errors",
+			Body:     `{"status":400,"detail":"bad request"}`,
+			Expected: nil,
+		},
+		{
+			TestName: "list_of_field_errors",
+			Body:     `{"status":400,"errors":[{"field":"ports[1].external_port","message":"must be unique"},{"message":"no field"}]}`,
+			Expected: []FieldError{
+				{Field: "ports[1].external_port", Message: "must be unique"},
+			},
+		},
+		{
+			TestName: "problem_details_invalid_params",
+			Body:     `{"invalid_params":[{"name":"/schedule/cronjob/schedule","reason":"invalid cron expression"}]}`,
+			Expected: []FieldError{
+				{Field: "/schedule/cronjob/schedule", Message: "invalid cron expression"},
+			},
+		},
+		{
+			TestName: "map_of_field_errors",
+			Body:     `{"errors":{"memory":["must be greater than 0"],"cpu":"too low"}}`,
+			Expected: []FieldError{
+				{Field: "cpu", Message: "too low"},
+				{Field: "memory", Message: "must be greater than 0"},
+			},
+		},
+		{
+			TestName: "not_json",
+			Body:     "Bad Request",
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.Expected, ParseFieldErrors([]byte(tc.Body)))
+		})
+	}
+}
+
+func TestAPIError_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	apiErr := NewUpdateAPIError(
+		APIResourceContainer,
+		"some-id",
+		&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"field":"cpu","message":"too low"}]}`)),
+		},
+		errors.New("400 Bad Request"),
+	)
+
+	assert.Equal(t, []FieldError{{Field: "cpu", Message: "too low"}}, apiErr.FieldErrors())
+}
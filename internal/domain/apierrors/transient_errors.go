@@ -0,0 +1,55 @@
+package apierrors
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// IsTransient returns whether the error is temporary and the call may succeed when retried:
+// a server error, a rate limiting or a network error such as a DNS error or a timeout.
+func (e APIError) IsTransient() bool {
+	if e.Resp != nil {
+		return e.Resp.StatusCode >= http.StatusInternalServerError || e.Resp.StatusCode == http.StatusTooManyRequests
+	}
+
+	return isNetworkError(e.err)
+}
+
+// IsErrTransient reports whether err's wrap chain contains an APIError that is transient (per IsTransient),
+// or a network error that did not reach the api.
+func IsErrTransient(err error) bool {
+	apiErr := NewAPIErrorFromError(err)
+	if apiErr == nil {
+		return isNetworkError(err)
+	}
+	return apiErr.IsTransient()
+}
+
+// isNetworkError returns whether err is a connection error, e.g. a DNS error, a timeout or a connection reset.
+func isNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
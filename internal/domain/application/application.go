@@ -0,0 +1,300 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/autoscaling"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+var (
+	// ErrNilApplication is returned if an Application is nil.
+	ErrNilApplication = errors.New("application cannot be nil")
+	// ErrInvalidApplication is the error return if an Application is invalid.
+	ErrInvalidApplication = errors.New("invalid application")
+	// ErrInvalidEnvironmentIDParam is returned if the environment id param is invalid.
+	ErrInvalidEnvironmentIDParam = errors.New("invalid environment id param")
+	// ErrInvalidApplicationIDParam is returned if the application id param is invalid.
+	ErrInvalidApplicationIDParam = errors.New("invalid application id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid name param")
+	// ErrInvalidGitRepositoryParam is returned if the git repository param is invalid.
+	ErrInvalidGitRepositoryParam = errors.New("invalid git repository param")
+	// ErrInvalidBuildModeParam is returned if the build mode param is invalid.
+	ErrInvalidBuildModeParam = errors.New("invalid build mode param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid application upsert request")
+	// ErrInvalidApplicationEnvironmentVariablesParam is returned if the environment variables param is invalid.
+	ErrInvalidApplicationEnvironmentVariablesParam = errors.New("invalid application environment variables param")
+	// ErrInvalidApplicationSecretsParam is returned if the secrets param is invalid.
+	ErrInvalidApplicationSecretsParam = errors.New("invalid application secrets param")
+)
+
+type Application struct {
+	ID                          uuid.UUID `validate:"required"`
+	EnvironmentID               uuid.UUID `validate:"required"`
+	Name                        string    `validate:"required"`
+	IconUri                     string
+	GitRepository               *git_repository.GitRepository
+	BuildMode                   *string
+	DockerfilePath              *string
+	DockerTargetBuildStage      *string
+	CPU                         *int32
+	Memory                      *int32
+	EphemeralStorage            *int32
+	MinRunningInstances         *int32
+	MaxRunningInstances         *int32
+	AutoPreview                 *bool
+	Entrypoint                  *string
+	Arguments                   []string
+	Storages                    storage.Storages
+	Ports                       port.Ports
+	EnvironmentVariables        variable.Variables
+	BuiltInEnvironmentVariables variable.Variables
+	ExternalSecrets             variable.ExternalSecrets
+	ExternalSecretFiles         variable.ExternalSecretFiles
+	Secrets                     secret.Secrets
+	InternalHost                *string
+	ExternalHost                *string
+	DeploymentStageID           string
+	IsSkipped                   bool
+	Healthchecks                qovery.Healthcheck
+	AdvancedSettingsJson        string
+	CustomDomains               []*qovery.CustomDomain
+	AutoDeploy                  *bool
+	AnnotationsGroupIds         []string
+	LabelsGroupIds              []string
+	Autoscaling                 *autoscaling.AutoscalingPolicy
+	DeploymentRestrictions      []deploymentrestriction.ServiceDeploymentRestriction
+}
+
+// Validate returns an error to tell whether the Application domain model is valid or not.
+func (a Application) Validate() error {
+	if err := a.Storages.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.Ports.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if a.GitRepository != nil {
+		if err := a.GitRepository.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidApplication.Error())
+		}
+	}
+
+	if a.Autoscaling != nil {
+		if err := a.Autoscaling.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidApplication.Error())
+		}
+	}
+
+	if err := validator.New().Struct(a); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Application domain model is valid or not.
+func (a Application) IsValid() bool {
+	return a.Validate() == nil
+}
+
+// NewApplicationParams represents the arguments needed to create an Application.
+type NewApplicationParams struct {
+	ApplicationID          string
+	EnvironmentID          string
+	Name                   string
+	IconUri                string
+	GitRepository          *git_repository.NewGitRepositoryParams
+	BuildMode              *string
+	DockerfilePath         *string
+	DockerTargetBuildStage *string
+	CPU                    *int32
+	Memory                 *int32
+	EphemeralStorage       *int32
+	MinRunningInstances    *int32
+	MaxRunningInstances    *int32
+	AutoPreview            *bool
+	Entrypoint             *string
+	Arguments              []string
+	Storages               storage.Storages
+	Ports                  port.Ports
+	DeploymentStageID      string
+	IsSkipped              bool
+	Healthchecks           qovery.Healthcheck
+	AdvancedSettingsAsJson string
+	CustomDomains          []*qovery.CustomDomain
+	AutoDeploy             *bool
+	AnnotationsGroupIds    []string
+	LabelsGroupIds         []string
+	Autoscaling            *autoscaling.AutoscalingPolicy
+}
+
+// NewApplication returns a new instance of an Application domain model.
+func NewApplication(params NewApplicationParams) (*Application, error) {
+	applicationUUID, err := uuid.Parse(params.ApplicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidApplicationIDParam.Error())
+	}
+
+	environmentUUID, err := uuid.Parse(params.EnvironmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	var gitRepository *git_repository.GitRepository
+	if params.GitRepository != nil {
+		gitRepository, err = git_repository.NewGitRepository(*params.GitRepository)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidGitRepositoryParam.Error())
+		}
+	}
+
+	a := &Application{
+		ID:                     applicationUUID,
+		EnvironmentID:          environmentUUID,
+		Name:                   params.Name,
+		IconUri:                params.IconUri,
+		GitRepository:          gitRepository,
+		BuildMode:              params.BuildMode,
+		DockerfilePath:         params.DockerfilePath,
+		DockerTargetBuildStage: params.DockerTargetBuildStage,
+		CPU:                    params.CPU,
+		Memory:                 params.Memory,
+		EphemeralStorage:       params.EphemeralStorage,
+		MinRunningInstances:    params.MinRunningInstances,
+		MaxRunningInstances:    params.MaxRunningInstances,
+		AutoPreview:            params.AutoPreview,
+		Entrypoint:             params.Entrypoint,
+		Arguments:              params.Arguments,
+		Storages:               params.Storages,
+		Ports:                  params.Ports,
+		DeploymentStageID:      params.DeploymentStageID,
+		IsSkipped:              params.IsSkipped,
+		Healthchecks:           params.Healthchecks,
+		AdvancedSettingsJson:   params.AdvancedSettingsAsJson,
+		CustomDomains:          params.CustomDomains,
+		AutoDeploy:             params.AutoDeploy,
+		AnnotationsGroupIds:    params.AnnotationsGroupIds,
+		LabelsGroupIds:         params.LabelsGroupIds,
+		Autoscaling:            params.Autoscaling,
+	}
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// SetEnvironmentVariables takes a variable.Variables and sets the attributes EnvironmentVariables & BuiltInEnvironmentVariables by splitting the variable with the `BUILT_IN` scope from the others.
+// The hosts of the application are set from the built-in variables exposing them.
+func (a *Application) SetEnvironmentVariables(vars variable.Variables) error {
+	if err := vars.Validate(); err != nil {
+		return err
+	}
+
+	envVars := make(variable.Variables, 0, len(vars))
+	builtIn := make(variable.Variables, 0, len(vars))
+
+	for _, v := range vars {
+		if v.Scope == variable.ScopeBuiltIn {
+			builtIn = append(builtIn, v)
+			continue
+		}
+		envVars = append(envVars, v)
+	}
+
+	a.EnvironmentVariables = envVars
+	a.BuiltInEnvironmentVariables = builtIn
+	a.SetHosts(vars)
+
+	return nil
+}
+
+// SetSecrets takes a secret.Secrets and sets the attributes Secrets of the application.
+func (a *Application) SetSecrets(secrets secret.Secrets) error {
+	if err := secrets.Validate(); err != nil {
+		return err
+	}
+
+	applicationSecrets := make(secret.Secrets, 0, len(secrets))
+	for _, s := range secrets {
+		if s.Scope == variable.ScopeBuiltIn {
+			continue
+		}
+		applicationSecrets = append(applicationSecrets, s)
+	}
+
+	a.Secrets = applicationSecrets
+
+	return nil
+}
+
+// SetExternalSecrets sets the ExternalSecrets field of the application, excluding BUILT_IN scoped items.
+func (a *Application) SetExternalSecrets(secrets variable.ExternalSecrets) {
+	filtered := make(variable.ExternalSecrets, 0, len(secrets))
+	for _, s := range secrets {
+		if s.Scope == variable.ScopeBuiltIn {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	a.ExternalSecrets = filtered
+}
+
+// SetExternalSecretFiles sets the ExternalSecretFiles field of the application, excluding BUILT_IN scoped items.
+func (a *Application) SetExternalSecretFiles(files variable.ExternalSecretFiles) {
+	filtered := make(variable.ExternalSecretFiles, 0, len(files))
+	for _, f := range files {
+		if f.Scope == variable.ScopeBuiltIn {
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+	a.ExternalSecretFiles = filtered
+}
+
+// SetHosts takes a variable.Variables and sets the attributes InternalHost & ExternalHost from the `QOVERY_APPLICATION_Z<ID>_HOST_*` variables.
+// A host whose variable is missing or empty is left unset.
+func (a *Application) SetHosts(vars variable.Variables) {
+	hostExternalKey := fmt.Sprintf("QOVERY_APPLICATION_Z%s_HOST_EXTERNAL", strings.ToUpper(strings.Split(a.ID.String(), "-")[0]))
+	hostInternalKey := fmt.Sprintf("QOVERY_APPLICATION_Z%s_HOST_INTERNAL", strings.ToUpper(strings.Split(a.ID.String(), "-")[0]))
+
+	a.ExternalHost = nil
+	a.InternalHost = nil
+	for _, v := range vars {
+		if v.Value == "" {
+			continue
+		}
+		switch v.Key {
+		case hostExternalKey:
+			a.ExternalHost = new(v.Value)
+		case hostInternalKey:
+			a.InternalHost = new(v.Value)
+		}
+	}
+}
+
+// SetDeploymentRestrictions sets the DeploymentRestrictions of the application.
+func (a *Application) SetDeploymentRestrictions(restrictions []deploymentrestriction.ServiceDeploymentRestriction) {
+	a.DeploymentRestrictions = restrictions
+}
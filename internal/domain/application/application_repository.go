@@ -0,0 +1,75 @@
+package application
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=ApplicationRepository --filename=application_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/autoscaling"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+)
+
+// Repository represents the interface to implement to handle the persistence of an Application.
+type Repository interface {
+	Create(ctx context.Context, environmentID string, request UpsertRepositoryRequest) (*Application, error)
+	Get(ctx context.Context, applicationID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*Application, error)
+	Update(ctx context.Context, applicationID string, request UpsertRepositoryRequest) (*Application, error)
+	Delete(ctx context.Context, applicationID string) error
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update an Application.
+type UpsertRepositoryRequest struct {
+	Name                   string `validate:"required"`
+	IconUri                *string
+	GitRepository          GitRepositoryRequest
+	BuildMode              *string
+	DockerfilePath         *string
+	DockerTargetBuildStage *string
+	CPU                    *int32
+	Memory                 *int32
+	EphemeralStorage       *int32
+	MinRunningInstances    *int32
+	MaxRunningInstances    *int32
+	AutoPreview            *bool
+	Entrypoint             *string
+	Arguments              []string
+	Storages               []storage.UpsertRequest
+	Ports                  []port.UpsertRequest
+	DeploymentStageID      string
+	IsSkipped              bool
+	Healthchecks           qovery.Healthcheck
+	AdvancedSettingsJson   string
+	CustomDomains          client.CustomDomainsDiff
+	AutoDeploy             qovery.NullableBool
+	AnnotationsGroupIds    []string
+	LabelsGroupIds         []string
+	Autoscaling            *autoscaling.AutoscalingPolicy
+}
+
+// GitRepositoryRequest represents the parameters needed to set the git repository an Application is built from.
+type GitRepositoryRequest struct {
+	Url        string `validate:"required"`
+	Branch     *string
+	RootPath   *string
+	GitTokenId *string
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+var (
+	ErrFailedToCreateApplication = errors.New("failed to create application")
+	ErrFailedToGetApplication    = errors.New("failed to get application")
+	ErrFailedToUpdateApplication = errors.New("failed to update application")
+	ErrFailedToDeleteApplication = errors.New("failed to delete application")
+)
+
+// Service represents the interface to implement to handle the domain logic of an Application.
+type Service interface {
+	Create(ctx context.Context, environmentID string, request UpsertServiceRequest) (*Application, error)
+	Get(ctx context.Context, applicationID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*Application, error)
+	Update(ctx context.Context, applicationID string, request UpsertServiceRequest) (*Application, error)
+	Delete(ctx context.Context, applicationID string) error
+}
+
+// UpsertServiceRequest represents the parameters needed to create & update an Application.
+type UpsertServiceRequest struct {
+	ApplicationUpsertRequest     UpsertRepositoryRequest
+	EnvironmentVariables         variable.DiffRequest
+	EnvironmentVariableAliases   variable.DiffRequest
+	EnvironmentVariableOverrides variable.DiffRequest
+	EnvironmentVariableFiles     variable.DiffRequest
+	Secrets                      secret.DiffRequest
+	SecretAliases                secret.DiffRequest
+	SecretOverrides              secret.DiffRequest
+	SecretFiles                  secret.DiffRequest
+	ExternalSecrets              variable.ExternalSecretDiffRequest
+	ExternalSecretFiles          variable.ExternalSecretFileDiffRequest
+	DeploymentRestrictionsDiff   deploymentrestriction.ServiceDeploymentRestrictionsDiff
+}
+
+// Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) Validate() error {
+	if err := r.ApplicationUpsertRequest.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.EnvironmentVariables.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Secrets.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) IsValid() bool {
+	return r.Validate() == nil
+}
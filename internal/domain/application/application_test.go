@@ -0,0 +1,124 @@
+//go:build unit && !integration
+
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+func newValidApplicationParams() application.NewApplicationParams {
+	return application.NewApplicationParams{
+		ApplicationID: uuid.NewString(),
+		EnvironmentID: uuid.NewString(),
+		Name:          "my-application",
+		GitRepository: &git_repository.NewGitRepositoryParams{
+			Url: "https://github.com/Qovery/test_http_server.git",
+		},
+	}
+}
+
+func TestNewApplication(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        func() application.NewApplicationParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_application_id",
+			Params: func() application.NewApplicationParams {
+				p := newValidApplicationParams()
+				p.ApplicationID = "invalid"
+				return p
+			},
+			ExpectedError: application.ErrInvalidApplicationIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_environment_id",
+			Params: func() application.NewApplicationParams {
+				p := newValidApplicationParams()
+				p.EnvironmentID = ""
+				return p
+			},
+			ExpectedError: application.ErrInvalidEnvironmentIDParam,
+		},
+		{
+			TestName: "fail_with_empty_name",
+			Params: func() application.NewApplicationParams {
+				p := newValidApplicationParams()
+				p.Name = ""
+				return p
+			},
+			ExpectedError: application.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_git_repository",
+			Params: func() application.NewApplicationParams {
+				p := newValidApplicationParams()
+				p.GitRepository = &git_repository.NewGitRepositoryParams{}
+				return p
+			},
+			ExpectedError: application.ErrInvalidGitRepositoryParam,
+		},
+		{
+			TestName: "success",
+			Params:   newValidApplicationParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			params := tc.Params()
+			app, err := application.NewApplication(params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, app)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, params.ApplicationID, app.ID.String())
+			assert.Equal(t, params.Name, app.Name)
+			assert.Equal(t, params.GitRepository.Url, app.GitRepository.Url)
+		})
+	}
+}
+
+func TestApplication_SetEnvironmentVariables(t *testing.T) {
+	t.Parallel()
+
+	app, err := application.NewApplication(newValidApplicationParams())
+	require.NoError(t, err)
+
+	hostPrefix := "QOVERY_APPLICATION_Z" + strings.ToUpper(strings.Split(app.ID.String(), "-")[0])
+	vars := variable.Variables{
+		{ID: uuid.New(), Key: "PORT", Value: "8080", Scope: variable.ScopeApplication},
+		{ID: uuid.New(), Key: hostPrefix + "_HOST_EXTERNAL", Value: "app.example.com", Scope: variable.ScopeBuiltIn},
+		{ID: uuid.New(), Key: hostPrefix + "_HOST_INTERNAL", Value: "app-internal", Scope: variable.ScopeBuiltIn},
+	}
+
+	require.NoError(t, app.SetEnvironmentVariables(vars))
+	assert.Len(t, app.EnvironmentVariables, 1)
+	assert.Len(t, app.BuiltInEnvironmentVariables, 2)
+	require.NotNil(t, app.ExternalHost)
+	assert.Equal(t, "app.example.com", *app.ExternalHost)
+	require.NotNil(t, app.InternalHost)
+	assert.Equal(t, "app-internal", *app.InternalHost)
+
+	// Hosts are cleared once the variables exposing them are gone.
+	require.NoError(t, app.SetEnvironmentVariables(vars[:1]))
+	assert.Nil(t, app.ExternalHost)
+	assert.Nil(t, app.InternalHost)
+}
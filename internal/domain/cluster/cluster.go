@@ -0,0 +1,217 @@
+package cluster
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+)
+
+const (
+	// FeatureIDKarpenter is the id of the cluster feature enabling Karpenter.
+	FeatureIDKarpenter = "KARPENTER"
+	// InstanceTypeAutoPilot is the instance type of GKE Autopilot clusters.
+	InstanceTypeAutoPilot = "AUTO_PILOT"
+)
+
+var (
+	// ErrNilCluster is returned if a Cluster is nil.
+	ErrNilCluster = errors.New("cluster cannot be nil")
+	// ErrInvalidCluster is the error return if a Cluster is invalid.
+	ErrInvalidCluster = errors.New("invalid cluster")
+	// ErrInvalidOrganizationIDParam is returned if the organization id param is invalid.
+	ErrInvalidOrganizationIDParam = errors.New("invalid organization id param")
+	// ErrInvalidClusterIDParam is returned if the cluster id param is invalid.
+	ErrInvalidClusterIDParam = errors.New("invalid cluster id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid cluster name param")
+	// ErrInvalidCloudProviderParam is returned if the cloud provider param is invalid.
+	ErrInvalidCloudProviderParam = errors.New("invalid cluster cloud provider param")
+	// ErrInvalidKubernetesModeParam is returned if the kubernetes mode param is invalid.
+	ErrInvalidKubernetesModeParam = errors.New("invalid cluster kubernetes mode param")
+	// ErrInvalidStateParam is returned if the state param is invalid.
+	ErrInvalidStateParam = errors.New("invalid cluster state param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid cluster upsert request")
+)
+
+type Cluster struct {
+	ID                             uuid.UUID `validate:"required"`
+	OrganizationID                 uuid.UUID `validate:"required"`
+	CredentialsID                  *string
+	Name                           string        `validate:"required"`
+	CloudProvider                  CloudProvider `validate:"required"`
+	Region                         string        `validate:"required"`
+	Description                    *string
+	KubernetesMode                 *KubernetesMode
+	KubernetesVersion              *string
+	InstanceType                   *string
+	DiskSize                       *int32
+	MinRunningNodes                *int32
+	MaxRunningNodes                *int32
+	Production                     *bool
+	State                          *State
+	Features                       []qovery.ClusterFeatureResponse
+	Keda                           *qovery.ClusterKeda
+	RoutingTable                   RoutingTable
+	AdvancedSettingsJson           string
+	InfrastructureOutputs          *qovery.InfrastructureOutputs
+	InfrastructureChartsParameters *qovery.ClusterInfrastructureChartsParameters
+	LabelsGroupIds                 []string
+	SecretManagerAccesses          []qovery.SecretManagerAccess
+}
+
+// Validate returns an error to tell whether the Cluster domain model is valid or not.
+func (c Cluster) Validate() error {
+	if err := c.CloudProvider.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	if c.KubernetesMode != nil {
+		if err := c.KubernetesMode.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidCluster.Error())
+		}
+	}
+
+	if c.State != nil {
+		if err := c.State.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidCluster.Error())
+		}
+	}
+
+	if err := validator.New().Struct(c); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Cluster domain model is valid or not.
+func (c Cluster) IsValid() bool {
+	return c.Validate() == nil
+}
+
+// IsPartiallyManaged returns a bool to tell whether the Cluster is a PARTIALLY_MANAGED (EKS Anywhere) cluster.
+func (c Cluster) IsPartiallyManaged() bool {
+	return c.KubernetesMode != nil && *c.KubernetesMode == KubernetesModePartiallyManaged
+}
+
+// HasKarpenter returns a bool to tell whether the Karpenter feature is enabled on the Cluster.
+func (c Cluster) HasKarpenter() bool {
+	for _, f := range c.Features {
+		if f.Id != nil && *f.Id == FeatureIDKarpenter {
+			return true
+		}
+	}
+	return false
+}
+
+// GetKubernetesVersion returns the kubernetes version of the Cluster, or an empty string when it is not known.
+func (c Cluster) GetKubernetesVersion() string {
+	if c.KubernetesVersion == nil {
+		return ""
+	}
+	return *c.KubernetesVersion
+}
+
+// IsAutoPilot returns a bool to tell whether the Cluster is a GKE Autopilot cluster.
+func (c Cluster) IsAutoPilot() bool {
+	return c.InstanceType != nil && *c.InstanceType == InstanceTypeAutoPilot
+}
+
+// NewClusterParams represents the arguments needed to create a Cluster.
+type NewClusterParams struct {
+	ClusterID                      string
+	OrganizationID                 string
+	CredentialsID                  *string
+	Name                           string
+	CloudProvider                  string
+	Region                         string
+	Description                    *string
+	KubernetesMode                 *string
+	KubernetesVersion              *string
+	InstanceType                   *string
+	DiskSize                       *int32
+	MinRunningNodes                *int32
+	MaxRunningNodes                *int32
+	Production                     *bool
+	State                          *string
+	Features                       []qovery.ClusterFeatureResponse
+	Keda                           *qovery.ClusterKeda
+	RoutingTable                   RoutingTable
+	AdvancedSettingsJson           string
+	InfrastructureOutputs          *qovery.InfrastructureOutputs
+	InfrastructureChartsParameters *qovery.ClusterInfrastructureChartsParameters
+	LabelsGroupIds                 []string
+	SecretManagerAccesses          []qovery.SecretManagerAccess
+}
+
+// NewCluster returns a new instance of a Cluster domain model.
+func NewCluster(params NewClusterParams) (*Cluster, error) {
+	clusterUUID, err := uuid.Parse(params.ClusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidClusterIDParam.Error())
+	}
+
+	organizationUUID, err := uuid.Parse(params.OrganizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidOrganizationIDParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	cloudProvider, err := NewCloudProviderFromString(params.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidCloudProviderParam.Error())
+	}
+
+	var kubernetesMode *KubernetesMode
+	if params.KubernetesMode != nil {
+		kubernetesMode, err = NewKubernetesModeFromString(*params.KubernetesMode)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidKubernetesModeParam.Error())
+		}
+	}
+
+	var state *State
+	if params.State != nil {
+		state, err = NewStateFromString(*params.State)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+	}
+
+	c := &Cluster{
+		ID:                             clusterUUID,
+		OrganizationID:                 organizationUUID,
+		CredentialsID:                  params.CredentialsID,
+		Name:                           params.Name,
+		CloudProvider:                  *cloudProvider,
+		Region:                         params.Region,
+		Description:                    params.Description,
+		KubernetesMode:                 kubernetesMode,
+		KubernetesVersion:              params.KubernetesVersion,
+		InstanceType:                   params.InstanceType,
+		DiskSize:                       params.DiskSize,
+		MinRunningNodes:                params.MinRunningNodes,
+		MaxRunningNodes:                params.MaxRunningNodes,
+		Production:                     params.Production,
+		State:                          state,
+		Features:                       params.Features,
+		Keda:                           params.Keda,
+		RoutingTable:                   params.RoutingTable,
+		AdvancedSettingsJson:           params.AdvancedSettingsJson,
+		InfrastructureOutputs:          params.InfrastructureOutputs,
+		InfrastructureChartsParameters: params.InfrastructureChartsParameters,
+		LabelsGroupIds:                 params.LabelsGroupIds,
+		SecretManagerAccesses:          params.SecretManagerAccesses,
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,154 @@
+package cluster
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrInvalidKubeconfig is returned if a kubeconfig is invalid.
+	ErrInvalidKubeconfig = errors.New("invalid kubeconfig")
+)
+
+// kubeconfig is the subset of a kubeconfig file that is checked by ValidateKubeconfig.
+type kubeconfig struct {
+	Kind           string `yaml:"kind"`
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string         `yaml:"name"`
+		User kubeconfigUser `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// kubeconfigUser holds the fields of a kubeconfig user that define how it authenticates.
+type kubeconfigUser struct {
+	Token                 string `yaml:"token"`
+	TokenFile             string `yaml:"tokenFile"`
+	ClientCertificate     string `yaml:"client-certificate"`
+	ClientCertificateData string `yaml:"client-certificate-data"`
+	ClientKey             string `yaml:"client-key"`
+	ClientKeyData         string `yaml:"client-key-data"`
+	Username              string `yaml:"username"`
+	Password              string `yaml:"password"`
+	Exec                  any    `yaml:"exec"`
+	AuthProvider          any    `yaml:"auth-provider"`
+}
+
+// hasAuthMethod returns true if the user can authenticate with a token, a client certificate, a username and password, an exec plugin or an auth provider.
+func (u kubeconfigUser) hasAuthMethod() bool {
+	hasToken := u.Token != "" || u.TokenFile != ""
+	hasClientCertificate := (u.ClientCertificate != "" || u.ClientCertificateData != "") && (u.ClientKey != "" || u.ClientKeyData != "")
+	hasBasicAuth := u.Username != "" && u.Password != ""
+
+	return hasToken || hasClientCertificate || hasBasicAuth || u.Exec != nil || u.AuthProvider != nil
+}
+
+// ValidateKubeconfig returns an error to tell whether the given kubeconfig is valid or not.
+// It only checks the structure of the kubeconfig: every context must reference a cluster with an https server URL and a user with an authentication method.
+// It does not try to connect to the cluster.
+func ValidateKubeconfig(content string) error {
+	var kc kubeconfig
+	if err := yaml.Unmarshal([]byte(content), &kc); err != nil {
+		return errors.Wrap(err, ErrInvalidKubeconfig.Error())
+	}
+
+	if kc.Kind != "" && kc.Kind != "Config" {
+		return errors.Wrapf(ErrInvalidKubeconfig, "kind must be Config, got %q", kc.Kind)
+	}
+
+	if len(kc.Contexts) == 0 {
+		return errors.Wrap(ErrInvalidKubeconfig, "at least one context is required")
+	}
+
+	servers := make(map[string]string, len(kc.Clusters))
+	for _, c := range kc.Clusters {
+		servers[c.Name] = c.Cluster.Server
+	}
+	users := make(map[string]kubeconfigUser, len(kc.Users))
+	for _, u := range kc.Users {
+		users[u.Name] = u.User
+	}
+
+	contexts := make(map[string]bool, len(kc.Contexts))
+	for _, c := range kc.Contexts {
+		contexts[c.Name] = true
+
+		server, ok := servers[c.Context.Cluster]
+		if !ok {
+			return errors.Wrapf(ErrInvalidKubeconfig, "context %q references unknown cluster %q", c.Name, c.Context.Cluster)
+		}
+		if err := validateKubeconfigServer(server); err != nil {
+			return errors.Wrapf(ErrInvalidKubeconfig, "cluster %q: %s", c.Context.Cluster, err)
+		}
+
+		user, ok := users[c.Context.User]
+		if !ok {
+			return errors.Wrapf(ErrInvalidKubeconfig, "context %q references unknown user %q", c.Name, c.Context.User)
+		}
+		if !user.hasAuthMethod() {
+			return errors.Wrapf(ErrInvalidKubeconfig, "user %q has no authentication method: one of token, client certificate and key, username and password, exec or auth-provider is required", c.Context.User)
+		}
+	}
+
+	if kc.CurrentContext != "" && !contexts[kc.CurrentContext] {
+		return errors.Wrapf(ErrInvalidKubeconfig, "current-context %q does not match any context", kc.CurrentContext)
+	}
+
+	return nil
+}
+
+// validateKubeconfigServer returns an error if the server of a kubeconfig cluster is not an https URL.
+func validateKubeconfigServer(server string) error {
+	if server == "" {
+		return fmt.Errorf("server is required")
+	}
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return fmt.Errorf("invalid server URL %q: %s", server, err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("server URL %q must be an https URL", server)
+	}
+
+	return nil
+}
+
+// KubeconfigSHA256 returns the hex encoded SHA-256 checksum of the given kubeconfig.
+// The checksum ignores the formatting of the kubeconfig, so that the kubeconfig returned by the Qovery API has the same
+// checksum as the one that was set even if its whitespace or indentation differ.
+func KubeconfigSHA256(content string) string {
+	sum := sha256.Sum256([]byte(normalizeKubeconfig(content)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeKubeconfig returns the kubeconfig re-encoded in YAML, or without its surrounding whitespace if it cannot be parsed.
+func normalizeKubeconfig(content string) string {
+	var document any
+	if err := yaml.Unmarshal([]byte(content), &document); err == nil && document != nil {
+		if normalized, err := yaml.Marshal(document); err == nil {
+			return string(normalized)
+		}
+	}
+
+	return strings.TrimSpace(content)
+}
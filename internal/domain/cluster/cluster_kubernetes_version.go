@@ -0,0 +1,146 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// ErrInvalidKubernetesVersion is returned if a kubernetes version cannot be parsed.
+	ErrInvalidKubernetesVersion = errors.New("invalid kubernetes version")
+	// ErrKubernetesVersionDowngrade is returned if the kubernetes version of a cluster would be downgraded.
+	ErrKubernetesVersionDowngrade = errors.New("the kubernetes version of a cluster cannot be downgraded")
+	// ErrKubernetesVersionSkipMinor is returned if an upgrade of the kubernetes version of a cluster would skip a minor version.
+	ErrKubernetesVersionSkipMinor = errors.New("the kubernetes version of a cluster can only be upgraded one minor version at a time")
+	// ErrUnknownKubernetesVersion is returned if the current kubernetes version of a cluster is not known.
+	ErrUnknownKubernetesVersion = errors.New("the current kubernetes version of the cluster is unknown")
+	// ErrKubernetesVersionUpgradeRequiresDeployedCluster is returned if the kubernetes version of a cluster that is not to be deployed would be upgraded.
+	ErrKubernetesVersionUpgradeRequiresDeployedCluster = errors.New("the kubernetes version of a cluster can only be upgraded when its state is DEPLOYED")
+	// ErrUnexpectedKubernetesVersion is returned if the cluster does not run the expected kubernetes version after an upgrade.
+	ErrUnexpectedKubernetesVersion = errors.New("cluster reached an unexpected kubernetes version")
+	// ErrUnsupportedKubernetesVersion is returned if a kubernetes version is not supported for the clusters of a cloud provider.
+	ErrUnsupportedKubernetesVersion = errors.New("unsupported kubernetes version")
+	// ErrKubernetesVersionNotDefault is returned if a cluster would be created with another kubernetes version than the default one.
+	ErrKubernetesVersionNotDefault = errors.New("new clusters are created with the default kubernetes version")
+)
+
+// SupportedKubernetesVersions contains the kubernetes versions supported by Qovery for each cloud provider, from the oldest to the newest.
+// The newest version is the default one, with which the Qovery API creates the clusters.
+// The Qovery API does not expose the supported versions, so the list mirrors the versions offered for the clusters by the
+// Qovery console, and must be updated with each release of the provider following a version added or retired by Qovery.
+// EKS Anywhere (ON_PREMISE) clusters run the kubernetes version of their own infrastructure.
+var SupportedKubernetesVersions = map[CloudProvider][]string{
+	CloudProviderAWS:   {"1.31", "1.32", "1.33"},
+	CloudProviderAzure: {"1.31", "1.32", "1.33"},
+	CloudProviderGCP:   {"1.31", "1.32", "1.33"},
+	CloudProviderSCW:   {"1.31", "1.32", "1.33"},
+}
+
+// KubernetesVersion is the minor version of kubernetes run by a cluster, patch versions being managed by the cloud provider.
+type KubernetesVersion struct {
+	Major int
+	Minor int
+}
+
+// String returns the string value of a KubernetesVersion, e.g. `1.31`.
+func (v KubernetesVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is older than, the same as or newer than other.
+func (v KubernetesVersion) Compare(other KubernetesVersion) int {
+	switch {
+	case v.Major < other.Major, v.Major == other.Major && v.Minor < other.Minor:
+		return -1
+	case v.Major == other.Major && v.Minor == other.Minor:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// NewKubernetesVersionFromString tries to turn a string into a KubernetesVersion.
+// It accepts `1.31`, `v1.31` and versions with a patch or a suffix such as `1.31.2` or `v1.31.2-eks-1`.
+func NewKubernetesVersionFromString(v string) (*KubernetesVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".", 3)
+	if len(parts) < 2 {
+		return nil, errors.Wrapf(ErrInvalidKubernetesVersion, "%q: expected a version such as 1.31", v)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return nil, errors.Wrapf(ErrInvalidKubernetesVersion, "%q: invalid major version", v)
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil || minor < 0 {
+		return nil, errors.Wrapf(ErrInvalidKubernetesVersion, "%q: invalid minor version", v)
+	}
+
+	return &KubernetesVersion{Major: major, Minor: minor}, nil
+}
+
+// IsSameKubernetesVersion returns a bool to tell whether both versions are the same minor version.
+// Versions that cannot be parsed are only the same when they are equal.
+func IsSameKubernetesVersion(a string, b string) bool {
+	va, errA := NewKubernetesVersionFromString(a)
+	vb, errB := NewKubernetesVersionFromString(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return va.Compare(*vb) == 0
+}
+
+// ValidateKubernetesVersionUpgrade returns an error to tell whether a cluster running the current version can be brought to the target version.
+// Only upgrades to the next minor version are allowed: downgrades and upgrades skipping a minor version are refused.
+func ValidateKubernetesVersionUpgrade(current string, target string) error {
+	currentVersion, err := NewKubernetesVersionFromString(current)
+	if err != nil {
+		return err
+	}
+	targetVersion, err := NewKubernetesVersionFromString(target)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case targetVersion.Compare(*currentVersion) < 0:
+		return errors.Wrapf(ErrKubernetesVersionDowngrade, "from %s to %s", currentVersion, targetVersion)
+	case targetVersion.Major != currentVersion.Major || targetVersion.Minor > currentVersion.Minor+1:
+		return errors.Wrapf(
+			ErrKubernetesVersionSkipMinor,
+			"from %s to %s: upgrade to %d.%d first", currentVersion, targetVersion, currentVersion.Major, currentVersion.Minor+1,
+		)
+	default:
+		return nil
+	}
+}
+
+// ValidateKubernetesVersionCreation returns an error to tell whether a cluster of the cloud provider is expected to be created with the given version.
+// The Qovery API creates the clusters with the default version, the newest supported one, and does not take the version
+// on creation: as a cluster cannot be downgraded, another version could only be reached by failing after the creation.
+// As SupportedKubernetesVersions may lag behind the API, the result is only a hint: the version of a new cluster is
+// checked against the one returned by the API once the cluster is created.
+// The versions of the cloud providers without supported versions, such as EKS Anywhere, are not validated.
+func ValidateKubernetesVersionCreation(cloudProvider CloudProvider, version string) error {
+	targetVersion, err := NewKubernetesVersionFromString(version)
+	if err != nil {
+		return err
+	}
+
+	supported := SupportedKubernetesVersions[cloudProvider]
+	if len(supported) == 0 {
+		return nil
+	}
+	if !slices.ContainsFunc(supported, func(v string) bool { return IsSameKubernetesVersion(v, version) }) {
+		return errors.Wrapf(ErrUnsupportedKubernetesVersion, "%s for %s clusters: supported versions are %v", targetVersion, cloudProvider, supported)
+	}
+	if defaultVersion := supported[len(supported)-1]; !IsSameKubernetesVersion(defaultVersion, version) {
+		return errors.Wrapf(ErrKubernetesVersionNotDefault, "%s: new %s clusters run %s", targetVersion, cloudProvider, defaultVersion)
+	}
+
+	return nil
+}
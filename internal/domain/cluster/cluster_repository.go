@@ -0,0 +1,151 @@
+package cluster
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=ClusterRepository --filename=cluster_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+)
+
+var (
+	// ErrPartiallyManagedKedaNotSupported is returned if keda is set on a PARTIALLY_MANAGED cluster.
+	ErrPartiallyManagedKedaNotSupported = errors.New("keda is not supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
+	// ErrPartiallyManagedFeaturesNotSupported is returned if features are set on a PARTIALLY_MANAGED cluster.
+	ErrPartiallyManagedFeaturesNotSupported = errors.New("features (vpc_subnet, static_ip, existing_vpc, karpenter, gke_kms_key) are not supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
+	// ErrPartiallyManagedInfrastructureChartsRequired is returned if a PARTIALLY_MANAGED cluster has no infrastructure charts parameters.
+	ErrPartiallyManagedInfrastructureChartsRequired = errors.New("infrastructure_charts_parameters is required when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
+	// ErrPartiallyManagedIPAddressPoolsRequired is returned if a PARTIALLY_MANAGED cluster has no MetalLB ip address pools.
+	ErrPartiallyManagedIPAddressPoolsRequired = errors.New("infrastructure_charts_parameters.metal_lb_parameters.ip_address_pools is required and must not be empty for PARTIALLY_MANAGED mode")
+	// ErrInfrastructureChartsOnlyForPartiallyManaged is returned if infrastructure charts parameters are set on a cluster that is not PARTIALLY_MANAGED.
+	ErrInfrastructureChartsOnlyForPartiallyManaged = errors.New("infrastructure_charts_parameters is only supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
+	// ErrKarpenterRequired is returned if a new AWS MANAGED cluster is created without Karpenter.
+	ErrKarpenterRequired = errors.New("Karpenter is required for new EKS (AWS MANAGED) clusters. Please configure the Karpenter feature in the cluster configuration")
+)
+
+// Repository represents the interface to implement to handle the persistence of a Cluster.
+type Repository interface {
+	Create(ctx context.Context, organizationID string, request UpsertRepositoryRequest) (*Cluster, error)
+	Get(ctx context.Context, organizationID string, clusterID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*Cluster, error)
+	Update(ctx context.Context, organizationID string, clusterID string, request UpsertRepositoryRequest) (*Cluster, error)
+	Delete(ctx context.Context, organizationID string, clusterID string) error
+	GetStatus(ctx context.Context, organizationID string, clusterID string) (*State, error)
+	Deploy(ctx context.Context, organizationID string, clusterID string) error
+	Stop(ctx context.Context, organizationID string, clusterID string) error
+	Upgrade(ctx context.Context, organizationID string, clusterID string) error
+	GetKubeconfig(ctx context.Context, organizationID string, clusterID string) (string, error)
+	SetKubeconfig(ctx context.Context, organizationID string, clusterID string, kubeconfig string) error
+	GetRoutingTable(ctx context.Context, organizationID string, clusterID string) (RoutingTable, error)
+	EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable RoutingTable) (RoutingTable, error)
+}
+
+// CloudProviderCredentials represents the credentials a Cluster uses to reach its cloud provider.
+type CloudProviderCredentials struct {
+	ID   string `validate:"required"`
+	Name string
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update a Cluster.
+// CloudProviderCredentials is only set when the credentials must be (re)specified, i.e. on creation or when they change.
+// RoutingTable is only applied when it is not empty.
+type UpsertRepositoryRequest struct {
+	Name                           string `validate:"required"`
+	CloudProvider                  string `validate:"required"`
+	Region                         string `validate:"required"`
+	Description                    *string
+	KubernetesMode                 string `validate:"required"`
+	CloudProviderCredentials       *CloudProviderCredentials
+	InstanceType                   *string
+	DiskSize                       *int32
+	MinRunningNodes                *int32
+	MaxRunningNodes                *int32
+	Production                     *bool
+	Features                       []qovery.ClusterRequestFeaturesInner
+	Keda                           *qovery.ClusterKeda
+	InfrastructureChartsParameters *qovery.ClusterInfrastructureChartsParameters
+	LabelsGroupIds                 []string
+	SecretManagerAccesses          []qovery.SecretManagerAccessRequest
+	RoutingTable                   RoutingTable
+	AdvancedSettingsJson           string
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := CloudProvider(r.CloudProvider).Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCloudProviderParam.Error())
+	}
+
+	if err := KubernetesMode(r.KubernetesMode).Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidKubernetesModeParam.Error())
+	}
+
+	if err := r.RoutingTable.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if !r.IsPartiallyManaged() {
+		if r.InfrastructureChartsParameters != nil {
+			return ErrInfrastructureChartsOnlyForPartiallyManaged
+		}
+		return nil
+	}
+
+	if r.Keda != nil {
+		return ErrPartiallyManagedKedaNotSupported
+	}
+
+	if r.InfrastructureChartsParameters == nil {
+		return ErrPartiallyManagedInfrastructureChartsRequired
+	}
+
+	if r.InfrastructureChartsParameters.MetalLbParameters == nil || len(r.InfrastructureChartsParameters.MetalLbParameters.IpAddressPools) == 0 {
+		return ErrPartiallyManagedIPAddressPoolsRequired
+	}
+
+	if len(r.Features) > 0 {
+		return ErrPartiallyManagedFeaturesNotSupported
+	}
+
+	return nil
+}
+
+// ValidateCreation returns an error to tell whether the UpsertRepositoryRequest can be used to create a new Cluster.
+// On top of Validate, new AWS MANAGED (EKS) clusters must enable Karpenter.
+func (r UpsertRepositoryRequest) ValidateCreation() error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	if CloudProvider(r.CloudProvider) == CloudProviderAWS && KubernetesMode(r.KubernetesMode) == KubernetesModeManaged && !r.HasKarpenter() {
+		return ErrKarpenterRequired
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// IsPartiallyManaged returns a bool to tell whether the request targets a PARTIALLY_MANAGED (EKS Anywhere) cluster.
+func (r UpsertRepositoryRequest) IsPartiallyManaged() bool {
+	return KubernetesMode(r.KubernetesMode) == KubernetesModePartiallyManaged
+}
+
+// HasKarpenter returns a bool to tell whether the request enables the Karpenter feature.
+// When it does, instance type, disk size and node counts are managed by Karpenter.
+func (r UpsertRepositoryRequest) HasKarpenter() bool {
+	for _, f := range r.Features {
+		if f.Id != nil && *f.Id == FeatureIDKarpenter {
+			return true
+		}
+	}
+	return false
+}
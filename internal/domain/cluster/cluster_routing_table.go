@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"net"
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidRoute is returned if a Route is invalid.
+	ErrInvalidRoute = errors.New("invalid cluster route")
+	// ErrInvalidRouteDestinationParam is returned if the destination of a Route is not a valid CIDR block.
+	ErrInvalidRouteDestinationParam = errors.New("invalid cluster route destination param: must be a CIDR block")
+	// ErrInvalidRouteTargetParam is returned if the target of a Route is not an IP address or a resource identifier.
+	ErrInvalidRouteTargetParam = errors.New("invalid cluster route target param: must be an IP address or a resource identifier such as a peering connection or gateway id")
+	// ErrDuplicateRouteDestination is returned if two routes of a RoutingTable have the same destination.
+	ErrDuplicateRouteDestination = errors.New("cluster routes have the same destination")
+	// ErrRouteNotFound is returned if no route of a RoutingTable has the given destination.
+	ErrRouteNotFound = errors.New("cluster route not found")
+	// ErrRouteAlreadyExists is returned if a route is added to a RoutingTable that already has a route for its destination.
+	ErrRouteAlreadyExists = errors.New("cluster route already exists")
+)
+
+// routeTargetRegex matches the resource identifiers a route can target, e.g. `pcx-0a1b2c3d` or `projects/my-project/global/gateways/default-internet-gateway`.
+var routeTargetRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._:/-]*$`)
+
+// Route represents an entry of the routing table of a Cluster.
+type Route struct {
+	Description string `validate:"required"`
+	Destination string `validate:"required"`
+	Target      string `validate:"required"`
+}
+
+// Validate returns an error to tell whether the Route is valid or not.
+func (r Route) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidRoute.Error())
+	}
+
+	ip, network, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return errors.Wrap(err, ErrInvalidRouteDestinationParam.Error())
+	}
+	if !ip.Equal(network.IP) {
+		return errors.Wrapf(ErrInvalidRouteDestinationParam, "%s has host bits set, did you mean %s", r.Destination, network)
+	}
+
+	// A CIDR block target usually means the destination and the target have been swapped.
+	if _, _, err := net.ParseCIDR(r.Target); err == nil || !routeTargetRegex.MatchString(r.Target) {
+		return errors.Wrapf(ErrInvalidRouteTargetParam, "got %q", r.Target)
+	}
+
+	return nil
+}
+
+// Overlaps returns true if the destination of the Route overlaps the destination of the given one.
+// Both routes are expected to be valid.
+func (r Route) Overlaps(other Route) bool {
+	_, network, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return false
+	}
+	_, otherNetwork, err := net.ParseCIDR(other.Destination)
+	if err != nil {
+		return false
+	}
+
+	return network.Contains(otherNetwork.IP) || otherNetwork.Contains(network.IP)
+}
+
+// HasSameDestination returns true if the destination of the Route is the same CIDR block as the destination of the given one.
+// Both routes are expected to be valid.
+func (r Route) HasSameDestination(other Route) bool {
+	_, network, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return false
+	}
+	_, otherNetwork, err := net.ParseCIDR(other.Destination)
+	if err != nil {
+		return false
+	}
+
+	return network.String() == otherNetwork.String()
+}
+
+// RoutingTable represents the custom routes of a Cluster.
+type RoutingTable []Route
+
+// Validate returns an error to tell whether the RoutingTable is valid or not.
+// Two routes cannot have the same destination, but the destination of a route can contain the destination of another
+// one, e.g. `10.0.0.0/8` and `10.1.0.0/16`: the traffic is then routed by the most specific route.
+func (rt RoutingTable) Validate() error {
+	for _, r := range rt {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range rt {
+		for _, other := range rt[i+1:] {
+			if r.HasSameDestination(other) {
+				return errors.Wrapf(ErrDuplicateRouteDestination, "%s and %s", r.Destination, other.Destination)
+			}
+		}
+	}
+
+	return nil
+}
+
+// OverlappingDestinations returns the pairs of overlapping destinations of the RoutingTable, in the order of its routes.
+// Overlapping routes are valid, see Validate, but are worth a warning as they are often a mistake.
+func (rt RoutingTable) OverlappingDestinations() [][2]string {
+	var overlaps [][2]string
+	for i, r := range rt {
+		for _, other := range rt[i+1:] {
+			if r.Overlaps(other) && !r.HasSameDestination(other) {
+				overlaps = append(overlaps, [2]string{r.Destination, other.Destination})
+			}
+		}
+	}
+
+	return overlaps
+}
+
+// Find returns the route of the RoutingTable with the given destination.
+func (rt RoutingTable) Find(destination string) (*Route, error) {
+	for _, r := range rt {
+		if r.Destination == destination {
+			return &r, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrRouteNotFound, "no route for destination %s", destination)
+}
+
+// WithRoute returns a copy of the RoutingTable where the route for the destination of the given one is replaced, or added if there is none.
+func (rt RoutingTable) WithRoute(route Route) RoutingTable {
+	result := make(RoutingTable, 0, len(rt)+1)
+	replaced := false
+	for _, r := range rt {
+		if r.Destination == route.Destination {
+			r = route
+			replaced = true
+		}
+		result = append(result, r)
+	}
+
+	if !replaced {
+		result = append(result, route)
+	}
+	return result
+}
+
+// WithoutRoute returns a copy of the RoutingTable without the route for the given destination.
+func (rt RoutingTable) WithoutRoute(destination string) RoutingTable {
+	result := make(RoutingTable, 0, len(rt))
+	for _, r := range rt {
+		if r.Destination != destination {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	ErrFailedToCreateCluster = errors.New("failed to create cluster")
+	ErrFailedToGetCluster    = errors.New("failed to get cluster")
+	ErrFailedToUpdateCluster = errors.New("failed to update cluster")
+	ErrFailedToDeleteCluster = errors.New("failed to delete cluster")
+
+	ErrFailedToGetRoutingTable    = errors.New("failed to get cluster routing table")
+	ErrFailedToUpdateRoutingTable = errors.New("failed to update cluster routing table")
+
+	// ErrPartiallyManagedKubeconfigRequired is returned if a PARTIALLY_MANAGED cluster is upserted without kubeconfig.
+	ErrPartiallyManagedKubeconfigRequired = errors.New("kubeconfig is required when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
+	// ErrKarpenterMigrationNotSupported is returned if Karpenter is enabled on an existing cluster that does not use it.
+	ErrKarpenterMigrationNotSupported = errors.New("It is not possible to migrate to Karpenter using terraform")
+	// ErrUnexpectedState is returned if the cluster ends up in an error state while waiting for another state.
+	ErrUnexpectedState = errors.New("cluster reached an unexpected state")
+)
+
+// Service represents the interface to implement to handle the domain logic of a Cluster.
+type Service interface {
+	Create(ctx context.Context, organizationID string, request UpsertServiceRequest) (*Cluster, error)
+	Get(ctx context.Context, organizationID string, clusterID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*Cluster, error)
+	Update(ctx context.Context, organizationID string, clusterID string, request UpsertServiceRequest) (*Cluster, error)
+	Delete(ctx context.Context, organizationID string, clusterID string) error
+	GetKubeconfig(ctx context.Context, organizationID string, clusterID string) (string, error)
+	SetKubeconfig(ctx context.Context, organizationID string, clusterID string, kubeconfig string) error
+	GetRoutingTable(ctx context.Context, organizationID string, clusterID string) (RoutingTable, error)
+	EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable RoutingTable) (RoutingTable, error)
+	GetRoute(ctx context.Context, organizationID string, clusterID string, destination string) (*Route, error)
+	AddRoute(ctx context.Context, organizationID string, clusterID string, route Route) (*Route, error)
+	UpdateRoute(ctx context.Context, organizationID string, clusterID string, route Route) (*Route, error)
+	DeleteRoute(ctx context.Context, organizationID string, clusterID string, destination string) error
+}
+
+// UpsertServiceRequest represents the parameters needed to create & update a Cluster.
+// Kubeconfig is only pushed for PARTIALLY_MANAGED clusters, when it is set.
+// ForceUpdate redeploys an already DEPLOYED cluster so that changes only applied on deploy are taken into account.
+// KubernetesVersion, when set, is the kubernetes version the cluster is upgraded to once it is in its desired state.
+type UpsertServiceRequest struct {
+	ClusterUpsertRequest UpsertRepositoryRequest
+	DesiredState         State
+	ForceUpdate          bool
+	Kubeconfig           *string
+	KubernetesVersion    *string
+}
+
+// Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) Validate() error {
+	if r.ClusterUpsertRequest.IsPartiallyManaged() && (r.Kubeconfig == nil || *r.Kubeconfig == "") {
+		return ErrPartiallyManagedKubeconfigRequired
+	}
+
+	if err := r.ClusterUpsertRequest.Validate(); err != nil {
+		return err
+	}
+
+	if !slices.Contains(AllowedDesiredStateValues, r.DesiredState) {
+		return errors.Wrapf(ErrInvalidStateParam, "valid values are %v", AllowedDesiredStateValues)
+	}
+
+	if r.KubernetesVersion != nil {
+		if _, err := NewKubernetesVersionFromString(*r.KubernetesVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateCreation returns an error to tell whether the UpsertServiceRequest is valid to create a cluster.
+func (r UpsertServiceRequest) ValidateCreation() error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	return r.ClusterUpsertRequest.ValidateCreation()
+}
+
+// IsValid returns a bool to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) IsValid() bool {
+	return r.Validate() == nil
+}
@@ -0,0 +1,116 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// State is an enum that contains all the valid values of a cluster state.
+type State string
+
+const (
+	StateBuilding           State = "BUILDING"
+	StateBuildError         State = "BUILD_ERROR"
+	StateCanceled           State = "CANCELED"
+	StateCanceling          State = "CANCELING"
+	StateDeleted            State = "DELETED"
+	StateDeleteError        State = "DELETE_ERROR"
+	StateDeleteQueued       State = "DELETE_QUEUED"
+	StateDeleting           State = "DELETING"
+	StateDeployed           State = "DEPLOYED"
+	StateDeploying          State = "DEPLOYING"
+	StateDeploymentError    State = "DEPLOYMENT_ERROR"
+	StateDeploymentQueued   State = "DEPLOYMENT_QUEUED"
+	StateDryRun             State = "DRY_RUN"
+	StateQueued             State = "QUEUED"
+	StateReady              State = "READY"
+	StateStopped            State = "STOPPED"
+	StateStopping           State = "STOPPING"
+	StateStopError          State = "STOP_ERROR"
+	StateStopQueued         State = "STOP_QUEUED"
+	StateRestartQueued      State = "RESTART_QUEUED"
+	StateRestarting         State = "RESTARTING"
+	StateRestarted          State = "RESTARTED"
+	StateRestartError       State = "RESTART_ERROR"
+	StateInvalidCredentials State = "INVALID_CREDENTIALS"
+)
+
+// AllowedStateValues contains all the valid values of a State.
+var AllowedStateValues = []State{
+	StateBuilding,
+	StateBuildError,
+	StateCanceled,
+	StateCanceling,
+	StateDeleted,
+	StateDeleteError,
+	StateDeleteQueued,
+	StateDeleting,
+	StateDeployed,
+	StateDeploying,
+	StateDeploymentError,
+	StateDeploymentQueued,
+	StateDryRun,
+	StateQueued,
+	StateReady,
+	StateStopped,
+	StateStopping,
+	StateStopError,
+	StateStopQueued,
+	StateRestartQueued,
+	StateRestarting,
+	StateRestarted,
+	StateRestartError,
+	StateInvalidCredentials,
+}
+
+// String returns the string value of a State.
+func (v State) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the State is valid or not.
+func (v State) Validate() error {
+	if slices.Contains(AllowedStateValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for State: valid values are %v", v, AllowedStateValues)
+}
+
+// IsValid returns a bool to tell whether the State is valid or not.
+func (v State) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewStateFromString tries to turn a string into a State.
+// It returns an error if the string is not a valid value.
+func NewStateFromString(v string) (*State, error) {
+	ev := State(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
+
+// AllowedDesiredStateValues contains the states a cluster can be asked to reach.
+var AllowedDesiredStateValues = []State{
+	StateDeployed,
+	StateStopped,
+	StateReady,
+}
+
+// IsFinal returns a bool to tell whether the State is final, meaning no operation is running or pending on the cluster.
+func (v State) IsFinal() bool {
+	return !strings.HasSuffix(v.String(), "ING") &&
+		!strings.Contains(v.String(), "_WAITING") &&
+		!strings.Contains(v.String(), "_QUEUED")
+}
+
+// IsError returns a bool to tell whether the State is a terminal error state.
+func (v State) IsError() bool {
+	return strings.HasSuffix(v.String(), "_ERROR")
+}
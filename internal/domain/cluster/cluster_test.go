@@ -0,0 +1,664 @@
+//go:build unit && !integration
+
+package cluster_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func newValidClusterParams() cluster.NewClusterParams {
+	return cluster.NewClusterParams{
+		ClusterID:      uuid.NewString(),
+		OrganizationID: uuid.NewString(),
+		Name:           "my-cluster",
+		CloudProvider:  "AWS",
+		Region:         "eu-west-3",
+		KubernetesMode: new("MANAGED"),
+		State:          new("DEPLOYED"),
+	}
+}
+
+func newValidUpsertRepositoryRequest() cluster.UpsertRepositoryRequest {
+	return cluster.UpsertRepositoryRequest{
+		Name:           "my-cluster",
+		CloudProvider:  "SCW",
+		Region:         "fr-par",
+		KubernetesMode: "MANAGED",
+	}
+}
+
+func newPartiallyManagedUpsertRepositoryRequest() cluster.UpsertRepositoryRequest {
+	request := newValidUpsertRepositoryRequest()
+	request.CloudProvider = "AWS"
+	request.KubernetesMode = "PARTIALLY_MANAGED"
+	request.InfrastructureChartsParameters = &qovery.ClusterInfrastructureChartsParameters{
+		MetalLbParameters: &qovery.ClusterInfrastructureMetalLbChartParameters{
+			IpAddressPools: []string{"10.0.0.10-10.0.0.20"},
+		},
+	}
+	return request
+}
+
+func TestNewCluster(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        func() cluster.NewClusterParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_cluster_id",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.ClusterID = "invalid"
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidClusterIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_organization_id",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.OrganizationID = ""
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidOrganizationIDParam,
+		},
+		{
+			TestName: "fail_with_empty_name",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.Name = ""
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_cloud_provider",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.CloudProvider = "DO"
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidCloudProviderParam,
+		},
+		{
+			TestName: "fail_with_invalid_kubernetes_mode",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.KubernetesMode = new("HYBRID")
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidKubernetesModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_state",
+			Params: func() cluster.NewClusterParams {
+				p := newValidClusterParams()
+				p.State = new("UNKNOWN")
+				return p
+			},
+			ExpectedError: cluster.ErrInvalidStateParam,
+		},
+		{
+			TestName: "success",
+			Params:   newValidClusterParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			params := tc.Params()
+			c, err := cluster.NewCluster(params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, c.IsValid())
+			assert.Equal(t, params.ClusterID, c.ID.String())
+			assert.Equal(t, params.OrganizationID, c.OrganizationID.String())
+			assert.Equal(t, cluster.CloudProviderAWS, c.CloudProvider)
+			require.NotNil(t, c.KubernetesMode)
+			assert.Equal(t, cluster.KubernetesModeManaged, *c.KubernetesMode)
+			require.NotNil(t, c.State)
+			assert.Equal(t, cluster.StateDeployed, *c.State)
+		})
+	}
+}
+
+func TestCluster_HasKarpenter(t *testing.T) {
+	t.Parallel()
+
+	karpenterID := cluster.FeatureIDKarpenter
+	otherID := "VPC_SUBNET"
+
+	assert.True(t, cluster.Cluster{Features: []qovery.ClusterFeatureResponse{{Id: &karpenterID}}}.HasKarpenter())
+	assert.False(t, cluster.Cluster{Features: []qovery.ClusterFeatureResponse{{Id: &otherID}}}.HasKarpenter())
+	assert.False(t, cluster.Cluster{Features: []qovery.ClusterFeatureResponse{{Id: nil}}}.HasKarpenter())
+	assert.False(t, cluster.Cluster{}.HasKarpenter())
+}
+
+func TestState_IsFinal(t *testing.T) {
+	t.Parallel()
+
+	for _, state := range []cluster.State{cluster.StateDeployed, cluster.StateStopped, cluster.StateReady, cluster.StateDeploymentError, cluster.StateDeleted} {
+		assert.True(t, state.IsFinal(), state.String())
+	}
+	for _, state := range []cluster.State{cluster.StateDeploying, cluster.StateDeploymentQueued, cluster.StateStopping, cluster.StateDeleteQueued, cluster.StateBuilding} {
+		assert.False(t, state.IsFinal(), state.String())
+	}
+
+	assert.True(t, cluster.StateDeploymentError.IsError())
+	assert.True(t, cluster.StateDeleteError.IsError())
+	assert.False(t, cluster.StateDeployed.IsError())
+}
+
+func TestRoutingTable_Validate(t *testing.T) {
+	t.Parallel()
+
+	route := cluster.Route{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-1234"}
+	assert.NoError(t, cluster.RoutingTable{route}.Validate())
+	assert.NoError(t, cluster.RoutingTable{}.Validate())
+
+	invalid := route
+	invalid.Destination = "10.1.0.0"
+	assert.ErrorContains(t, cluster.RoutingTable{route, invalid}.Validate(), cluster.ErrInvalidRouteDestinationParam.Error())
+
+	invalid = route
+	invalid.Target = ""
+	assert.ErrorContains(t, cluster.RoutingTable{invalid}.Validate(), cluster.ErrInvalidRoute.Error())
+
+	invalid = route
+	invalid.Destination = "10.1.2.3/16"
+	assert.ErrorContains(t, cluster.RoutingTable{invalid}.Validate(), "10.1.2.3/16 has host bits set, did you mean 10.1.0.0/16")
+
+	for _, target := range []string{"10.2.0.0/16", "pcx 1234", "-pcx"} {
+		invalid = route
+		invalid.Target = target
+		assert.ErrorIs(t, cluster.RoutingTable{invalid}.Validate(), cluster.ErrInvalidRouteTargetParam, target)
+	}
+	for _, target := range []string{"10.0.0.5", "fd00::1", "tgw-0a1b2c3d4e5f", "projects/my-project/global/gateways/default-internet-gateway"} {
+		valid := route
+		valid.Target = target
+		assert.NoError(t, cluster.RoutingTable{valid}.Validate(), target)
+	}
+
+	overlapping := route
+	overlapping.Destination = "10.1.128.0/17"
+	assert.NoError(t, cluster.RoutingTable{route, overlapping}.Validate())
+	assert.ErrorIs(t, cluster.RoutingTable{route, route}.Validate(), cluster.ErrDuplicateRouteDestination)
+
+	duplicate := route
+	duplicate.Target = "pcx-4e5f6a7b"
+	assert.ErrorIs(t, cluster.RoutingTable{route, duplicate}.Validate(), cluster.ErrDuplicateRouteDestination)
+
+	other := route
+	other.Destination = "10.2.0.0/16"
+	assert.NoError(t, cluster.RoutingTable{route, other}.Validate())
+}
+
+func TestRoutingTable_OverlappingDestinations(t *testing.T) {
+	t.Parallel()
+
+	newRoute := func(destination string) cluster.Route {
+		return cluster.Route{Description: "peering", Destination: destination, Target: "pcx-0a1b2c3d"}
+	}
+
+	assert.Empty(t, cluster.RoutingTable{newRoute("10.1.0.0/16"), newRoute("10.2.0.0/16")}.OverlappingDestinations())
+	assert.Empty(t, cluster.RoutingTable{newRoute("10.1.0.0/16"), newRoute("10.1.0.0/16")}.OverlappingDestinations())
+	assert.Equal(t,
+		[][2]string{{"10.0.0.0/8", "10.1.0.0/16"}, {"10.0.0.0/8", "10.2.0.0/16"}},
+		cluster.RoutingTable{newRoute("10.0.0.0/8"), newRoute("10.1.0.0/16"), newRoute("10.2.0.0/16")}.OverlappingDestinations(),
+	)
+}
+
+func TestRoutingTable_Routes(t *testing.T) {
+	t.Parallel()
+
+	first := cluster.Route{Description: "first", Destination: "10.1.0.0/16", Target: "pcx-1"}
+	second := cluster.Route{Description: "second", Destination: "10.2.0.0/16", Target: "pcx-2"}
+	routingTable := cluster.RoutingTable{first, second}
+
+	route, err := routingTable.Find(second.Destination)
+	require.NoError(t, err)
+	assert.Equal(t, second, *route)
+	_, err = routingTable.Find("10.3.0.0/16")
+	assert.ErrorIs(t, err, cluster.ErrRouteNotFound)
+
+	updated := first
+	updated.Target = "tgw-1"
+	assert.Equal(t, cluster.RoutingTable{updated, second}, routingTable.WithRoute(updated))
+
+	third := cluster.Route{Description: "third", Destination: "10.3.0.0/16", Target: "pcx-3"}
+	assert.Equal(t, cluster.RoutingTable{first, second, third}, routingTable.WithRoute(third))
+
+	assert.Equal(t, cluster.RoutingTable{second}, routingTable.WithoutRoute(first.Destination))
+	assert.Equal(t, routingTable, routingTable.WithoutRoute(third.Destination))
+	assert.Equal(t, cluster.RoutingTable{first, second}, routingTable, "the routing table must not be modified")
+}
+
+func TestValidateKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	const validKubeconfig = `apiVersion: v1
+kind: Config
+current-context: admin@my-cluster
+clusters:
+  - name: my-cluster
+    cluster:
+      server: https://10.0.0.1:6443
+      certificate-authority-data: Y2E=
+contexts:
+  - name: admin@my-cluster
+    context:
+      cluster: my-cluster
+      user: admin
+users:
+  - name: admin
+    user:
+      client-certificate-data: Y2VydA==
+      client-key-data: a2V5
+`
+
+	testCases := []struct {
+		name          string
+		kubeconfig    string
+		expectedError string
+	}{
+		{
+			name:       "valid",
+			kubeconfig: validKubeconfig,
+		},
+		{
+			name:          "not_yaml",
+			kubeconfig:    "clusters: [",
+			expectedError: cluster.ErrInvalidKubeconfig.Error(),
+		},
+		{
+			name:          "wrong_kind",
+			kubeconfig:    strings.Replace(validKubeconfig, "kind: Config", "kind: Pod", 1),
+			expectedError: `kind must be Config, got "Pod"`,
+		},
+		{
+			name:          "no_context",
+			kubeconfig:    "apiVersion: v1\nkind: Config\n",
+			expectedError: "at least one context is required",
+		},
+		{
+			name:          "unknown_current_context",
+			kubeconfig:    strings.Replace(validKubeconfig, "current-context: admin@my-cluster", "current-context: other", 1),
+			expectedError: `current-context "other" does not match any context`,
+		},
+		{
+			name:          "unknown_cluster",
+			kubeconfig:    strings.Replace(validKubeconfig, "cluster: my-cluster", "cluster: other", 1),
+			expectedError: `context "admin@my-cluster" references unknown cluster "other"`,
+		},
+		{
+			name:          "http_server",
+			kubeconfig:    strings.Replace(validKubeconfig, "https://10.0.0.1:6443", "http://10.0.0.1:6443", 1),
+			expectedError: `server URL "http://10.0.0.1:6443" must be an https URL`,
+		},
+		{
+			name:          "missing_server",
+			kubeconfig:    strings.Replace(validKubeconfig, "server: https://10.0.0.1:6443", "insecure-skip-tls-verify: true", 1),
+			expectedError: "server is required",
+		},
+		{
+			name:          "unknown_user",
+			kubeconfig:    strings.Replace(validKubeconfig, "user: admin", "user: other", 1),
+			expectedError: `context "admin@my-cluster" references unknown user "other"`,
+		},
+		{
+			name:          "client_certificate_without_key",
+			kubeconfig:    strings.Replace(validKubeconfig, "client-key-data: a2V5", "username: admin", 1),
+			expectedError: `user "admin" has no authentication method`,
+		},
+		{
+			name: "exec",
+			kubeconfig: strings.Replace(validKubeconfig, "      client-certificate-data: Y2VydA==\n      client-key-data: a2V5\n",
+				"      exec:\n        apiVersion: client.authentication.k8s.io/v1beta1\n        command: aws\n", 1),
+		},
+		{
+			name:       "token",
+			kubeconfig: strings.Replace(validKubeconfig, "client-key-data: a2V5", "token: my-token", 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := cluster.ValidateKubeconfig(tc.kubeconfig)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, cluster.ErrInvalidKubeconfig.Error())
+			assert.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestKubeconfigSHA256(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", cluster.KubeconfigSHA256(""))
+	assert.NotEqual(t, cluster.KubeconfigSHA256("a"), cluster.KubeconfigSHA256("b"))
+
+	const kubeconfig = `clusters:
+  - name: my-cluster
+    cluster:
+      server: https://10.0.0.1:6443
+users:
+  - name: my-user
+    user:
+      token: my-token
+`
+	// The kubeconfig returned by the API only differs in whitespace: line endings, indentation and trailing new lines.
+	const returned = "clusters:\r\n- name: my-cluster\r\n  cluster:\r\n    server:   https://10.0.0.1:6443\r\nusers:\r\n- name: my-user\r\n  user:\r\n    token: my-token\r\n\r\n"
+	assert.Equal(t, cluster.KubeconfigSHA256(kubeconfig), cluster.KubeconfigSHA256(returned))
+	assert.Equal(t, cluster.KubeconfigSHA256("not: [a kubeconfig"), cluster.KubeconfigSHA256("  not: [a kubeconfig\n"))
+	assert.NotEqual(t, cluster.KubeconfigSHA256(kubeconfig), cluster.KubeconfigSHA256(strings.Replace(kubeconfig, "my-token", "other-token", 1)))
+}
+
+func TestUpsertRepositoryRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       func() cluster.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_missing_region",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newValidUpsertRepositoryRequest()
+				r.Region = ""
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_cloud_provider",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newValidUpsertRepositoryRequest()
+				r.CloudProvider = "scw"
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidCloudProviderParam,
+		},
+		{
+			TestName: "fail_with_invalid_kubernetes_mode",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newValidUpsertRepositoryRequest()
+				r.KubernetesMode = "HYBRID"
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidKubernetesModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_route",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newValidUpsertRepositoryRequest()
+				r.RoutingTable = cluster.RoutingTable{{Description: "d", Destination: "nope", Target: "t"}}
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidRouteDestinationParam,
+		},
+		{
+			TestName: "fail_with_infrastructure_charts_on_managed_cluster",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newValidUpsertRepositoryRequest()
+				r.InfrastructureChartsParameters = &qovery.ClusterInfrastructureChartsParameters{}
+				return r
+			},
+			ExpectedError: cluster.ErrInfrastructureChartsOnlyForPartiallyManaged,
+		},
+		{
+			TestName: "fail_with_keda_on_partially_managed_cluster",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newPartiallyManagedUpsertRepositoryRequest()
+				r.Keda = &qovery.ClusterKeda{}
+				return r
+			},
+			ExpectedError: cluster.ErrPartiallyManagedKedaNotSupported,
+		},
+		{
+			TestName: "fail_without_infrastructure_charts_on_partially_managed_cluster",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newPartiallyManagedUpsertRepositoryRequest()
+				r.InfrastructureChartsParameters = nil
+				return r
+			},
+			ExpectedError: cluster.ErrPartiallyManagedInfrastructureChartsRequired,
+		},
+		{
+			TestName: "fail_without_ip_address_pools_on_partially_managed_cluster",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newPartiallyManagedUpsertRepositoryRequest()
+				r.InfrastructureChartsParameters.MetalLbParameters.IpAddressPools = nil
+				return r
+			},
+			ExpectedError: cluster.ErrPartiallyManagedIPAddressPoolsRequired,
+		},
+		{
+			TestName: "fail_with_features_on_partially_managed_cluster",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := newPartiallyManagedUpsertRepositoryRequest()
+				r.Features = []qovery.ClusterRequestFeaturesInner{{Id: new("STATIC_IP")}}
+				return r
+			},
+			ExpectedError: cluster.ErrPartiallyManagedFeaturesNotSupported,
+		},
+		{
+			TestName: "success_with_managed_cluster",
+			Request:  newValidUpsertRepositoryRequest,
+		},
+		{
+			TestName: "success_with_partially_managed_cluster",
+			Request:  newPartiallyManagedUpsertRepositoryRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.Request().Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpsertRepositoryRequest_ValidateCreation(t *testing.T) {
+	t.Parallel()
+
+	request := newValidUpsertRepositoryRequest()
+	request.CloudProvider = "AWS"
+	assert.ErrorIs(t, request.ValidateCreation(), cluster.ErrKarpenterRequired)
+	// Existing clusters are not required to migrate to Karpenter.
+	assert.NoError(t, request.Validate())
+
+	request.Features = []qovery.ClusterRequestFeaturesInner{{Id: new(cluster.FeatureIDKarpenter)}}
+	assert.NoError(t, request.ValidateCreation())
+
+	request.Features = nil
+	request.KubernetesMode = "SELF_MANAGED"
+	assert.NoError(t, request.ValidateCreation())
+
+	assert.NoError(t, newPartiallyManagedUpsertRepositoryRequest().ValidateCreation())
+}
+
+func TestUpsertServiceRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	request := cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: newValidUpsertRepositoryRequest(),
+		DesiredState:         cluster.StateDeployed,
+	}
+	assert.NoError(t, request.Validate())
+
+	invalid := request
+	invalid.DesiredState = cluster.StateDeleted
+	assert.ErrorIs(t, invalid.Validate(), cluster.ErrInvalidStateParam)
+
+	invalid = request
+	invalid.ClusterUpsertRequest = newPartiallyManagedUpsertRepositoryRequest()
+	assert.ErrorIs(t, invalid.Validate(), cluster.ErrPartiallyManagedKubeconfigRequired)
+
+	invalid.Kubeconfig = new("apiVersion: v1")
+	assert.NoError(t, invalid.Validate())
+
+	invalid = request
+	invalid.KubernetesVersion = new("latest")
+	assert.ErrorIs(t, invalid.Validate(), cluster.ErrInvalidKubernetesVersion)
+}
+
+func TestNewKubernetesVersionFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		version  string
+		expected string
+	}{
+		{version: "1.31", expected: "1.31"},
+		{version: "v1.31", expected: "1.31"},
+		{version: "1.31.2", expected: "1.31"},
+		{version: "v1.31.2-eks-1", expected: "1.31"},
+		{version: "1.31-gke", expected: "1.31"},
+	}
+	for _, tc := range testCases {
+		v, err := cluster.NewKubernetesVersionFromString(tc.version)
+		require.NoError(t, err, tc.version)
+		assert.Equal(t, tc.expected, v.String(), tc.version)
+	}
+
+	for _, version := range []string{"", "1", "latest", "1.x", "-1.31"} {
+		_, err := cluster.NewKubernetesVersionFromString(version)
+		assert.ErrorIs(t, err, cluster.ErrInvalidKubernetesVersion, version)
+	}
+}
+
+func TestValidateKubernetesVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		current  string
+		target   string
+		expected error
+	}{
+		{current: "1.31", target: "1.31", expected: nil},
+		{current: "1.31.4", target: "1.32", expected: nil},
+		{current: "1.31", target: "1.30", expected: cluster.ErrKubernetesVersionDowngrade},
+		{current: "1.31", target: "1.33", expected: cluster.ErrKubernetesVersionSkipMinor},
+		{current: "1.31", target: "2.0", expected: cluster.ErrKubernetesVersionSkipMinor},
+		{current: "1.31", target: "next", expected: cluster.ErrInvalidKubernetesVersion},
+	}
+	for _, tc := range testCases {
+		err := cluster.ValidateKubernetesVersionUpgrade(tc.current, tc.target)
+		if tc.expected == nil {
+			assert.NoError(t, err, "%s -> %s", tc.current, tc.target)
+		} else {
+			assert.ErrorIs(t, err, tc.expected, "%s -> %s", tc.current, tc.target)
+		}
+	}
+
+	assert.True(t, cluster.IsSameKubernetesVersion("1.31", "v1.31.2"))
+	assert.False(t, cluster.IsSameKubernetesVersion("1.31", "1.32"))
+}
+
+func TestValidateKubernetesVersionCreation(t *testing.T) {
+	t.Parallel()
+
+	supported := cluster.SupportedKubernetesVersions[cluster.CloudProviderAWS]
+	defaultVersion := supported[len(supported)-1]
+
+	testCases := []struct {
+		cloudProvider cluster.CloudProvider
+		version       string
+		expected      error
+	}{
+		{cloudProvider: cluster.CloudProviderAWS, version: defaultVersion, expected: nil},
+		{cloudProvider: cluster.CloudProviderAWS, version: "v" + defaultVersion + ".2", expected: nil},
+		{cloudProvider: cluster.CloudProviderAWS, version: supported[0], expected: cluster.ErrKubernetesVersionNotDefault},
+		{cloudProvider: cluster.CloudProviderAWS, version: "1.20", expected: cluster.ErrUnsupportedKubernetesVersion},
+		{cloudProvider: cluster.CloudProviderAWS, version: "latest", expected: cluster.ErrInvalidKubernetesVersion},
+		{cloudProvider: cluster.CloudProviderOnPremise, version: "1.20", expected: nil},
+	}
+	for _, tc := range testCases {
+		err := cluster.ValidateKubernetesVersionCreation(tc.cloudProvider, tc.version)
+		if tc.expected == nil {
+			assert.NoError(t, err, "%s %s", tc.cloudProvider, tc.version)
+		} else {
+			assert.ErrorIs(t, err, tc.expected, "%s %s", tc.cloudProvider, tc.version)
+		}
+	}
+
+	request := cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: newValidUpsertRepositoryRequest(),
+		DesiredState:         cluster.StateDeployed,
+		KubernetesVersion:    new(supported[0]),
+	}
+	assert.NoError(t, request.Validate())
+	assert.NoError(t, request.ValidateCreation())
+}
+
+// TestNewCloudProviderFromString validate that the cloud providers qovery.CloudProviderEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the cluster.CloudProvider stays up to date.
+func TestNewCloudProviderFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, cluster.AllowedCloudProviderValues, len(qovery.AllowedCloudProviderEnumEnumValues))
+	for _, qoveryCloudProvider := range qovery.AllowedCloudProviderEnumEnumValues {
+		cloudProvider, err := cluster.NewCloudProviderFromString(string(qoveryCloudProvider))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryCloudProvider), cloudProvider.String())
+	}
+}
+
+// TestNewKubernetesModeFromString validate that the kubernetes modes qovery.KubernetesEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the cluster.KubernetesMode stays up to date.
+func TestNewKubernetesModeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, cluster.AllowedKubernetesModeValues, len(qovery.AllowedKubernetesEnumEnumValues))
+	for _, qoveryKubernetesMode := range qovery.AllowedKubernetesEnumEnumValues {
+		kubernetesMode, err := cluster.NewKubernetesModeFromString(string(qoveryKubernetesMode))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryKubernetesMode), kubernetesMode.String())
+	}
+}
+
+// TestNewStateFromString validate that the states qovery.ClusterStateEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the cluster.State stays up to date.
+func TestNewStateFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, cluster.AllowedStateValues, len(qovery.AllowedClusterStateEnumEnumValues))
+	for _, qoveryState := range qovery.AllowedClusterStateEnumEnumValues {
+		state, err := cluster.NewStateFromString(string(qoveryState))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryState), state.String())
+	}
+}
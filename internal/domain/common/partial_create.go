@@ -0,0 +1,32 @@
+package common
+
+// PartialCreateError is returned when a resource was created but one of the later steps of its creation failed
+// (variables, custom domains, deployment stage attachment, advanced settings...).
+// Resource holds the resource as created so far, so that the caller can keep track of it before reporting Err.
+type PartialCreateError[T any] struct {
+	Resource *T
+	Err      error
+}
+
+// NewPartialCreateError returns a *PartialCreateError holding the given partially created resource.
+// err is returned as is if there is no resource to hold.
+func NewPartialCreateError[T any](resource *T, err error) error {
+	if resource == nil || err == nil {
+		return err
+	}
+
+	return &PartialCreateError[T]{
+		Resource: resource,
+		Err:      err,
+	}
+}
+
+// Error returns the error of the failed step.
+func (e *PartialCreateError[T]) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the failed step.
+func (e *PartialCreateError[T]) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,29 @@
+//go:build unit && !integration
+
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+func TestNewPartialCreateError(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	assert.Same(t, boom, common.NewPartialCreateError[string](nil, boom))
+	assert.NoError(t, common.NewPartialCreateError(new("created"), nil))
+
+	err := common.NewPartialCreateError(new("created"), boom)
+	assert.EqualError(t, err, "boom")
+	assert.ErrorIs(t, err, boom)
+
+	var partial *common.PartialCreateError[string]
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, "created", *partial.Resource)
+}
@@ -0,0 +1,33 @@
+package common
+
+// PartialUpdateError is returned when a resource was updated but one of the later steps of its update failed after
+// changing it, such as a variable or secret diff that was only partially applied.
+// Resource holds the resource as updated so far, so that the caller can keep track of the applied changes before
+// reporting Err.
+type PartialUpdateError[T any] struct {
+	Resource *T
+	Err      error
+}
+
+// NewPartialUpdateError returns a *PartialUpdateError holding the given partially updated resource.
+// err is returned as is if there is no resource to hold.
+func NewPartialUpdateError[T any](resource *T, err error) error {
+	if resource == nil || err == nil {
+		return err
+	}
+
+	return &PartialUpdateError[T]{
+		Resource: resource,
+		Err:      err,
+	}
+}
+
+// Error returns the error of the failed step.
+func (e *PartialUpdateError[T]) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the failed step.
+func (e *PartialUpdateError[T]) Unwrap() error {
+	return e.Err
+}
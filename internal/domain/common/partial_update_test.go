@@ -0,0 +1,29 @@
+//go:build unit && !integration
+
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+func TestNewPartialUpdateError(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	assert.Same(t, boom, common.NewPartialUpdateError[string](nil, boom))
+	assert.NoError(t, common.NewPartialUpdateError(new("updated"), nil))
+
+	err := common.NewPartialUpdateError(new("updated"), boom)
+	assert.EqualError(t, err, "boom")
+	assert.ErrorIs(t, err, boom)
+
+	var partial *common.PartialUpdateError[string]
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, "updated", *partial.Resource)
+}
@@ -0,0 +1,193 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+var (
+	// ErrNilDatabase is returned if a Database is nil.
+	ErrNilDatabase = errors.New("database cannot be nil")
+	// ErrInvalidDatabase is the error return if a Database is invalid.
+	ErrInvalidDatabase = errors.New("invalid database")
+	// ErrInvalidEnvironmentIDParam is returned if the environment id param is invalid.
+	ErrInvalidEnvironmentIDParam = errors.New("invalid environment id param")
+	// ErrInvalidDatabaseIDParam is returned if the database id param is invalid.
+	ErrInvalidDatabaseIDParam = errors.New("invalid database id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid database name param")
+	// ErrInvalidTypeParam is returned if the type param is invalid.
+	ErrInvalidTypeParam = errors.New("invalid database type param")
+	// ErrInvalidVersionParam is returned if the version param is invalid.
+	ErrInvalidVersionParam = errors.New("invalid database version param")
+	// ErrInvalidModeParam is returned if the mode param is invalid.
+	ErrInvalidModeParam = errors.New("invalid database mode param")
+	// ErrInvalidAccessibilityParam is returned if the accessibility param is invalid.
+	ErrInvalidAccessibilityParam = errors.New("invalid database accessibility param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid database upsert request")
+)
+
+type Database struct {
+	ID                  uuid.UUID `validate:"required"`
+	EnvironmentID       uuid.UUID `validate:"required"`
+	Name                string    `validate:"required"`
+	IconUri             string
+	Type                Type   `validate:"required"`
+	Version             string `validate:"required"`
+	Mode                Mode   `validate:"required"`
+	Accessibility       *Accessibility
+	CPU                 *int32
+	Memory              *int32
+	Storage             *int32
+	InstanceType        *string
+	ExternalHost        string
+	InternalHost        string
+	Port                *int32
+	Login               string
+	Password            string
+	DeploymentStageID   string
+	IsSkipped           bool
+	AnnotationsGroupIds []string
+	LabelsGroupIds      []string
+}
+
+// Validate returns an error to tell whether the Database domain model is valid or not.
+func (d Database) Validate() error {
+	if err := d.Type.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if err := d.Mode.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if d.Accessibility != nil {
+		if err := d.Accessibility.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidDatabase.Error())
+		}
+	}
+
+	if err := validator.New().Struct(d); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Database domain model is valid or not.
+func (d Database) IsValid() bool {
+	return d.Validate() == nil
+}
+
+// SetInternalHost takes the variables of the environment of the database and sets the attribute InternalHost.
+// The internal host is only exposed through the built-in variable `QOVERY_{DB-TYPE}_Z{DB-ID}_HOST_INTERNAL`.
+func (d *Database) SetInternalHost(environmentVariables variable.Variables) {
+	key := fmt.Sprintf("QOVERY_%s_Z%s_HOST_INTERNAL", d.Type, strings.ToUpper(strings.Split(d.ID.String(), "-")[0]))
+
+	d.InternalHost = ""
+	for _, v := range environmentVariables {
+		if v.Scope == variable.ScopeBuiltIn && v.Key == key {
+			d.InternalHost = v.Value
+			return
+		}
+	}
+}
+
+// NewDatabaseParams represents the arguments needed to create a Database.
+type NewDatabaseParams struct {
+	DatabaseID          string
+	EnvironmentID       string
+	Name                string
+	IconUri             string
+	Type                string
+	Version             string
+	Mode                string
+	Accessibility       *string
+	CPU                 *int32
+	Memory              *int32
+	Storage             *int32
+	InstanceType        *string
+	ExternalHost        string
+	Port                *int32
+	Login               string
+	Password            string
+	DeploymentStageID   string
+	IsSkipped           bool
+	AnnotationsGroupIds []string
+	LabelsGroupIds      []string
+}
+
+// NewDatabase returns a new instance of a Database domain model.
+func NewDatabase(params NewDatabaseParams) (*Database, error) {
+	databaseUUID, err := uuid.Parse(params.DatabaseID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidDatabaseIDParam.Error())
+	}
+
+	environmentUUID, err := uuid.Parse(params.EnvironmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	dbType, err := NewTypeFromString(params.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidTypeParam.Error())
+	}
+
+	if params.Version == "" {
+		return nil, ErrInvalidVersionParam
+	}
+
+	mode, err := NewModeFromString(params.Mode)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidModeParam.Error())
+	}
+
+	var accessibility *Accessibility
+	if params.Accessibility != nil {
+		accessibility, err = NewAccessibilityFromString(*params.Accessibility)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidAccessibilityParam.Error())
+		}
+	}
+
+	d := &Database{
+		ID:                  databaseUUID,
+		EnvironmentID:       environmentUUID,
+		Name:                params.Name,
+		IconUri:             params.IconUri,
+		Type:                *dbType,
+		Version:             params.Version,
+		Mode:                *mode,
+		Accessibility:       accessibility,
+		CPU:                 params.CPU,
+		Memory:              params.Memory,
+		Storage:             params.Storage,
+		InstanceType:        params.InstanceType,
+		ExternalHost:        params.ExternalHost,
+		Port:                params.Port,
+		Login:               params.Login,
+		Password:            params.Password,
+		DeploymentStageID:   params.DeploymentStageID,
+		IsSkipped:           params.IsSkipped,
+		AnnotationsGroupIds: params.AnnotationsGroupIds,
+		LabelsGroupIds:      params.LabelsGroupIds,
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Accessibility is an enum that contains all the valid values of a database accessibility.
+type Accessibility string
+
+const (
+	AccessibilityPrivate Accessibility = "PRIVATE"
+	AccessibilityPublic  Accessibility = "PUBLIC"
+)
+
+// AllowedAccessibilityValues contains all the valid values of a Accessibility.
+var AllowedAccessibilityValues = []Accessibility{
+	AccessibilityPrivate,
+	AccessibilityPublic,
+}
+
+// String returns the string value of a Accessibility.
+func (v Accessibility) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the Accessibility is valid or not.
+func (v Accessibility) Validate() error {
+	if slices.Contains(AllowedAccessibilityValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for Accessibility: valid values are %v", v, AllowedAccessibilityValues)
+}
+
+// IsValid returns a bool to tell whether the Accessibility is valid or not.
+func (v Accessibility) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewAccessibilityFromString tries to turn a string into a Accessibility.
+// It returns an error if the string is not a valid value.
+func NewAccessibilityFromString(v string) (*Accessibility, error) {
+	ev := Accessibility(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
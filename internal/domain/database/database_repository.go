@@ -0,0 +1,65 @@
+package database
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=DatabaseRepository --filename=database_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+// Repository represents the interface to implement to handle the persistence of a Database.
+type Repository interface {
+	Create(ctx context.Context, environmentID string, request UpsertRepositoryRequest) (*Database, error)
+	Get(ctx context.Context, databaseID string) (*Database, error)
+	Update(ctx context.Context, databaseID string, request UpsertRepositoryRequest) (*Database, error)
+	Delete(ctx context.Context, databaseID string) error
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update a Database.
+// Type, Mode and Storage cannot be updated and are only taken into account on creation.
+type UpsertRepositoryRequest struct {
+	Name                string `validate:"required"`
+	IconUri             *string
+	Type                string `validate:"required"`
+	Version             string `validate:"required"`
+	Mode                string `validate:"required"`
+	Accessibility       *string
+	CPU                 *int32
+	Memory              *int32
+	Storage             *int32
+	InstanceType        *string
+	DeploymentStageID   string
+	IsSkipped           bool
+	AnnotationsGroupIds []string
+	LabelsGroupIds      []string
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := Type(r.Type).Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidTypeParam.Error())
+	}
+
+	if err := Mode(r.Mode).Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidModeParam.Error())
+	}
+
+	if r.Accessibility != nil {
+		if err := Accessibility(*r.Accessibility).Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidAccessibilityParam.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
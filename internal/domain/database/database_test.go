@@ -0,0 +1,212 @@
+//go:build unit && !integration
+
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+func newValidDatabaseParams() database.NewDatabaseParams {
+	return database.NewDatabaseParams{
+		DatabaseID:    uuid.NewString(),
+		EnvironmentID: uuid.NewString(),
+		Name:          "my-database",
+		Type:          "POSTGRESQL",
+		Version:       "16",
+		Mode:          "CONTAINER",
+		Accessibility: new("PRIVATE"),
+	}
+}
+
+func TestNewDatabase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        func() database.NewDatabaseParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_database_id",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.DatabaseID = "invalid"
+				return p
+			},
+			ExpectedError: database.ErrInvalidDatabaseIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_environment_id",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.EnvironmentID = ""
+				return p
+			},
+			ExpectedError: database.ErrInvalidEnvironmentIDParam,
+		},
+		{
+			TestName: "fail_with_empty_name",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.Name = ""
+				return p
+			},
+			ExpectedError: database.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_type",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.Type = "ORACLE"
+				return p
+			},
+			ExpectedError: database.ErrInvalidTypeParam,
+		},
+		{
+			TestName: "fail_with_empty_version",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.Version = ""
+				return p
+			},
+			ExpectedError: database.ErrInvalidVersionParam,
+		},
+		{
+			TestName: "fail_with_invalid_mode",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.Mode = "SERVERLESS"
+				return p
+			},
+			ExpectedError: database.ErrInvalidModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_accessibility",
+			Params: func() database.NewDatabaseParams {
+				p := newValidDatabaseParams()
+				p.Accessibility = new("INTERNAL")
+				return p
+			},
+			ExpectedError: database.ErrInvalidAccessibilityParam,
+		},
+		{
+			TestName: "success",
+			Params:   newValidDatabaseParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			params := tc.Params()
+			db, err := database.NewDatabase(params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, db)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, db.IsValid())
+			assert.Equal(t, params.DatabaseID, db.ID.String())
+			assert.Equal(t, params.Name, db.Name)
+			assert.Equal(t, database.TypePostgreSQL, db.Type)
+			assert.Equal(t, database.ModeContainer, db.Mode)
+			require.NotNil(t, db.Accessibility)
+			assert.Equal(t, database.AccessibilityPrivate, *db.Accessibility)
+		})
+	}
+}
+
+func TestDatabase_SetInternalHost(t *testing.T) {
+	t.Parallel()
+
+	db, err := database.NewDatabase(newValidDatabaseParams())
+	require.NoError(t, err)
+
+	key := "QOVERY_POSTGRESQL_Z" + strings.ToUpper(strings.Split(db.ID.String(), "-")[0]) + "_HOST_INTERNAL"
+	vars := variable.Variables{
+		{ID: uuid.New(), Key: key, Value: "not-built-in", Scope: variable.ScopeEnvironment},
+		{ID: uuid.New(), Key: key, Value: "zdb-internal", Scope: variable.ScopeBuiltIn},
+	}
+
+	db.SetInternalHost(vars)
+	assert.Equal(t, "zdb-internal", db.InternalHost)
+
+	// The internal host is cleared once the variable exposing it is gone.
+	db.SetInternalHost(vars[:1])
+	assert.Empty(t, db.InternalHost)
+}
+
+func TestUpsertRepositoryRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	request := database.UpsertRepositoryRequest{
+		Name:    "my-database",
+		Type:    "REDIS",
+		Version: "7",
+		Mode:    "MANAGED",
+	}
+	assert.NoError(t, request.Validate())
+
+	invalid := request
+	invalid.Type = "redis"
+	assert.ErrorContains(t, invalid.Validate(), database.ErrInvalidTypeParam.Error())
+
+	invalid = request
+	invalid.Mode = ""
+	assert.ErrorContains(t, invalid.Validate(), database.ErrInvalidUpsertRequest.Error())
+
+	invalid = request
+	invalid.Accessibility = new("INTERNAL")
+	assert.ErrorContains(t, invalid.Validate(), database.ErrInvalidAccessibilityParam.Error())
+}
+
+// TestNewTypeFromString validate that the types qovery.DatabaseTypeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Type stays up to date.
+func TestNewTypeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedTypeValues, len(qovery.AllowedDatabaseTypeEnumEnumValues))
+	for _, qoveryType := range qovery.AllowedDatabaseTypeEnumEnumValues {
+		dbType, err := database.NewTypeFromString(string(qoveryType))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryType), dbType.String())
+	}
+}
+
+// TestNewModeFromString validate that the modes qovery.DatabaseModeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Mode stays up to date.
+func TestNewModeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedModeValues, len(qovery.AllowedDatabaseModeEnumEnumValues))
+	for _, qoveryMode := range qovery.AllowedDatabaseModeEnumEnumValues {
+		mode, err := database.NewModeFromString(string(qoveryMode))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryMode), mode.String())
+	}
+}
+
+// TestNewAccessibilityFromString validate that the accessibilities qovery.DatabaseAccessibilityEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Accessibility stays up to date.
+func TestNewAccessibilityFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedAccessibilityValues, len(qovery.AllowedDatabaseAccessibilityEnumEnumValues))
+	for _, qoveryAccessibility := range qovery.AllowedDatabaseAccessibilityEnumEnumValues {
+		accessibility, err := database.NewAccessibilityFromString(string(qoveryAccessibility))
+		assert.NoError(t, err)
+		assert.Equal(t, string(qoveryAccessibility), accessibility.String())
+	}
+}
@@ -19,6 +19,8 @@ var (
 // Service represents the interface to implement to handle the domain logic of a Secret.
 type Service interface {
 	List(ctx context.Context, scopeResourceID string) (Secrets, error)
+	// Update applies the diffs and returns the resulting secrets. When a diff is only partially applied, it returns the
+	// secrets applied before the failure along with an error wrapping the *variable.DiffApplyError report.
 	Update(
 		ctx context.Context,
 		scopeResourceID string,
@@ -84,6 +86,9 @@ type DiffUpdateRequest struct {
 	SecretID string `validate:"required"`
 }
 
+// DiffDeleteRequest represents the deletion of a Secret.
+// Key is only used to report which secrets were deleted.
 type DiffDeleteRequest struct {
 	SecretID string `validate:"required"`
+	Key      string
 }
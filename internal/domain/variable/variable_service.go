@@ -2,6 +2,9 @@ package variable
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
@@ -17,6 +20,8 @@ var (
 // Service represents the interface to implement to handle the domain logic of a Variable.
 type Service interface {
 	List(ctx context.Context, scopeResourceID string) (Variables, error)
+	// Update applies the diffs and returns the resulting variables. When a diff is only partially applied, it returns the
+	// variables applied before the failure along with an error wrapping the *DiffApplyError report.
 	Update(
 		ctx context.Context,
 		scopeResourceID string,
@@ -82,6 +87,90 @@ type DiffUpdateRequest struct {
 	VariableID string `validate:"required"`
 }
 
+// DiffDeleteRequest represents the deletion of a Variable.
+// Key is only used to report which variables were deleted.
 type DiffDeleteRequest struct {
 	VariableID string `validate:"required"`
+	Key        string
+}
+
+// DiffKind is the kind of variables targeted by a diff, an update applying one diff of each kind.
+type DiffKind string
+
+const (
+	DiffKindValue    DiffKind = "value"
+	DiffKindAlias    DiffKind = "alias"
+	DiffKindOverride DiffKind = "override"
+	DiffKindFile     DiffKind = "file"
+)
+
+// DiffApplyError is returned when a diff is only partially applied.
+// It tells which keys were applied and which failed, so that the caller knows the actual state of the variables.
+type DiffApplyError struct {
+	Applied []DiffAppliedKey
+	Failed  []DiffApplyFailure
+}
+
+// DiffAppliedKey represents a key of a diff that was applied.
+// The kind is kept as the same key can be both a variable and an alias or override.
+type DiffAppliedKey struct {
+	Kind DiffKind
+	Key  string
+}
+
+// String returns the key followed by its kind.
+func (k DiffAppliedKey) String() string {
+	return fmt.Sprintf("%s (%s)", k.Key, k.Kind)
+}
+
+// DiffApplyFailure represents the failed change of a single key of a diff.
+type DiffApplyFailure struct {
+	Key string
+	Err error
+}
+
+// Error returns the failed keys with their errors, followed by the applied keys.
+func (e *DiffApplyError) Error() string {
+	failures := make([]string, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		failures = append(failures, fmt.Sprintf("%s: %s", f.Key, f.Err))
+	}
+	sort.Strings(failures)
+
+	applied := make([]string, 0, len(e.Applied))
+	for _, a := range e.Applied {
+		applied = append(applied, a.String())
+	}
+	sort.Strings(applied)
+
+	return fmt.Sprintf("failed to apply %d change(s) [%s]; applied keys: [%s]", len(e.Failed), strings.Join(failures, "; "), strings.Join(applied, ", "))
+}
+
+// Unwrap returns the errors of the failed keys.
+func (e *DiffApplyError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
+
+// AppliedKeys returns the applied keys of the diff of the given kind. It returns nil for a nil report.
+func (e *DiffApplyError) AppliedKeys(kind DiffKind) []string {
+	if e == nil {
+		return nil
+	}
+
+	var keys []string
+	for _, a := range e.Applied {
+		if a.Kind == kind {
+			keys = append(keys, a.Key)
+		}
+	}
+	return keys
+}
+
+// HasFailures returns a bool to tell whether at least one key failed to be applied.
+func (e *DiffApplyError) HasFailures() bool {
+	return len(e.Failed) > 0
 }
@@ -0,0 +1,172 @@
+// Package diskcache persists rarely changing API responses, such as the default advanced settings, across provider
+// processes, so that each plan does not start cold and validation keeps working when the API cannot be reached.
+package diskcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	// CacheDirEnvName enables the disk cache, storing the cached responses in the given directory.
+	CacheDirEnvName = "QOVERY_CACHE_DIR"
+	// CacheEnabledEnvName enables the disk cache when set to a true value, storing the cached responses under
+	// TF_PLUGIN_CACHE_DIR when it is set, or under the user cache directory otherwise.
+	CacheEnabledEnvName = "QOVERY_CACHE_ENABLED"
+	// CacheTTLEnvName overrides the lifetime of the cached responses, as a duration (e.g. 12h).
+	CacheTTLEnvName = "QOVERY_CACHE_TTL"
+	// PluginCacheDirEnvName is the Terraform plugin cache directory.
+	PluginCacheDirEnvName = "TF_PLUGIN_CACHE_DIR"
+
+	// DefaultTTL is the time during which a cached response is served before being fetched again.
+	DefaultTTL = 24 * time.Hour
+
+	// cacheSubDir is the directory of the cache under TF_PLUGIN_CACHE_DIR or the user cache directory.
+	cacheSubDir = "terraform-provider-qovery"
+)
+
+// Cache is a TTL-bounded cache of API responses stored as JSON files.
+// Every entry is stored with a version, e.g. the provider version, and entries of another version are never served.
+// Stale entries are served when the response cannot be fetched, errors are never cached, and failing to read or write
+// the cache falls back to fetching.
+//
+// A nil *Cache is valid and fetches every time.
+type Cache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+type entry struct {
+	Key       string          `json:"key"`
+	Version   string          `json:"version"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// New returns a new Cache storing responses in dir for the given ttl.
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// FromEnv returns the Cache configured by the QOVERY_CACHE_* environment variables, or nil when the disk cache is
+// not enabled.
+func FromEnv() *Cache {
+	ttl := DefaultTTL
+	if d, err := time.ParseDuration(os.Getenv(CacheTTLEnvName)); err == nil {
+		ttl = d
+	}
+
+	if dir := os.Getenv(CacheDirEnvName); dir != "" {
+		return New(dir, ttl)
+	}
+
+	if enabled, _ := strconv.ParseBool(os.Getenv(CacheEnabledEnvName)); !enabled {
+		return nil
+	}
+	if dir := os.Getenv(PluginCacheDirEnvName); dir != "" {
+		return New(filepath.Join(dir, cacheSubDir), ttl)
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil
+	}
+	return New(filepath.Join(dir, cacheSubDir), ttl)
+}
+
+// Fetch returns the value cached for the given key and version, or calls fetch to retrieve it and stores it.
+// When fetch fails, the last value stored for the key and version is returned, however old it is.
+func Fetch[T any](ctx context.Context, c *Cache, key string, version string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	cached, fetchedAt, found := read[T](c, key, version)
+	if found && c.now().Before(fetchedAt.Add(c.ttl)) {
+		return cached, nil
+	}
+
+	value, err := fetch(ctx)
+	if err != nil {
+		if found {
+			return cached, nil
+		}
+		return value, err
+	}
+
+	c.write(key, version, value)
+	return value, nil
+}
+
+// read returns the value stored for the given key and version, and when it was fetched.
+func read[T any](c *Cache, key string, version string) (T, time.Time, bool) {
+	var zero T
+
+	content, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return zero, time.Time{}, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(content, &e); err != nil || e.Key != key || e.Version != version {
+		return zero, time.Time{}, false
+	}
+
+	var value T
+	if err := json.Unmarshal(e.Value, &value); err != nil {
+		return zero, time.Time{}, false
+	}
+	return value, e.FetchedAt, true
+}
+
+// write stores the value for the given key and version. The file is renamed into place so that concurrent provider
+// processes never read a partially written entry.
+func (c *Cache) write(key string, version string, value any) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	content, err := json.Marshal(entry{
+		Key:       key,
+		Version:   version,
+		FetchedAt: c.now(),
+		Value:     raw,
+	})
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), c.path(key))
+}
+
+// path returns the path of the file of the given key.
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
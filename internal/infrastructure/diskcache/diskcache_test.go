@@ -0,0 +1,148 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package diskcache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingFetch(calls *atomic.Int32, value map[string]any) func(ctx context.Context) (map[string]any, error) {
+	return func(ctx context.Context) (map[string]any, error) {
+		calls.Add(1)
+		return value, nil
+	}
+}
+
+func failingFetch(calls *atomic.Int32) func(ctx context.Context) (map[string]any, error) {
+	return func(ctx context.Context) (map[string]any, error) {
+		calls.Add(1)
+		return nil, errors.New("api unreachable")
+	}
+}
+
+func TestFetch_ServesStoredValueUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	dir := t.TempDir()
+	value := map[string]any{"network.ingress.cors_enabled": false}
+
+	var calls atomic.Int32
+	for range 3 {
+		// Each cache stands for a new provider process sharing the same directory.
+		cache := New(dir, time.Hour)
+		cache.now = func() time.Time { return now }
+
+		cached, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", countingFetch(&calls, value))
+		require.NoError(t, err)
+		assert.Equal(t, value, cached)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	cache := New(dir, time.Hour)
+	cache.now = func() time.Time { return now.Add(time.Hour) }
+	_, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", countingFetch(&calls, value))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFetch_DoesNotServeAnotherVersion(t *testing.T) {
+	t.Parallel()
+
+	cache := New(t.TempDir(), time.Hour)
+
+	var calls atomic.Int32
+	_, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", countingFetch(&calls, map[string]any{"a": "old"}))
+	require.NoError(t, err)
+
+	cached, err := Fetch(context.Background(), cache, "defaults/0", "1.1.0", countingFetch(&calls, map[string]any{"a": "new"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "new"}, cached)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFetch_ServesStaleValueWhenFetchFails(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := New(t.TempDir(), time.Hour)
+	cache.now = func() time.Time { return now }
+
+	var calls atomic.Int32
+	_, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", countingFetch(&calls, map[string]any{"a": "b"}))
+	require.NoError(t, err)
+
+	cache.now = func() time.Time { return now.Add(48 * time.Hour) }
+	cached, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", failingFetch(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "b"}, cached)
+	assert.Equal(t, int32(2), calls.Load())
+
+	_, err = Fetch(context.Background(), cache, "defaults/1", "1.0.0", failingFetch(&calls))
+	assert.Error(t, err)
+}
+
+func TestFetch_NilCacheAlwaysFetches(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	for range 2 {
+		_, err := Fetch(context.Background(), nil, "defaults/0", "1.0.0", countingFetch(&calls, map[string]any{}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFetch_UnwritableDirectoryFallsBackToFetching(t *testing.T) {
+	t.Parallel()
+
+	// The parent of the cache directory is a file, so the directory cannot be created.
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	cache := New(filepath.Join(file, "dir"), time.Hour)
+
+	var calls atomic.Int32
+	for range 2 {
+		_, err := Fetch(context.Background(), cache, "defaults/0", "1.0.0", countingFetch(&calls, map[string]any{}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv(CacheDirEnvName, "")
+		t.Setenv(CacheEnabledEnvName, "")
+		assert.Nil(t, FromEnv())
+	})
+
+	t.Run("enabled in the given directory", func(t *testing.T) {
+		t.Setenv(CacheDirEnvName, "/tmp/qovery")
+		t.Setenv(CacheTTLEnvName, "2h")
+		cache := FromEnv()
+		require.NotNil(t, cache)
+		assert.Equal(t, "/tmp/qovery", cache.dir)
+		assert.Equal(t, 2*time.Hour, cache.ttl)
+	})
+
+	t.Run("enabled under the plugin cache directory", func(t *testing.T) {
+		t.Setenv(CacheDirEnvName, "")
+		t.Setenv(CacheEnabledEnvName, "true")
+		t.Setenv(PluginCacheDirEnvName, "/tmp/plugins")
+		t.Setenv(CacheTTLEnvName, "")
+		cache := FromEnv()
+		require.NotNil(t, cache)
+		assert.Equal(t, filepath.Join("/tmp/plugins", cacheSubDir), cache.dir)
+		assert.Equal(t, DefaultTTL, cache.ttl)
+	})
+}
@@ -0,0 +1,78 @@
+// Package polling provides the adaptive polling strategies used to wait for the Qovery API to reach an expected state.
+//
+// The Qovery API does not expose a stream or long-poll endpoint for deployment statuses, waits therefore poll the status
+// endpoints: quickly at first so that short operations such as a container restart return fast, then less and less often
+// so that long operations such as a cluster installation do not flood the API.
+package polling
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Strategy describes how the delay between two polls grows.
+type Strategy struct {
+	// InitialInterval is the delay before the first poll following the initial one.
+	InitialInterval time.Duration
+	// MaxInterval is the ceiling of the delay between two polls.
+	MaxInterval time.Duration
+	// Multiplier is the factor applied to the delay after each poll.
+	Multiplier float64
+}
+
+var (
+	// ServiceStrategy is suited to service and environment deployments, which usually take from seconds to a few minutes.
+	ServiceStrategy = Strategy{
+		InitialInterval: 2 * time.Second,
+		MaxInterval:     20 * time.Second,
+		Multiplier:      1.5,
+	}
+
+	// ClusterStrategy is suited to cluster operations, which usually take from several minutes to an hour.
+	ClusterStrategy = Strategy{
+		InitialInterval: 10 * time.Second,
+		MaxInterval:     1 * time.Minute,
+		Multiplier:      1.5,
+	}
+	// TransientErrorStrategy is suited to the retries of a poll failing with a transient error, such as a DNS error or a
+	// rate limiting, which usually clears within seconds.
+	TransientErrorStrategy = Strategy{
+		InitialInterval: 2 * time.Second,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+	}
+)
+
+// Intervals returns a new sequence of poll delays following the strategy.
+func (s Strategy) Intervals() *Intervals {
+	return &Intervals{
+		strategy: s,
+		next:     s.InitialInterval,
+	}
+}
+
+// Intervals is a sequence of poll delays, it is not safe for concurrent use.
+type Intervals struct {
+	strategy Strategy
+	next     time.Duration
+}
+
+// Next returns the delay to wait before the next poll and grows the following one up to the strategy ceiling.
+func (i *Intervals) Next() time.Duration {
+	current := min(i.next, i.strategy.MaxInterval)
+	if i.strategy.Multiplier > 1 {
+		i.next = min(time.Duration(float64(current)*i.strategy.Multiplier), i.strategy.MaxInterval)
+	}
+
+	return current
+}
+
+// Jitter returns a random delay between half the given delay and the delay, so that the clients failing together
+// do not retry together.
+func Jitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(half)
+}
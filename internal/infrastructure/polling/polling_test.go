@@ -0,0 +1,68 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package polling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervals_Next(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Strategy Strategy
+		Expected []time.Duration
+	}{
+		{
+			TestName: "backs_off_to_the_ceiling",
+			Strategy: Strategy{InitialInterval: 2 * time.Second, MaxInterval: 10 * time.Second, Multiplier: 2},
+			Expected: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		{
+			TestName: "constant_without_multiplier",
+			Strategy: Strategy{InitialInterval: 5 * time.Second, MaxInterval: 10 * time.Second},
+			Expected: []time.Duration{5 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		{
+			TestName: "initial_interval_above_the_ceiling",
+			Strategy: Strategy{InitialInterval: 20 * time.Second, MaxInterval: 10 * time.Second, Multiplier: 1.5},
+			Expected: []time.Duration{10 * time.Second, 10 * time.Second},
+		},
+		{
+			TestName: "service_strategy",
+			Strategy: ServiceStrategy,
+			Expected: []time.Duration{2 * time.Second, 3 * time.Second, 4500 * time.Millisecond, 6750 * time.Millisecond, 10125 * time.Millisecond, 15187500 * time.Microsecond, 20 * time.Second, 20 * time.Second},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			intervals := tc.Strategy.Intervals()
+			actual := make([]time.Duration, 0, len(tc.Expected))
+			for range tc.Expected {
+				actual = append(actual, intervals.Next())
+			}
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		jittered := Jitter(10 * time.Second)
+		assert.GreaterOrEqual(t, jittered, 5*time.Second)
+		assert.Less(t, jittered, 10*time.Second)
+	}
+
+	assert.Equal(t, time.Duration(0), Jitter(0))
+	assert.Equal(t, time.Nanosecond, Jitter(time.Nanosecond))
+}
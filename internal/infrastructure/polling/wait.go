@@ -0,0 +1,84 @@
+package polling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
+)
+
+// maxTransientAttempts is the number of attempts of a poll failing with a transient error before the wait fails.
+const maxTransientAttempts = 3
+
+// ErrWaitTimeout is returned when a wait is not done within its timeout.
+var ErrWaitTimeout = errors.New("operation did not complete")
+
+// Wait polls f following the given strategy until it returns true or an error, the timeout is reached or ctx is done.
+// A poll failing with a transient error is retried following retryStrategy.
+func Wait(ctx context.Context, f func(ctx context.Context) (bool, error), strategy Strategy, retryStrategy Strategy, timeout time.Duration) error {
+	ctx, span := telemetry.StartSpan(ctx, "wait")
+	err := doWait(ctx, f, strategy, retryStrategy, timeout)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+func doWait(ctx context.Context, f func(ctx context.Context) (bool, error), strategy Strategy, retryStrategy Strategy, timeout time.Duration) error {
+	// Run the function once before waiting
+	iteration := 0
+	ok, err := poll(ctx, iteration, f, retryStrategy)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	intervals := strategy.Intervals()
+	timer := time.NewTimer(intervals.Next())
+	defer timer.Stop()
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeoutTimer.C:
+			return fmt.Errorf("%w within %s", ErrWaitTimeout, timeout)
+		case <-timer.C:
+			iteration++
+			ok, err := poll(ctx, iteration, f, retryStrategy)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return nil
+			}
+			timer.Reset(intervals.Next())
+		}
+	}
+}
+
+// poll runs f, retrying it following the retry strategy while it fails with a transient error, e.g. a DNS error,
+// a timeout or a rate limiting, so that a network hiccup does not fail a wait that may last hours.
+func poll(ctx context.Context, iteration int, f func(ctx context.Context) (bool, error), retryStrategy Strategy) (bool, error) {
+	retryIntervals := retryStrategy.Intervals()
+	for attempt := 1; ; attempt++ {
+		ok, err := telemetry.Poll(ctx, iteration, f)
+		if err == nil || attempt == maxTransientAttempts || !apierrors.IsErrTransient(err) {
+			return ok, err
+		}
+
+		retryTimer := time.NewTimer(Jitter(retryIntervals.Next()))
+		select {
+		case <-ctx.Done():
+			retryTimer.Stop()
+			return false, err
+		case <-retryTimer.C:
+		}
+	}
+}
@@ -0,0 +1,118 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package polling
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+// testStrategy polls and retries almost without delay so that the waits of the tests are fast.
+var testStrategy = Strategy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+func TestDoWait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns_once_done", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("returns_the_error_of_the_wait_function", func(t *testing.T) {
+		t.Parallel()
+
+		boom := errors.New("boom")
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			return false, boom
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.ErrorIs(t, err, boom)
+	})
+
+	t.Run("returns_a_timeout_error", func(t *testing.T) {
+		t.Parallel()
+
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			return false, nil
+		}, testStrategy, testStrategy, 20*time.Millisecond)
+
+		assert.ErrorIs(t, err, ErrWaitTimeout)
+		assert.EqualError(t, err, "operation did not complete within 20ms")
+	})
+
+	t.Run("returns_once_the_context_is_done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := doWait(ctx, func(context.Context) (bool, error) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return false, nil
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("retries_transient_errors", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			calls++
+			if calls < maxTransientAttempts {
+				return false, apierrors.NewReadAPIError(apierrors.APIResourceCluster, "some-id", &http.Response{StatusCode: http.StatusTooManyRequests}, errors.New("429 Too Many Requests"))
+			}
+			return true, nil
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, maxTransientAttempts, calls)
+	})
+
+	t.Run("fails_once_the_transient_retries_are_exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			calls++
+			return false, apierrors.NewReadAPIError(apierrors.APIResourceCluster, "some-id", nil, &net.DNSError{Err: "no such host", Name: "api.qovery.com"})
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.True(t, apierrors.IsErrTransient(err), err)
+		assert.Equal(t, maxTransientAttempts, calls)
+	})
+
+	t.Run("does_not_retry_other_errors", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		err := doWait(context.Background(), func(context.Context) (bool, error) {
+			calls++
+			return false, apierrors.NewReadAPIError(apierrors.APIResourceCluster, "some-id", &http.Response{StatusCode: http.StatusBadRequest}, errors.New("400 Bad Request"))
+		}, testStrategy, testStrategy, time.Minute)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,118 @@
+package readcache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultTTL is the time during which a cached response is served before being fetched again.
+// It is short enough for reads to stay fresh within a plan or an apply while letting resources
+// refreshed in parallel share the responses of their common parents.
+const DefaultTTL = 30 * time.Second
+
+// Cache is a TTL-bounded cache of API responses shared by the repositories of a provider instance.
+// Concurrent fetches of the same key are coalesced so that only one request is sent to the API.
+// Errors are never cached.
+//
+// A nil *Cache is valid and fetches every time.
+type Cache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mu         sync.Mutex
+	entries    map[string]entry
+	generation uint64
+}
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+}
+
+// New returns a new Cache keeping responses for the given ttl.
+// With a zero or negative ttl, responses are not kept but concurrent fetches are still coalesced.
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]entry),
+	}
+}
+
+// Fetch returns the value cached for the given key, or calls fetch to retrieve it.
+// The values returned by Fetch are shared by every caller and must be treated as read-only.
+func Fetch[T any](ctx context.Context, c *Cache, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expiresAt) {
+		c.mu.Unlock()
+		return e.value.(T), nil
+	}
+	generation := c.generation
+	c.mu.Unlock()
+
+	// The generation is part of the flight key so that a fetch started before an invalidation is never joined afterwards.
+	flight := c.group.DoChan(key+"@"+strconv.FormatUint(generation, 10), func() (any, error) {
+		// The flight is shared by every caller that joins it, so it must not fail when the caller that started it gives up.
+		value, err := fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.ttl > 0 && c.generation == generation {
+			c.entries[key] = entry{
+				value:     value,
+				expiresAt: c.now().Add(c.ttl),
+			}
+		}
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case res := <-flight:
+		if res.Err != nil {
+			var zero T
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	}
+}
+
+// Invalidate drops the cached values whose key starts with one of the given prefixes.
+// It must be called after every write that may change the cached responses.
+func (c *Cache) Invalidate(prefixes ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key := range c.entries {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// Key builds a cache key from the given parts.
+func Key(parts ...string) string {
+	return strings.Join(parts, "/")
+}
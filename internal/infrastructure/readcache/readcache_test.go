@@ -0,0 +1,211 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package readcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingFetch(calls *atomic.Int32, value string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		return value, nil
+	}
+}
+
+func TestFetch_ServesCachedValueUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := New(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	var calls atomic.Int32
+	for range 3 {
+		value, err := Fetch(context.Background(), cache, "environment/1", countingFetch(&calls, "env"))
+		require.NoError(t, err)
+		assert.Equal(t, "env", value)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	now = now.Add(time.Minute)
+	_, err := Fetch(context.Background(), cache, "environment/1", countingFetch(&calls, "env"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFetch_CoalescesConcurrentFetches(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fetch := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		<-release
+		return "stage", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = Fetch(context.Background(), cache, "deployment_stage/1", fetch)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, result := range results {
+		assert.Equal(t, "stage", result)
+	}
+}
+
+func TestFetch_FlightSurvivesCancellationOfFirstCaller(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (string, error) {
+		close(started)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-release:
+			return "stage", nil
+		}
+	}
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := Fetch(firstCtx, cache, "deployment_stage/1", fetch)
+		firstErr <- err
+	}()
+	<-started
+
+	secondResult := make(chan string, 1)
+	go func() {
+		value, _ := Fetch(context.Background(), cache, "deployment_stage/1", fetch)
+		secondResult <- value
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+	close(release)
+	assert.Equal(t, "stage", <-secondResult)
+}
+
+func TestFetch_DoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	expectedErr := errors.New("not found")
+
+	_, err := Fetch(context.Background(), cache, "environment/1", func(ctx context.Context) (string, error) {
+		return "", expectedErr
+	})
+	assert.ErrorIs(t, err, expectedErr)
+
+	var calls atomic.Int32
+	value, err := Fetch(context.Background(), cache, "environment/1", countingFetch(&calls, "env"))
+	require.NoError(t, err)
+	assert.Equal(t, "env", value)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestInvalidate(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	var calls atomic.Int32
+	for _, key := range []string{"deployment_stage/1", "deployment_stage/2", "environment/1"} {
+		_, err := Fetch(context.Background(), cache, key, countingFetch(&calls, key))
+		require.NoError(t, err)
+	}
+
+	cache.Invalidate("deployment_stage/")
+
+	for _, key := range []string{"deployment_stage/1", "deployment_stage/2", "environment/1"} {
+		_, err := Fetch(context.Background(), cache, key, countingFetch(&calls, key))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(5), calls.Load())
+}
+
+func TestInvalidate_DropsValueOfFetchInFlight(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = Fetch(context.Background(), cache, "environment/1", func(ctx context.Context) (string, error) {
+			close(started)
+			<-release
+			return "stale", nil
+		})
+	}()
+
+	<-started
+	cache.Invalidate("environment/1")
+	close(release)
+	<-done
+
+	value, err := Fetch(context.Background(), cache, "environment/1", func(ctx context.Context) (string, error) {
+		return "fresh", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", value)
+}
+
+func TestFetch_ReturnsWhenContextIsCanceled(t *testing.T) {
+	t.Parallel()
+
+	cache := New(time.Minute)
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_, _ = Fetch(context.Background(), cache, "environment/1", func(ctx context.Context) (string, error) {
+			<-release
+			return "env", nil
+		})
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Fetch(ctx, cache, "environment/1", countingFetch(&atomic.Int32{}, "env"))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFetch_NilCache(t *testing.T) {
+	t.Parallel()
+
+	var cache *Cache
+	var calls atomic.Int32
+	for range 2 {
+		value, err := Fetch(context.Background(), cache, "environment/1", countingFetch(&calls, "env"))
+		require.NoError(t, err)
+		assert.Equal(t, "env", value)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+	cache.Invalidate("environment/")
+}
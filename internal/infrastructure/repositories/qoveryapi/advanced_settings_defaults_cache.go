@@ -0,0 +1,51 @@
+package qoveryapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/diskcache"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
+)
+
+// advancedSettingsDefaultsCacheTTL is the lifetime of the default advanced settings cached by
+// NewAdvancedSettingsDefaultsCache. The default set is static for a provider run.
+const advancedSettingsDefaultsCacheTTL = time.Hour
+
+// advancedSettingsDefaultsCache implements advanced_settings.DefaultsCache with a read cache,
+// backed by the disk cache when it is enabled.
+type advancedSettingsDefaultsCache struct {
+	readCache *readcache.Cache
+	diskCache *diskcache.Cache
+	// host scopes the disk cache entries to the API they were fetched from.
+	host string
+	// version is the version of the disk cache entries. The user agent holds the provider version,
+	// so that a provider upgrade never serves the defaults cached by a previous version.
+	version string
+}
+
+// NewAdvancedSettingsDefaultsCache returns the cache of the default advanced settings used by the advanced
+// settings services built outside of the repositories, such as the ones validating the configuration at plan time.
+func NewAdvancedSettingsDefaultsCache(apiConfig *qovery.Configuration) advanced_settings.DefaultsCache {
+	return newAdvancedSettingsDefaultsCache(apiConfig, readcache.New(advancedSettingsDefaultsCacheTTL))
+}
+
+func newAdvancedSettingsDefaultsCache(apiConfig *qovery.Configuration, readCache *readcache.Cache) advanced_settings.DefaultsCache {
+	return advancedSettingsDefaultsCache{
+		readCache: readCache,
+		diskCache: diskcache.FromEnv(),
+		host:      apiConfig.Servers[0].URL,
+		version:   apiConfig.UserAgent,
+	}
+}
+
+// FetchDefaults returns the default advanced settings from the read cache, then from the disk cache,
+// and only fetches them from the API when neither holds them.
+func (c advancedSettingsDefaultsCache) FetchDefaults(ctx context.Context, key string, fetch func(ctx context.Context) (map[string]any, error)) (map[string]any, error) {
+	return readcache.Fetch(ctx, c.readCache, key, func(ctx context.Context) (map[string]any, error) {
+		return diskcache.Fetch(ctx, c.diskCache, readcache.Key(c.host, key), c.version, fetch)
+	})
+}
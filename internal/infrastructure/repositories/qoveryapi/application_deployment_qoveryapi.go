@@ -0,0 +1,81 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+// Ensure applicationDeploymentQoveryAPI defined types fully satisfy the deployment.Repository interface.
+var _ deployment.Repository = applicationDeploymentQoveryAPI{}
+
+// applicationDeploymentQoveryAPI implements the interface deployment.Repository.
+type applicationDeploymentQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationDeploymentQoveryAPI return a new instance of a deployment.Repository that uses Qovery's API.
+func newApplicationDeploymentQoveryAPI(client *qovery.APIClient) (deployment.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationDeploymentQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// GetStatus calls Qovery's API to get the status of an application using the given applicationID.
+func (c applicationDeploymentQoveryAPI) GetStatus(ctx context.Context, applicationID string) (*status.Status, error) {
+	applicationStatus, resp, err := c.client.ApplicationMainCallsAPI.
+		GetApplicationStatus(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplicationStatus, applicationID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(applicationStatus)
+}
+
+// Deploy calls Qovery's API to deploy an application at the given git commit using the given applicationID.
+func (c applicationDeploymentQoveryAPI) Deploy(ctx context.Context, applicationID string, gitCommitID string) (*status.Status, error) {
+	applicationStatus, resp, err := c.client.ApplicationActionsAPI.
+		DeployApplication(ctx, applicationID).
+		DeployRequest(qovery.DeployRequest{
+			GitCommitId: gitCommitID,
+		}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewDeployAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(applicationStatus)
+}
+
+// Redeploy calls Qovery's API to redeploy an application using the given applicationID.
+func (c applicationDeploymentQoveryAPI) Redeploy(ctx context.Context, applicationID string) (*status.Status, error) {
+	applicationStatus, resp, err := c.client.ApplicationActionsAPI.
+		DeployApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewRedeployAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(applicationStatus)
+}
+
+// Stop calls Qovery's API to stop an application using the given applicationID.
+func (c applicationDeploymentQoveryAPI) Stop(ctx context.Context, applicationID string) (*status.Status, error) {
+	applicationStatus, resp, err := c.client.ApplicationActionsAPI.
+		StopApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewStopAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(applicationStatus)
+}
@@ -0,0 +1,109 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure applicationEnvironmentVariablesQoveryAPI defined types fully satisfy the variable.Repository interface.
+var _ variable.Repository = applicationEnvironmentVariablesQoveryAPI{}
+
+// applicationEnvironmentVariablesQoveryAPI implements the interface variable.Repository.
+type applicationEnvironmentVariablesQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationEnvironmentVariablesQoveryAPI return a new instance of a variable.Repository that uses Qovery's API.
+func newApplicationEnvironmentVariablesQoveryAPI(client *qovery.APIClient) (variable.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationEnvironmentVariablesQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create an environment variable for an application using the given applicationID and request.
+func (p applicationEnvironmentVariablesQoveryAPI) Create(ctx context.Context, applicationID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		CreateApplicationEnvironmentVariable(ctx, applicationID).
+		EnvironmentVariableRequest(newQoveryEnvironmentVariableRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationEnvironmentVariable, request.Key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// List calls Qovery's API to retrieve an environment variables from an application using the given applicationID and variableID.
+func (p applicationEnvironmentVariablesQoveryAPI) List(ctx context.Context, applicationID string) (variable.Variables, error) {
+	vars, resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		ListApplicationEnvironmentVariable(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplicationEnvironmentVariable, applicationID, resp, err)
+	}
+
+	return newDomainVariablesFromQovery(vars)
+}
+
+// Update calls Qovery's API to update an environment variable from an application using the given applicationID, credentialsID and request.
+func (p applicationEnvironmentVariablesQoveryAPI) Update(ctx context.Context, applicationID string, credentialsID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		EditApplicationEnvironmentVariable(ctx, applicationID, credentialsID).
+		EnvironmentVariableEditRequest(newQoveryEnvironmentVariableEditRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplicationEnvironmentVariable, credentialsID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// Delete calls Qovery's API to delete an environment variable from an application using the given applicationID and credentialsID.
+func (p applicationEnvironmentVariablesQoveryAPI) Delete(ctx context.Context, applicationID string, credentialsID string) *apierrors.APIError {
+	resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		DeleteApplicationEnvironmentVariable(ctx, applicationID, credentialsID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceApplicationEnvironmentVariable, credentialsID, resp, err)
+	}
+
+	return nil
+}
+
+func (p applicationEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, applicationID string, request variable.UpsertRequest, aliasedVariableId string) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		CreateApplicationEnvironmentVariableAlias(ctx, applicationID, aliasedVariableId).
+		Key(qovery.Key{
+			Key:         request.Key,
+			Description: *qovery.NewNullableString(&request.Description),
+		}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationEnvironmentVariable, request.Key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+func (p applicationEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, applicationID string, request variable.UpsertRequest, overriddenVariableId string) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableAPI.
+		CreateApplicationEnvironmentVariableOverride(ctx, applicationID, overriddenVariableId).
+		Value(qovery.Value{
+			Value:       &request.Value,
+			Description: *qovery.NewNullableString(&request.Description),
+		}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationEnvironmentVariable, request.Key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
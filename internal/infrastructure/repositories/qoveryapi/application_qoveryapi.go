@@ -0,0 +1,234 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
+)
+
+// Ensure applicationQoveryAPI defined types fully satisfy the application.Repository interface.
+var _ application.Repository = applicationQoveryAPI{}
+
+// applicationQoveryAPI implements the interface application.Repository.
+type applicationQoveryAPI struct {
+	client    *qovery.APIClient
+	readCache *readcache.Cache
+}
+
+// newApplicationQoveryAPI return a new instance of an application.Repository that uses Qovery's API.
+func newApplicationQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (application.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationQoveryAPI{
+		client:    client,
+		readCache: readCache,
+	}, nil
+}
+
+// Create calls Qovery's API to create an application for an environment using the given environmentID and request.
+func (c applicationQoveryAPI) Create(ctx context.Context, environmentID string, request application.UpsertRepositoryRequest) (_ *application.Application, err error) {
+	req, err := newQoveryApplicationRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	newApplication, resp, err := c.client.ApplicationsAPI.
+		CreateApplication(ctx, environmentID).
+		ApplicationRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplication, request.Name, resp, err)
+	}
+
+	// From now on the application exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*application.Application, error) {
+			return c.Get(ctx, newApplication.Id, request.AdvancedSettingsJson, false)
+		})
+	}()
+
+	// Create custom domains
+	for _, customDomain := range request.CustomDomains.Create {
+		_, resp, err := c.client.ApplicationCustomDomainAPI.
+			CreateApplicationCustomDomain(ctx, newApplication.Id).
+			CustomDomainRequest(customDomain.CustomDomainRequest).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationCustomDomain, request.Name, resp, err)
+		}
+	}
+
+	// Attach application to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, newApplication.Id, request.IsSkipped)
+		if err != nil || (response != nil && response.StatusCode >= 400) {
+			return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplication, request.Name, response, err)
+		}
+	}
+
+	// Update advanced settings
+	err = advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).UpdateServiceAdvancedSettings(domain.APPLICATION, newApplication.Id, request.AdvancedSettingsJson)
+	if err != nil {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplication, newApplication.Id, nil, err)
+	}
+
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newApplication.Id)
+
+	// Get application deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, newApplication.Id).Execute()
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplication, newApplication.Id, resp, err)
+	}
+
+	// Get custom domains
+	customDomains, resp, err := c.client.ApplicationCustomDomainAPI.ListApplicationCustomDomain(ctx, newApplication.Id).Execute()
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationCustomDomain, newApplication.Id, resp, err)
+	}
+
+	return newDomainApplicationFromQovery(newApplication, deploymentStage.Id, getServiceIsSkipped(deploymentStage, newApplication.Id), request.AdvancedSettingsJson, customDomains)
+}
+
+// Get calls Qovery's API to retrieve an application using the given applicationID.
+func (c applicationQoveryAPI) Get(ctx context.Context, applicationID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*application.Application, error) {
+	app, resp, err := c.client.ApplicationMainCallsAPI.
+		GetApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	// Get application deployment stage
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceApplication, app.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get advanced settings
+	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsServiceWithCache(c.client.GetConfig(), newAdvancedSettingsDefaultsCache(c.client.GetConfig(), c.readCache)).ReadServiceAdvancedSettings(domain.APPLICATION, app.Id, advancedSettingsJsonFromState, isTriggeredFromImport)
+	if err != nil {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplication, applicationID, nil, err)
+	}
+
+	// Get custom domains
+	customDomains, resp, err := c.client.ApplicationCustomDomainAPI.ListApplicationCustomDomain(ctx, app.Id).Execute()
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplicationCustomDomain, app.Id, resp, err)
+	}
+
+	return newDomainApplicationFromQovery(app, deploymentStage.Id, getServiceIsSkipped(deploymentStage, app.Id), *advancedSettingsAsJson, customDomains)
+}
+
+// Update calls Qovery's API to update an application using the given applicationID and request.
+func (c applicationQoveryAPI) Update(ctx context.Context, applicationID string, request application.UpsertRepositoryRequest) (*application.Application, error) {
+	req, err := newQoveryApplicationEditRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	app, resp, err := c.client.ApplicationMainCallsAPI.
+		EditApplication(ctx, applicationID).
+		ApplicationEditRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	// Update custom domains
+	for _, customDomain := range request.CustomDomains.Delete {
+		resp, err := c.client.ApplicationCustomDomainAPI.
+			DeleteCustomDomain(ctx, applicationID, customDomain.Id).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewDeleteAPIError(apierrors.APIResourceApplicationCustomDomain, customDomain.Id, resp, err)
+		}
+	}
+	for _, customDomain := range request.CustomDomains.Update {
+		_, resp, err := c.client.ApplicationCustomDomainAPI.
+			EditCustomDomain(ctx, applicationID, customDomain.Id).
+			CustomDomainRequest(customDomain.CustomDomainRequest).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplicationCustomDomain, customDomain.Id, resp, err)
+		}
+	}
+	for _, customDomain := range request.CustomDomains.Create {
+		_, resp, err := c.client.ApplicationCustomDomainAPI.
+			CreateApplicationCustomDomain(ctx, applicationID).
+			CustomDomainRequest(customDomain.CustomDomainRequest).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationCustomDomain, customDomain.Domain, resp, err)
+		}
+	}
+
+	// Attach application to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, app.Id, request.IsSkipped)
+		if err != nil || (response != nil && response.StatusCode >= 400) {
+			return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplication, request.Name, response, err)
+		}
+	}
+
+	// Update advanced settings
+	err = advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).UpdateServiceAdvancedSettings(domain.APPLICATION, app.Id, request.AdvancedSettingsJson)
+	if err != nil {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplication, app.Id, nil, err)
+	}
+
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, app.Id)
+
+	// Get application deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, app.Id).Execute()
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplication, app.Id, resp, err)
+	}
+
+	// Get custom domains
+	customDomains, resp, err := c.client.ApplicationCustomDomainAPI.ListApplicationCustomDomain(ctx, app.Id).Execute()
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplicationCustomDomain, app.Id, resp, err)
+	}
+
+	return newDomainApplicationFromQovery(app, deploymentStage.Id, getServiceIsSkipped(deploymentStage, app.Id), request.AdvancedSettingsJson, customDomains)
+}
+
+// Delete calls Qovery's API to delete an application using the given applicationID.
+// The deletion is only requested once the environment of the application has reached a final state.
+func (c applicationQoveryAPI) Delete(ctx context.Context, applicationID string) error {
+	app, resp, err := c.client.ApplicationMainCallsAPI.
+		GetApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		if resp != nil && resp.StatusCode == 404 {
+			// if the application is not found, then it has already been deleted
+			return nil
+		}
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	if err := waitForEnvironmentFinalState(ctx, c.client, app.Environment.Id); err != nil {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceApplication, applicationID, nil, err)
+	}
+
+	resp, err = c.client.ApplicationMainCallsAPI.
+		DeleteApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceApplication, applicationID, resp, err)
+	}
+
+	invalidateServiceDeploymentStage(c.readCache, applicationID)
+	return nil
+}
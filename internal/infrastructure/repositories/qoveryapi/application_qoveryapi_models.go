@@ -0,0 +1,297 @@
+package qoveryapi
+
+import (
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/autoscaling"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+)
+
+// newDomainApplicationFromQovery takes a qovery.Application returned by the API client and turns it into the domain model application.Application.
+func newDomainApplicationFromQovery(
+	a *qovery.Application,
+	deploymentStageID string,
+	isSkipped bool,
+	advancedSettingsAsJson string,
+	qoveryCustomDomains *qovery.CustomDomainResponseList,
+) (*application.Application, error) {
+	if a == nil {
+		return nil, application.ErrNilApplication
+	}
+
+	ports, err := newDomainPortsFromQovery(a.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, port.ErrInvalidPorts.Error())
+	}
+
+	storages, err := newDomainStoragesFromQovery(a.Storage)
+	if err != nil {
+		return nil, errors.Wrap(err, storage.ErrInvalidStorages.Error())
+	}
+
+	customDomains := make([]*qovery.CustomDomain, 0, len(qoveryCustomDomains.GetResults()))
+	for _, v := range qoveryCustomDomains.GetResults() {
+		cpy := v
+		customDomains = append(customDomains, &cpy)
+	}
+
+	annotationsGroupIds := make([]string, 0, len(a.AnnotationsGroups))
+	for _, annotationsGroup := range a.AnnotationsGroups {
+		annotationsGroupIds = append(annotationsGroupIds, annotationsGroup.Id)
+	}
+
+	labelsGroupIds := make([]string, 0, len(a.LabelsGroups))
+	for _, labelsGroup := range a.LabelsGroups {
+		labelsGroupIds = append(labelsGroupIds, labelsGroup.Id)
+	}
+
+	autoscalingPolicy, err := autoscaling.FromQoveryResponse(a.Autoscaling)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidApplication.Error())
+	}
+
+	var gitRepository *git_repository.NewGitRepositoryParams
+	if a.GitRepository != nil {
+		gitRepository = &git_repository.NewGitRepositoryParams{
+			Url:        a.GitRepository.Url,
+			Branch:     a.GitRepository.Branch,
+			RootPath:   a.GitRepository.RootPath,
+			GitTokenId: a.GitRepository.GitTokenId.Get(),
+		}
+	}
+
+	var buildMode *string
+	if a.BuildMode != nil {
+		buildMode = new(string(*a.BuildMode))
+	}
+
+	return application.NewApplication(application.NewApplicationParams{
+		ApplicationID:          a.Id,
+		EnvironmentID:          a.Environment.Id,
+		Name:                   a.Name,
+		IconUri:                a.IconUri,
+		GitRepository:          gitRepository,
+		BuildMode:              buildMode,
+		DockerfilePath:         a.DockerfilePath.Get(),
+		DockerTargetBuildStage: a.DockerTargetBuildStage.Get(),
+		CPU:                    a.Cpu,
+		Memory:                 a.Memory,
+		EphemeralStorage:       a.EphemeralStorageInGib,
+		MinRunningInstances:    a.MinRunningInstances,
+		MaxRunningInstances:    a.MaxRunningInstances,
+		AutoPreview:            a.AutoPreview,
+		Entrypoint:             a.Entrypoint,
+		Arguments:              a.Arguments,
+		Storages:               storages,
+		Ports:                  ports,
+		DeploymentStageID:      deploymentStageID,
+		IsSkipped:              isSkipped,
+		Healthchecks:           a.Healthchecks,
+		AdvancedSettingsAsJson: advancedSettingsAsJson,
+		CustomDomains:          customDomains,
+		AutoDeploy:             a.AutoDeploy,
+		AnnotationsGroupIds:    annotationsGroupIds,
+		LabelsGroupIds:         labelsGroupIds,
+		Autoscaling:            autoscalingPolicy,
+	})
+}
+
+// newQoveryApplicationRequestFromDomain takes the domain request application.UpsertRepositoryRequest and turns it into a qovery.ApplicationRequest to make the api call.
+func newQoveryApplicationRequestFromDomain(request application.UpsertRepositoryRequest) (*qovery.ApplicationRequest, error) {
+	ports, err := newQoveryPortsRequestFromDomain(request.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	storages, err := newQoveryStoragesRequestFromDomain(request.Storages)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	gitRepository, err := newQoveryApplicationGitRepositoryRequestFromDomain(request.GitRepository)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidGitRepositoryParam.Error())
+	}
+
+	buildMode, err := newQoveryBuildModeFromDomain(request.BuildMode)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidBuildModeParam.Error())
+	}
+
+	annotationsGroups, err := NewQoveryServiceAnnotationsGroupRequestFromDomain(request.AnnotationsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	labelsGroups, err := NewQoveryServiceLabelsGroupRequestFromDomain(request.LabelsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	autoscalingPolicy, err := newQoveryAutoscalingRequestFromDomain(request.Autoscaling)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.ApplicationRequest{
+		Name:                   request.Name,
+		IconUri:                request.IconUri,
+		GitRepository:          *gitRepository,
+		BuildMode:              buildMode,
+		DockerfilePath:         *qovery.NewNullableString(request.DockerfilePath),
+		DockerTargetBuildStage: *qovery.NewNullableString(request.DockerTargetBuildStage),
+		Cpu:                    request.CPU,
+		Memory:                 request.Memory,
+		EphemeralStorageInGib:  request.EphemeralStorage,
+		MinRunningInstances:    request.MinRunningInstances,
+		MaxRunningInstances:    request.MaxRunningInstances,
+		AutoPreview:            request.AutoPreview,
+		Entrypoint:             request.Entrypoint,
+		Arguments:              request.Arguments,
+		Storage:                storages,
+		Ports:                  ports,
+		Healthchecks:           request.Healthchecks,
+		AutoDeploy:             request.AutoDeploy,
+		AnnotationsGroups:      annotationsGroups,
+		LabelsGroups:           labelsGroups,
+		Autoscaling:            autoscalingPolicy,
+	}, nil
+}
+
+// newQoveryApplicationEditRequestFromDomain takes the domain request application.UpsertRepositoryRequest and turns it into a qovery.ApplicationEditRequest to make the api call.
+func newQoveryApplicationEditRequestFromDomain(request application.UpsertRepositoryRequest) (*qovery.ApplicationEditRequest, error) {
+	ports, err := newQoveryServicePortsFromDomain(request.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	storages, err := newQoveryStoragesRequestFromDomain(request.Storages)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	gitRepository, err := newQoveryApplicationGitRepositoryRequestFromDomain(request.GitRepository)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidGitRepositoryParam.Error())
+	}
+
+	buildMode, err := newQoveryBuildModeFromDomain(request.BuildMode)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidBuildModeParam.Error())
+	}
+
+	annotationsGroups, err := NewQoveryServiceAnnotationsGroupRequestFromDomain(request.AnnotationsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	labelsGroups, err := NewQoveryServiceLabelsGroupRequestFromDomain(request.LabelsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	autoscalingPolicy, err := newQoveryAutoscalingRequestFromDomain(request.Autoscaling)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.ApplicationEditRequest{
+		Name:                   new(request.Name),
+		IconUri:                request.IconUri,
+		GitRepository:          gitRepository,
+		BuildMode:              buildMode,
+		DockerfilePath:         *qovery.NewNullableString(request.DockerfilePath),
+		DockerTargetBuildStage: *qovery.NewNullableString(request.DockerTargetBuildStage),
+		Cpu:                    request.CPU,
+		Memory:                 request.Memory,
+		EphemeralStorageInGib:  request.EphemeralStorage,
+		MinRunningInstances:    request.MinRunningInstances,
+		MaxRunningInstances:    request.MaxRunningInstances,
+		AutoPreview:            request.AutoPreview,
+		Entrypoint:             request.Entrypoint,
+		Arguments:              request.Arguments,
+		Storage:                storages,
+		Ports:                  ports,
+		Healthchecks:           request.Healthchecks,
+		AutoDeploy:             request.AutoDeploy,
+		AnnotationsGroups:      annotationsGroups,
+		LabelsGroups:           labelsGroups,
+		Autoscaling:            autoscalingPolicy,
+	}, nil
+}
+
+// newQoveryApplicationGitRepositoryRequestFromDomain takes the domain request application.GitRepositoryRequest and turns it into a qovery.ApplicationGitRepositoryRequest.
+// The git provider is detected from the repository URL.
+func newQoveryApplicationGitRepositoryRequestFromDomain(request application.GitRepositoryRequest) (*qovery.ApplicationGitRepositoryRequest, error) {
+	provider, err := detectGitProviderFromURL(request.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &qovery.ApplicationGitRepositoryRequest{
+		Url:        request.Url,
+		Branch:     request.Branch,
+		RootPath:   request.RootPath,
+		GitTokenId: *qovery.NewNullableString(request.GitTokenId),
+		Provider:   provider,
+	}, nil
+}
+
+// newQoveryBuildModeFromDomain turns the given build mode into a qovery.BuildModeEnum, leaving it unset when nil.
+func newQoveryBuildModeFromDomain(buildMode *string) (*qovery.BuildModeEnum, error) {
+	if buildMode == nil {
+		return nil, nil
+	}
+
+	return qovery.NewBuildModeEnumFromValue(*buildMode)
+}
+
+// newQoveryAutoscalingRequestFromDomain turns the given autoscaling policy into a qovery.AutoscalingPolicyRequest, leaving it unset when nil.
+func newQoveryAutoscalingRequestFromDomain(policy *autoscaling.AutoscalingPolicy) (*qovery.AutoscalingPolicyRequest, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	request, err := autoscaling.ToQoveryRequest(*policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// newQoveryServicePortsFromDomain takes the domain requests port.UpsertRequest and turns them into qovery.ServicePort as expected by the edit endpoints.
+func newQoveryServicePortsFromDomain(requests []port.UpsertRequest) ([]qovery.ServicePort, error) {
+	ports := make([]qovery.ServicePort, 0, len(requests))
+	for _, r := range requests {
+		portProtocol := qovery.PortProtocolEnum(port.DefaultProtocol)
+		if r.Protocol != nil {
+			proto, err := qovery.NewPortProtocolEnumFromValue(*r.Protocol)
+			if err != nil {
+				return nil, errors.Wrap(err, port.ErrInvalidUpsertRequest.Error())
+			}
+			portProtocol = *proto
+		}
+
+		var portID string
+		if r.Id != nil {
+			portID = *r.Id
+		}
+
+		ports = append(ports, qovery.ServicePort{
+			Id:                 portID,
+			Name:               r.Name,
+			InternalPort:       r.InternalPort,
+			ExternalPort:       r.ExternalPort,
+			Protocol:           portProtocol,
+			PubliclyAccessible: r.PubliclyAccessible,
+			IsDefault:          new(r.IsDefault),
+		})
+	}
+
+	return ports, nil
+}
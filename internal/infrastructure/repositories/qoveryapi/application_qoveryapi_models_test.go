@@ -0,0 +1,194 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+)
+
+func TestNewDomainApplicationFromQovery(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Application   *qovery.Application
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_nil_application",
+			Application:   nil,
+			ExpectedError: application.ErrNilApplication,
+		},
+		{
+			TestName: "success",
+			Application: &qovery.Application{
+				Id: gofakeit.UUID(),
+				Environment: qovery.ReferenceObject{
+					Id: gofakeit.UUID(),
+				},
+				Name:    gofakeit.Name(),
+				IconUri: "app://qovery-console/application",
+				GitRepository: &qovery.ApplicationGitRepository{
+					Url:      "https://github.com/Qovery/test_http_server.git",
+					Branch:   new("master"),
+					RootPath: new("/"),
+				},
+				BuildMode:           new(qovery.BUILDMODEENUM_DOCKER),
+				DockerfilePath:      *qovery.NewNullableString(new("Dockerfile")),
+				Cpu:                 new(int32(500)),
+				Memory:              new(int32(512)),
+				MinRunningInstances: new(int32(1)),
+				MaxRunningInstances: new(int32(2)),
+				Ports: []qovery.ServicePort{
+					{
+						Id:                 gofakeit.UUID(),
+						Name:               new("p80"),
+						InternalPort:       80,
+						ExternalPort:       new(int32(443)),
+						Protocol:           qovery.PORTPROTOCOLENUM_HTTP,
+						PubliclyAccessible: true,
+						IsDefault:          new(true),
+					},
+				},
+				Healthchecks: qovery.Healthcheck{
+					ReadinessProbe: *qovery.NewNullableProbe(nil),
+					LivenessProbe:  *qovery.NewNullableProbe(nil),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			deploymentStageID := uuid.NewString()
+			customDomains := qovery.CustomDomainResponseList{
+				Results: []qovery.CustomDomain{
+					{Id: gofakeit.UUID(), Domain: "app.example.com"},
+				},
+			}
+			app, err := newDomainApplicationFromQovery(tc.Application, deploymentStageID, true, "{}", &customDomains)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, app)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, app.IsValid())
+			assert.Equal(t, tc.Application.Id, app.ID.String())
+			assert.Equal(t, tc.Application.Environment.Id, app.EnvironmentID.String())
+			assert.Equal(t, tc.Application.Name, app.Name)
+			assert.Equal(t, tc.Application.GitRepository.Url, app.GitRepository.Url)
+			assert.Equal(t, tc.Application.GitRepository.Branch, app.GitRepository.Branch)
+			assert.Equal(t, string(*tc.Application.BuildMode), *app.BuildMode)
+			assert.Equal(t, tc.Application.DockerfilePath.Get(), app.DockerfilePath)
+			assert.Equal(t, tc.Application.Cpu, app.CPU)
+			assert.Equal(t, tc.Application.Memory, app.Memory)
+			assert.Equal(t, tc.Application.MinRunningInstances, app.MinRunningInstances)
+			assert.Equal(t, tc.Application.MaxRunningInstances, app.MaxRunningInstances)
+			assert.Equal(t, deploymentStageID, app.DeploymentStageID)
+			assert.True(t, app.IsSkipped)
+			assert.Equal(t, "{}", app.AdvancedSettingsJson)
+
+			require.Len(t, app.Ports, len(tc.Application.Ports))
+			for idx, p := range app.Ports {
+				assert.Equal(t, tc.Application.Ports[idx].Id, p.ID.String())
+				assert.Equal(t, tc.Application.Ports[idx].InternalPort, p.InternalPort)
+				assert.Equal(t, string(tc.Application.Ports[idx].Protocol), p.Protocol.String())
+			}
+
+			require.Len(t, app.CustomDomains, len(customDomains.Results))
+			assert.Equal(t, customDomains.Results[0].Domain, app.CustomDomains[0].Domain)
+		})
+	}
+}
+
+func TestNewQoveryApplicationRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       application.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_unknown_git_provider",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+				GitRepository: application.GitRepositoryRequest{
+					Url: "https://git.example.com/qovery/app.git",
+				},
+			},
+			ExpectedError: application.ErrInvalidGitRepositoryParam,
+		},
+		{
+			TestName: "fail_with_invalid_build_mode",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+				GitRepository: application.GitRepositoryRequest{
+					Url: "https://github.com/Qovery/test_http_server.git",
+				},
+				BuildMode: new("BUILDPACKS"),
+			},
+			ExpectedError: application.ErrInvalidBuildModeParam,
+		},
+		{
+			TestName: "success",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+				GitRepository: application.GitRepositoryRequest{
+					Url:    "https://gitlab.com/qovery/app.git",
+					Branch: new("main"),
+				},
+				BuildMode: new("DOCKER"),
+				CPU:       new(int32(500)),
+				Ports: []port.UpsertRequest{
+					{InternalPort: 80, PubliclyAccessible: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := newQoveryApplicationRequestFromDomain(tc.Request)
+			editReq, editErr := newQoveryApplicationEditRequestFromDomain(tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, req)
+				assert.ErrorContains(t, editErr, tc.ExpectedError.Error())
+				assert.Nil(t, editReq)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.GitRepository.Url, req.GitRepository.Url)
+			assert.Equal(t, qovery.GITPROVIDERENUM_GITLAB, req.GitRepository.Provider)
+			assert.Equal(t, qovery.BUILDMODEENUM_DOCKER, *req.BuildMode)
+			assert.Equal(t, tc.Request.CPU, req.Cpu)
+			require.Len(t, req.Ports, len(tc.Request.Ports))
+
+			require.NoError(t, editErr)
+			assert.Equal(t, tc.Request.Name, *editReq.Name)
+			assert.Equal(t, qovery.GITPROVIDERENUM_GITLAB, editReq.GitRepository.Provider)
+			require.Len(t, editReq.Ports, len(tc.Request.Ports))
+			assert.Equal(t, qovery.PortProtocolEnum(port.DefaultProtocol), editReq.Ports[0].Protocol)
+			assert.Empty(t, editReq.Ports[0].Id)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+)
+
+// Ensure applicationSecretsQoveryAPI defined types fully satisfy the secret.Repository interface.
+var _ secret.Repository = applicationSecretsQoveryAPI{}
+
+// applicationSecretsQoveryAPI implements the interface secret.Repository.
+type applicationSecretsQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationSecretsQoveryAPI return a new instance of a secret.Repository that uses Qovery's API.
+func newApplicationSecretsQoveryAPI(client *qovery.APIClient) (secret.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationSecretsQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create an environment secret for an application using the given applicationID and request.
+func (p applicationSecretsQoveryAPI) Create(ctx context.Context, applicationID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretAPI.
+		CreateApplicationSecret(ctx, applicationID).
+		SecretRequest(newQoverySecretRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationSecret, request.Key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// List calls Qovery's API to retrieve an environment secrets from an application using the given applicationID and secretID.
+func (p applicationSecretsQoveryAPI) List(ctx context.Context, applicationID string) (secret.Secrets, error) {
+	vars, resp, err := p.client.ApplicationSecretAPI.
+		ListApplicationSecrets(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceApplicationSecret, applicationID, resp, err)
+	}
+
+	return newDomainSecretsFromQovery(vars)
+}
+
+// Update calls Qovery's API to update an environment secret from an application using the given applicationID, credentialsID and request.
+func (p applicationSecretsQoveryAPI) Update(ctx context.Context, applicationID string, credentialsID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretAPI.
+		EditApplicationSecret(ctx, applicationID, credentialsID).
+		SecretEditRequest(newQoverySecretEditRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceApplicationSecret, credentialsID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// Delete calls Qovery's API to delete an environment secret from an application using the given applicationID and credentialsID.
+func (p applicationSecretsQoveryAPI) Delete(ctx context.Context, applicationID string, credentialsID string) *apierrors.APIError {
+	resp, err := p.client.ApplicationSecretAPI.
+		DeleteApplicationSecret(ctx, applicationID, credentialsID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceApplicationSecret, credentialsID, resp, err)
+	}
+
+	return nil
+}
+
+func (p applicationSecretsQoveryAPI) CreateAlias(ctx context.Context, applicationId string, request secret.UpsertRequest, aliasedSecretId string) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretAPI.
+		CreateApplicationSecretAlias(ctx, applicationId, aliasedSecretId).
+		Key(qovery.Key{
+			Key:         request.Key,
+			Description: *qovery.NewNullableString(&request.Description),
+		}).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationSecret, applicationId, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+func (p applicationSecretsQoveryAPI) CreateOverride(ctx context.Context, applicationId string, request secret.UpsertRequest, overriddenSecretId string) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretAPI.
+		CreateApplicationSecretOverride(ctx, applicationId, overriddenSecretId).
+		Value(qovery.Value{
+			Value:       &request.Value,
+			Description: *qovery.NewNullableString(&request.Description),
+		}).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceApplicationSecret, applicationId, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
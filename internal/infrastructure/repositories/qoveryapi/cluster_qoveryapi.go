@@ -0,0 +1,282 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// Ensure clusterQoveryAPI defined types fully satisfy the cluster.Repository interface.
+var _ cluster.Repository = clusterQoveryAPI{}
+
+// clusterQoveryAPI implements the interface cluster.Repository.
+type clusterQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newClusterQoveryAPI return a new instance of a cluster.Repository that uses Qovery's API.
+func newClusterQoveryAPI(client *qovery.APIClient) (cluster.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &clusterQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a cluster for an organization using the given organizationID and request.
+func (c clusterQoveryAPI) Create(ctx context.Context, organizationID string, request cluster.UpsertRepositoryRequest) (*cluster.Cluster, error) {
+	req, err := newQoveryClusterRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	newCluster, resp, err := c.client.ClustersAPI.
+		CreateCluster(ctx, organizationID).
+		ClusterRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceCluster, request.Name, resp, err)
+	}
+
+	return c.upsertClusterDetails(ctx, organizationID, newCluster, request)
+}
+
+// Get calls Qovery's API to retrieve a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Get(ctx context.Context, organizationID string, clusterID string, advancedSettingsJsonFromState string, isTriggeredFromImport bool) (*cluster.Cluster, error) {
+	qoveryCluster, err := c.getClusterByID(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, resp, err := c.client.ClustersAPI.
+		GetOrganizationCloudProviderInfo(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterCloudProvider, clusterID, resp, err)
+	}
+
+	routingTable, err := c.GetRoutingTable(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	advancedSettingsJson, err := advanced_settings.NewClusterAdvancedSettingsService(c.client.GetConfig()).ReadClusterAdvancedSettings(organizationID, clusterID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	if err != nil {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterAdvancedSettings, clusterID, nil, err)
+	}
+
+	return newDomainClusterFromQovery(organizationID, qoveryCluster, info, routingTable, *advancedSettingsJson)
+}
+
+// Update calls Qovery's API to update a cluster using the given organizationID, clusterID and request.
+func (c clusterQoveryAPI) Update(ctx context.Context, organizationID string, clusterID string, request cluster.UpsertRepositoryRequest) (*cluster.Cluster, error) {
+	req, err := newQoveryClusterRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	// INFO (cor-775) As DiskSize is defaulted when no value is present in the request, we need to set it to current value
+	// This is due to the attribute `disk_size` that was not there before
+	// INFO: Same logic applies for MetricsParameters - preserve value set via console without exposing it in Terraform state
+	if req.DiskSize == nil || req.MetricsParameters == nil {
+		currentCluster, err := c.getClusterByID(ctx, organizationID, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if req.DiskSize == nil {
+			req.DiskSize = currentCluster.DiskSize
+		}
+		if req.MetricsParameters == nil {
+			req.MetricsParameters = currentCluster.MetricsParameters
+		}
+	}
+
+	updatedCluster, resp, err := c.client.ClustersAPI.
+		EditCluster(ctx, organizationID, clusterID).
+		ClusterRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	return c.upsertClusterDetails(ctx, organizationID, updatedCluster, request)
+}
+
+// Delete calls Qovery's API to delete a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Delete(ctx context.Context, organizationID string, clusterID string) error {
+	resp, err := c.client.ClustersAPI.
+		DeleteCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// GetStatus calls Qovery's API to retrieve the state of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) GetStatus(ctx context.Context, organizationID string, clusterID string) (*cluster.State, error) {
+	clusterStatus, resp, err := c.client.ClustersAPI.
+		GetClusterStatus(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterStatus, clusterID, resp, err)
+	}
+
+	return cluster.NewStateFromString(string(clusterStatus.GetStatus()))
+}
+
+// Deploy calls Qovery's API to deploy a cluster using the given organizationID and clusterID.
+// The cluster is deployed even if it is already DEPLOYED to apply the changes made by an edition.
+func (c clusterQoveryAPI) Deploy(ctx context.Context, organizationID string, clusterID string) error {
+	_, resp, err := c.client.ClustersAPI.
+		DeployCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return apierrors.NewDeployAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// Upgrade calls Qovery's API to upgrade the kubernetes version of a cluster to the next minor version using the given
+// organizationID and clusterID.
+func (c clusterQoveryAPI) Upgrade(ctx context.Context, organizationID string, clusterID string) error {
+	_, resp, err := c.client.ClustersAPI.
+		UpgradeCluster(ctx, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return apierrors.NewUpgradeAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// Stop calls Qovery's API to stop a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Stop(ctx context.Context, organizationID string, clusterID string) error {
+	_, resp, err := c.client.ClustersAPI.
+		StopCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return apierrors.NewStopAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// GetKubeconfig calls Qovery's API to retrieve the kubeconfig of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) GetKubeconfig(ctx context.Context, organizationID string, clusterID string) (string, error) {
+	kubeconfig, resp, err := c.client.ClustersAPI.
+		GetClusterKubeconfig(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return "", apierrors.NewReadAPIError(apierrors.APIResourceClusterKubeconfig, clusterID, resp, err)
+	}
+
+	return kubeconfig, nil
+}
+
+// SetKubeconfig calls Qovery's API to set the kubeconfig of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) SetKubeconfig(ctx context.Context, organizationID string, clusterID string, kubeconfig string) error {
+	resp, err := c.client.ClustersAPI.
+		EditClusterKubeconfig(ctx, organizationID, clusterID).
+		Body(kubeconfig).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return apierrors.NewUpdateAPIError(apierrors.APIResourceClusterKubeconfig, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// GetRoutingTable calls Qovery's API to retrieve the routing table of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) GetRoutingTable(ctx context.Context, organizationID string, clusterID string) (cluster.RoutingTable, error) {
+	routingTable, resp, err := c.client.ClustersAPI.
+		GetRoutingTable(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterRoutingTable, clusterID, resp, err)
+	}
+
+	return newDomainRoutingTableFromQovery(routingTable), nil
+}
+
+// EditRoutingTable calls Qovery's API to replace the routing table of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable cluster.RoutingTable) (cluster.RoutingTable, error) {
+	editedRoutingTable, resp, err := c.client.ClustersAPI.
+		EditRoutingTable(ctx, organizationID, clusterID).
+		ClusterRoutingTableRequest(newQoveryRoutingTableRequestFromDomain(routingTable)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceClusterRoutingTable, clusterID, resp, err)
+	}
+
+	return newDomainRoutingTableFromQovery(editedRoutingTable), nil
+}
+
+// getClusterByID looks the cluster up in the clusters of its organization, as there is no endpoint to get a single cluster.
+func (c clusterQoveryAPI) getClusterByID(ctx context.Context, organizationID string, clusterID string) (*qovery.Cluster, error) {
+	clusters, resp, err := c.client.ClustersAPI.
+		ListOrganizationCluster(ctx, organizationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceCluster, clusterID, resp, err)
+	}
+
+	for _, qoveryCluster := range clusters.GetResults() {
+		if qoveryCluster.Id == clusterID {
+			return &qoveryCluster, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFoundAPIError(apierrors.APIResourceCluster, clusterID)
+}
+
+// upsertClusterDetails applies the parts of the request handled by dedicated endpoints once the cluster itself is created or edited:
+// the cloud provider credentials, the routing table and the advanced settings.
+func (c clusterQoveryAPI) upsertClusterDetails(ctx context.Context, organizationID string, qoveryCluster *qovery.Cluster, request cluster.UpsertRepositoryRequest) (*cluster.Cluster, error) {
+	cloudProviderRequest, err := newQoveryClusterCloudProviderInfoRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	if cloudProviderRequest != nil {
+		_, resp, err := c.client.ClustersAPI.
+			SpecifyClusterCloudProviderInfo(ctx, organizationID, qoveryCluster.Id).
+			ClusterCloudProviderInfoRequest(*cloudProviderRequest).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceClusterCloudProvider, qoveryCluster.Id, resp, err)
+		}
+	}
+
+	info, resp, err := c.client.ClustersAPI.
+		GetOrganizationCloudProviderInfo(ctx, organizationID, qoveryCluster.Id).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceClusterCloudProvider, qoveryCluster.Id, resp, err)
+	}
+
+	var routingTable cluster.RoutingTable
+	if len(request.RoutingTable) > 0 {
+		routingTable, err = c.EditRoutingTable(ctx, organizationID, qoveryCluster.Id, request.RoutingTable)
+	} else {
+		routingTable, err = c.GetRoutingTable(ctx, organizationID, qoveryCluster.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := advanced_settings.NewClusterAdvancedSettingsService(c.client.GetConfig()).UpdateClusterAdvancedSettings(organizationID, qoveryCluster.Id, request.AdvancedSettingsJson); err != nil {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceClusterAdvancedSettings, qoveryCluster.Id, nil, err)
+	}
+
+	return newDomainClusterFromQovery(organizationID, qoveryCluster, info, routingTable, request.AdvancedSettingsJson)
+}
@@ -0,0 +1,166 @@
+package qoveryapi
+
+import (
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// newDomainClusterFromQovery takes a qovery cluster resource and its cloud provider info and turns them into a domain cluster.
+func newDomainClusterFromQovery(organizationID string, c *qovery.Cluster, info *qovery.ClusterCloudProviderInfo, routingTable cluster.RoutingTable, advancedSettingsJson string) (*cluster.Cluster, error) {
+	if c == nil {
+		return nil, cluster.ErrNilCluster
+	}
+
+	var credentialsID *string
+	if info != nil && info.Credentials != nil {
+		credentialsID = info.Credentials.Id
+	}
+
+	var kubernetesMode *string
+	if c.Kubernetes != nil {
+		kubernetesMode = new(string(*c.Kubernetes))
+	}
+
+	var state *string
+	if c.Status != nil {
+		state = new(string(*c.Status))
+	}
+
+	labelsGroupIds := make([]string, 0, len(c.LabelsGroups))
+	for _, lg := range c.LabelsGroups {
+		if lg.Id != nil {
+			labelsGroupIds = append(labelsGroupIds, *lg.Id)
+		}
+	}
+
+	return cluster.NewCluster(cluster.NewClusterParams{
+		ClusterID:                      c.Id,
+		OrganizationID:                 organizationID,
+		CredentialsID:                  credentialsID,
+		Name:                           c.Name,
+		CloudProvider:                  string(c.CloudProvider),
+		Region:                         c.Region,
+		Description:                    c.Description,
+		KubernetesMode:                 kubernetesMode,
+		KubernetesVersion:              c.Version,
+		InstanceType:                   c.InstanceType,
+		DiskSize:                       c.DiskSize,
+		MinRunningNodes:                c.MinRunningNodes,
+		MaxRunningNodes:                c.MaxRunningNodes,
+		Production:                     c.Production,
+		State:                          state,
+		Features:                       c.Features,
+		Keda:                           c.Keda,
+		RoutingTable:                   routingTable,
+		AdvancedSettingsJson:           advancedSettingsJson,
+		InfrastructureOutputs:          c.InfrastructureOutputs,
+		InfrastructureChartsParameters: c.InfrastructureChartsParameters,
+		LabelsGroupIds:                 labelsGroupIds,
+		SecretManagerAccesses:          c.SecretManagerAccesses,
+	})
+}
+
+// newQoveryClusterRequestFromDomain takes the domain request cluster.UpsertRepositoryRequest and turns it into a qovery.ClusterRequest to make the api call.
+func newQoveryClusterRequestFromDomain(request cluster.UpsertRepositoryRequest) (*qovery.ClusterRequest, error) {
+	cloudVendor, err := qovery.NewCloudVendorEnumFromValue(request.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidCloudProviderParam.Error())
+	}
+
+	kubernetesMode, err := qovery.NewKubernetesEnumFromValue(request.KubernetesMode)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidKubernetesModeParam.Error())
+	}
+
+	cloudProviderCredentials, err := newQoveryClusterCloudProviderInfoRequestFromDomain(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var labelsGroups []qovery.ClusterLabelsGroup
+	if request.LabelsGroupIds != nil {
+		labelsGroups = make([]qovery.ClusterLabelsGroup, 0, len(request.LabelsGroupIds))
+		for _, id := range request.LabelsGroupIds {
+			labelsGroups = append(labelsGroups, qovery.ClusterLabelsGroup{Id: new(id)})
+		}
+	}
+
+	req := &qovery.ClusterRequest{
+		Name:                           request.Name,
+		CloudProvider:                  *cloudVendor,
+		CloudProviderCredentials:       cloudProviderCredentials,
+		Region:                         request.Region,
+		Description:                    request.Description,
+		Kubernetes:                     kubernetesMode,
+		Production:                     request.Production,
+		Features:                       request.Features,
+		Keda:                           request.Keda,
+		InfrastructureChartsParameters: request.InfrastructureChartsParameters,
+		LabelsGroups:                   labelsGroups,
+		SecretManagerAccesses:          request.SecretManagerAccesses,
+	}
+
+	// When Karpenter is enabled, these fields are managed by Karpenter — don't send them to the API.
+	if !request.HasKarpenter() {
+		req.InstanceType = request.InstanceType
+		req.DiskSize = request.DiskSize
+		req.MinRunningNodes = request.MinRunningNodes
+		req.MaxRunningNodes = request.MaxRunningNodes
+	}
+
+	return req, nil
+}
+
+// newQoveryClusterCloudProviderInfoRequestFromDomain takes the domain request cluster.UpsertRepositoryRequest and turns it into a qovery.ClusterCloudProviderInfoRequest.
+// It returns nil if the request does not specify cloud provider credentials.
+func newQoveryClusterCloudProviderInfoRequestFromDomain(request cluster.UpsertRepositoryRequest) (*qovery.ClusterCloudProviderInfoRequest, error) {
+	if request.CloudProviderCredentials == nil {
+		return nil, nil
+	}
+
+	cloudProvider, err := qovery.NewCloudProviderEnumFromValue(request.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidCloudProviderParam.Error())
+	}
+
+	return &qovery.ClusterCloudProviderInfoRequest{
+		CloudProvider: cloudProvider,
+		Region:        new(request.Region),
+		Credentials: &qovery.ClusterCloudProviderInfoCredentials{
+			Id:   new(request.CloudProviderCredentials.ID),
+			Name: new(request.CloudProviderCredentials.Name),
+		},
+	}, nil
+}
+
+// newDomainRoutingTableFromQovery takes a qovery cluster routing table and turns it into a domain cluster.RoutingTable.
+func newDomainRoutingTableFromQovery(routingTable *qovery.ClusterRoutingTable) cluster.RoutingTable {
+	routes := make(cluster.RoutingTable, 0, len(routingTable.GetResults()))
+	for _, r := range routingTable.GetResults() {
+		routes = append(routes, cluster.Route{
+			Description: r.Description,
+			Destination: r.Destination,
+			Target:      r.Target,
+		})
+	}
+
+	return routes
+}
+
+// newQoveryRoutingTableRequestFromDomain takes a domain cluster.RoutingTable and turns it into a qovery.ClusterRoutingTableRequest to make the api call.
+func newQoveryRoutingTableRequestFromDomain(routingTable cluster.RoutingTable) qovery.ClusterRoutingTableRequest {
+	routes := make([]qovery.ClusterRoutingTableResultsInner, 0, len(routingTable))
+	for _, r := range routingTable {
+		routes = append(routes, qovery.ClusterRoutingTableResultsInner{
+			Description: r.Description,
+			Destination: r.Destination,
+			Target:      r.Target,
+		})
+	}
+
+	return qovery.ClusterRoutingTableRequest{
+		Routes: routes,
+	}
+}
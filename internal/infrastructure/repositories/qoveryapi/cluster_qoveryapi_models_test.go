@@ -0,0 +1,149 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestNewDomainClusterFromQovery(t *testing.T) {
+	t.Parallel()
+
+	labelsGroupID := gofakeit.UUID()
+
+	testCases := []struct {
+		TestName      string
+		Cluster       *qovery.Cluster
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_nil_cluster",
+			Cluster:       nil,
+			ExpectedError: cluster.ErrNilCluster,
+		},
+		{
+			TestName: "success",
+			Cluster: &qovery.Cluster{
+				Id:              gofakeit.UUID(),
+				Name:            gofakeit.Name(),
+				CloudProvider:   qovery.CLOUDVENDORENUM_AWS,
+				Region:          "eu-west-3",
+				Kubernetes:      new(qovery.KUBERNETESENUM_MANAGED),
+				Status:          new(qovery.CLUSTERSTATEENUM_DEPLOYED),
+				InstanceType:    new("t3a.large"),
+				MinRunningNodes: new(int32(3)),
+				MaxRunningNodes: new(int32(5)),
+				// Entries without id are skipped.
+				LabelsGroups: []qovery.ClusterLabelsGroup{{Id: &labelsGroupID}, {Id: nil}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			organizationID := gofakeit.UUID()
+			info := &qovery.ClusterCloudProviderInfo{
+				Credentials: &qovery.ClusterCloudProviderInfoCredentials{Id: new("cred-123")},
+			}
+			routingTable := cluster.RoutingTable{{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-1234"}}
+
+			c, err := newDomainClusterFromQovery(organizationID, tc.Cluster, info, routingTable, "{}")
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, c.IsValid())
+			assert.Equal(t, tc.Cluster.Id, c.ID.String())
+			assert.Equal(t, organizationID, c.OrganizationID.String())
+			assert.Equal(t, "cred-123", *c.CredentialsID)
+			assert.Equal(t, cluster.CloudProviderAWS, c.CloudProvider)
+			assert.Equal(t, cluster.KubernetesModeManaged, *c.KubernetesMode)
+			assert.Equal(t, cluster.StateDeployed, *c.State)
+			assert.Equal(t, tc.Cluster.InstanceType, c.InstanceType)
+			assert.Equal(t, []string{labelsGroupID}, c.LabelsGroupIds)
+			assert.Equal(t, routingTable, c.RoutingTable)
+			assert.Equal(t, "{}", c.AdvancedSettingsJson)
+		})
+	}
+}
+
+func TestNewQoveryClusterRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	request := cluster.UpsertRepositoryRequest{
+		Name:            gofakeit.Name(),
+		CloudProvider:   "AWS",
+		Region:          "eu-west-3",
+		KubernetesMode:  "MANAGED",
+		InstanceType:    new("t3a.large"),
+		DiskSize:        new(int32(50)),
+		MinRunningNodes: new(int32(3)),
+		MaxRunningNodes: new(int32(5)),
+		LabelsGroupIds:  []string{gofakeit.UUID()},
+		CloudProviderCredentials: &cluster.CloudProviderCredentials{
+			ID:   gofakeit.UUID(),
+			Name: "aws-credentials",
+		},
+	}
+
+	req, err := newQoveryClusterRequestFromDomain(request)
+	require.NoError(t, err)
+	assert.Equal(t, request.Name, req.Name)
+	assert.Equal(t, qovery.CLOUDVENDORENUM_AWS, req.CloudProvider)
+	assert.Equal(t, qovery.KUBERNETESENUM_MANAGED, *req.Kubernetes)
+	assert.Equal(t, request.InstanceType, req.InstanceType)
+	assert.Equal(t, request.MinRunningNodes, req.MinRunningNodes)
+	require.Len(t, req.LabelsGroups, 1)
+	assert.Equal(t, request.LabelsGroupIds[0], *req.LabelsGroups[0].Id)
+	require.NotNil(t, req.CloudProviderCredentials)
+	assert.Equal(t, request.CloudProviderCredentials.ID, *req.CloudProviderCredentials.Credentials.Id)
+
+	// Sizing is managed by Karpenter once enabled, so it is not sent to the API.
+	request.Features = []qovery.ClusterRequestFeaturesInner{{Id: new(cluster.FeatureIDKarpenter)}}
+	req, err = newQoveryClusterRequestFromDomain(request)
+	require.NoError(t, err)
+	assert.Nil(t, req.InstanceType)
+	assert.Nil(t, req.DiskSize)
+	assert.Nil(t, req.MinRunningNodes)
+	assert.Nil(t, req.MaxRunningNodes)
+
+	// Labels groups are omitted when not managed.
+	request.LabelsGroupIds = nil
+	request.CloudProviderCredentials = nil
+	req, err = newQoveryClusterRequestFromDomain(request)
+	require.NoError(t, err)
+	assert.Nil(t, req.LabelsGroups)
+	assert.Nil(t, req.CloudProviderCredentials)
+
+	request.CloudProvider = "UNKNOWN"
+	_, err = newQoveryClusterRequestFromDomain(request)
+	assert.ErrorContains(t, err, cluster.ErrInvalidCloudProviderParam.Error())
+}
+
+func TestRoutingTableRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	routingTable := cluster.RoutingTable{
+		{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-1234"},
+		{Description: "vpn", Destination: "10.2.0.0/16", Target: "vgw-5678"},
+	}
+
+	req := newQoveryRoutingTableRequestFromDomain(routingTable)
+	require.Len(t, req.Routes, 2)
+
+	assert.Equal(t, routingTable, newDomainRoutingTableFromQovery(&qovery.ClusterRoutingTable{Results: req.Routes}))
+	assert.Empty(t, newDomainRoutingTableFromQovery(nil))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 // Ensure containerQoveryAPI defined types fully satisfy the container.Repository interface.
@@ -17,22 +18,24 @@ var _ container.Repository = containerQoveryAPI{}
 
 // containerQoveryAPI implements the interface container.Repository.
 type containerQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
 // newContainerQoveryAPI return a new instance of a container.Repository that uses Qovery's API.
-func newContainerQoveryAPI(client *qovery.APIClient) (container.Repository, error) {
+func newContainerQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (container.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &containerQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
 // Create calls Qovery's API to create a container for an organization using the given organizationID and request.
-func (c containerQoveryAPI) Create(ctx context.Context, environmentID string, request container.UpsertRepositoryRequest) (*container.Container, error) {
+func (c containerQoveryAPI) Create(ctx context.Context, environmentID string, request container.UpsertRepositoryRequest) (_ *container.Container, err error) {
 	req, err := newQoveryContainerRequestFromDomain(request)
 	if err != nil {
 		return nil, errors.Wrap(err, container.ErrInvalidUpsertRequest.Error())
@@ -46,6 +49,13 @@ func (c containerQoveryAPI) Create(ctx context.Context, environmentID string, re
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceContainer, request.Name, resp, err)
 	}
 
+	// From now on the container exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*container.Container, error) {
+			return c.Get(ctx, newContainer.Id, request.AdvancedSettingsJson, false)
+		})
+	}()
+
 	// Create custom domains
 	if !request.CustomDomains.IsEmpty() {
 		for _, customDomain := range request.CustomDomains.Create {
@@ -78,6 +88,9 @@ func (c containerQoveryAPI) Create(ctx context.Context, environmentID string, re
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceContainer, newContainer.Id, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newContainer.Id)
+
 	// Get container deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, newContainer.Id).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -103,13 +116,13 @@ func (c containerQoveryAPI) Get(ctx context.Context, containerID string, advance
 	}
 
 	// Get container deployment stage
-	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, container.Id).Execute()
-	if err != nil || (resp != nil && resp.StatusCode >= 400) {
-		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceContainer, container.Id, resp, err)
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceContainer, container.Id)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get advanced settings
-	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).ReadServiceAdvancedSettings(domain.CONTAINER, container.Id, advancedSettingsJsonFromState, isTriggeredFromImport)
+	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsServiceWithCache(c.client.GetConfig(), newAdvancedSettingsDefaultsCache(c.client.GetConfig(), c.readCache)).ReadServiceAdvancedSettings(domain.CONTAINER, container.Id, advancedSettingsJsonFromState, isTriggeredFromImport)
 	if err != nil {
 		return nil, apierrors.NewReadAPIError(apierrors.APIResourceContainer, containerID, nil, err)
 	}
@@ -191,6 +204,9 @@ func (c containerQoveryAPI) Update(ctx context.Context, containerID string, requ
 		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceContainer, container.Id, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, container.Id)
+
 	// Get container deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, container.Id).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -226,5 +242,6 @@ func (c containerQoveryAPI) Delete(ctx context.Context, containerID string) erro
 		return apierrors.NewDeleteAPIError(apierrors.APIResourceContainer, containerID, resp, err)
 	}
 
+	invalidateServiceDeploymentStage(c.readCache, containerID)
 	return nil
 }
@@ -0,0 +1,79 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+// Ensure databaseDeploymentQoveryAPI defined types fully satisfy the deployment.Repository interface.
+var _ deployment.Repository = databaseDeploymentQoveryAPI{}
+
+// databaseDeploymentQoveryAPI implements the interface deployment.Repository.
+type databaseDeploymentQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newDatabaseDeploymentQoveryAPI return a new instance of a deployment.Repository that uses Qovery's API.
+func newDatabaseDeploymentQoveryAPI(client *qovery.APIClient) (deployment.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &databaseDeploymentQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// GetStatus calls Qovery's API to get the status of a database using the given databaseID.
+func (c databaseDeploymentQoveryAPI) GetStatus(ctx context.Context, databaseID string) (*status.Status, error) {
+	databaseStatus, resp, err := c.client.DatabaseMainCallsAPI.
+		GetDatabaseStatus(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceDatabaseStatus, databaseID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(databaseStatus)
+}
+
+// Deploy calls Qovery's API to deploy a database using the given databaseID.
+// Databases are not versioned, the version is ignored.
+func (c databaseDeploymentQoveryAPI) Deploy(ctx context.Context, databaseID string, _ string) (*status.Status, error) {
+	databaseStatus, resp, err := c.client.DatabaseActionsAPI.
+		DeployDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewDeployAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(databaseStatus)
+}
+
+// Redeploy calls Qovery's API to redeploy a database using the given databaseID.
+func (c databaseDeploymentQoveryAPI) Redeploy(ctx context.Context, databaseID string) (*status.Status, error) {
+	databaseStatus, resp, err := c.client.DatabaseActionsAPI.
+		RedeployDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewRedeployAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(databaseStatus)
+}
+
+// Stop calls Qovery's API to stop a database using the given databaseID.
+func (c databaseDeploymentQoveryAPI) Stop(ctx context.Context, databaseID string) (*status.Status, error) {
+	databaseStatus, resp, err := c.client.DatabaseActionsAPI.
+		StopDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewStopAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	return newDomainStatusFromQovery(databaseStatus)
+}
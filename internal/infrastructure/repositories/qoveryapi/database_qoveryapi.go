@@ -0,0 +1,183 @@
+package qoveryapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
+)
+
+// Ensure databaseQoveryAPI defined types fully satisfy the database.Repository interface.
+var _ database.Repository = databaseQoveryAPI{}
+
+// databaseQoveryAPI implements the interface database.Repository.
+type databaseQoveryAPI struct {
+	client    *qovery.APIClient
+	readCache *readcache.Cache
+}
+
+// newDatabaseQoveryAPI return a new instance of a database.Repository that uses Qovery's API.
+func newDatabaseQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (database.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &databaseQoveryAPI{
+		client:    client,
+		readCache: readCache,
+	}, nil
+}
+
+// Create calls Qovery's API to create a database for an environment using the given environmentID and request.
+func (c databaseQoveryAPI) Create(ctx context.Context, environmentID string, request database.UpsertRepositoryRequest) (_ *database.Database, err error) {
+	req, err := newQoveryDatabaseRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	newDatabase, resp, err := c.client.DatabasesAPI.
+		CreateDatabase(ctx, environmentID).
+		DatabaseRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceDatabase, request.Name, resp, err)
+	}
+
+	// From now on the database exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*database.Database, error) {
+			return c.Get(ctx, newDatabase.Id)
+		})
+	}()
+
+	// Attach database to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, newDatabase.Id, request.IsSkipped)
+		if err != nil || (response != nil && response.StatusCode >= 400) {
+			return nil, apierrors.NewCreateAPIError(apierrors.APIResourceDatabase, request.DeploymentStageID, response, err)
+		}
+	}
+
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newDatabase.Id)
+
+	return c.getDatabaseDetails(ctx, newDatabase, func(resp *http.Response, err error) error {
+		return apierrors.NewCreateAPIError(apierrors.APIResourceDatabase, newDatabase.Id, resp, err)
+	})
+}
+
+// Get calls Qovery's API to retrieve a database using the given databaseID.
+func (c databaseQoveryAPI) Get(ctx context.Context, databaseID string) (*database.Database, error) {
+	db, resp, err := c.client.DatabaseMainCallsAPI.
+		GetDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	return c.getDatabaseDetails(ctx, db, func(resp *http.Response, err error) error {
+		return apierrors.NewReadAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	})
+}
+
+// Update calls Qovery's API to update a database using the given databaseID and request.
+func (c databaseQoveryAPI) Update(ctx context.Context, databaseID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	req, err := newQoveryDatabaseEditRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	db, resp, err := c.client.DatabaseMainCallsAPI.
+		EditDatabase(ctx, databaseID).
+		DatabaseEditRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	// Attach database to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, db.Id, request.IsSkipped)
+		if err != nil || (response != nil && response.StatusCode >= 400) {
+			return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceDatabase, request.DeploymentStageID, response, err)
+		}
+	}
+
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, db.Id)
+
+	return c.getDatabaseDetails(ctx, db, func(resp *http.Response, err error) error {
+		return apierrors.NewUpdateAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	})
+}
+
+// Delete calls Qovery's API to delete a database using the given databaseID.
+// The deletion is only requested once the environment of the database has reached a final state.
+func (c databaseQoveryAPI) Delete(ctx context.Context, databaseID string) error {
+	db, resp, err := c.client.DatabaseMainCallsAPI.
+		GetDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		if resp != nil && resp.StatusCode == 404 {
+			// if the database is not found, then it has already been deleted
+			return nil
+		}
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	if err := waitForEnvironmentFinalState(ctx, c.client, db.Environment.Id); err != nil {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceDatabase, databaseID, nil, err)
+	}
+
+	resp, err = c.client.DatabaseMainCallsAPI.
+		DeleteDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteAPIError(apierrors.APIResourceDatabase, databaseID, resp, err)
+	}
+
+	invalidateServiceDeploymentStage(c.readCache, databaseID)
+	return nil
+}
+
+// getDatabaseDetails fetches the credentials, internal host and deployment stage of the given database and turns it into a database.Database.
+// Errors are built with newError so they are reported for the operation in progress.
+func (c databaseQoveryAPI) getDatabaseDetails(ctx context.Context, db *qovery.Database, newError func(resp *http.Response, err error) error) (*database.Database, error) {
+	credentials, resp, err := c.client.DatabaseMainCallsAPI.
+		GetDatabaseMasterCredentials(ctx, db.Id).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, newError(resp, err)
+	}
+
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceDatabase, db.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := newDomainDatabaseFromQovery(db, credentials, deploymentStage.Id, getServiceIsSkipped(deploymentStage, db.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	// The internal host of a database is only exposed through the built-in variables of its environment.
+	vars, resp, err := c.client.EnvironmentVariableAPI.
+		ListEnvironmentEnvironmentVariable(ctx, db.Environment.Id).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadAPIError(apierrors.APIResourceEnvironmentEnvironmentVariable, db.Environment.Id, resp, err)
+	}
+
+	environmentVariables, err := newDomainVariablesFromQovery(vars)
+	if err != nil {
+		return nil, err
+	}
+	d.SetInternalHost(environmentVariables)
+
+	return d, nil
+}
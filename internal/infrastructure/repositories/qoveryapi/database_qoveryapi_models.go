@@ -0,0 +1,145 @@
+package qoveryapi
+
+import (
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// newDomainDatabaseFromQovery takes a qovery.Database returned by the API client and turns it into the domain model database.Database.
+func newDomainDatabaseFromQovery(
+	d *qovery.Database,
+	credentials *qovery.Credentials,
+	deploymentStageID string,
+	isSkipped bool,
+) (*database.Database, error) {
+	if d == nil {
+		return nil, database.ErrNilDatabase
+	}
+
+	var accessibility *string
+	if d.Accessibility != nil {
+		accessibility = new(string(*d.Accessibility))
+	}
+
+	annotationsGroupIds := make([]string, 0, len(d.AnnotationsGroups))
+	for _, annotationsGroup := range d.AnnotationsGroups {
+		annotationsGroupIds = append(annotationsGroupIds, annotationsGroup.Id)
+	}
+
+	labelsGroupIds := make([]string, 0, len(d.LabelsGroups))
+	for _, labelsGroup := range d.LabelsGroups {
+		labelsGroupIds = append(labelsGroupIds, labelsGroup.Id)
+	}
+
+	return database.NewDatabase(database.NewDatabaseParams{
+		DatabaseID:          d.Id,
+		EnvironmentID:       d.Environment.Id,
+		Name:                d.Name,
+		IconUri:             d.IconUri,
+		Type:                string(d.Type),
+		Version:             d.Version,
+		Mode:                string(d.Mode),
+		Accessibility:       accessibility,
+		CPU:                 d.Cpu,
+		Memory:              d.Memory,
+		Storage:             d.Storage,
+		InstanceType:        d.InstanceType,
+		ExternalHost:        d.GetHost(),
+		Port:                d.Port,
+		Login:               credentials.GetLogin(),
+		Password:            credentials.GetPassword(),
+		DeploymentStageID:   deploymentStageID,
+		IsSkipped:           isSkipped,
+		AnnotationsGroupIds: annotationsGroupIds,
+		LabelsGroupIds:      labelsGroupIds,
+	})
+}
+
+// newQoveryDatabaseRequestFromDomain takes the domain request database.UpsertRepositoryRequest and turns it into a qovery.DatabaseRequest to make the api call.
+func newQoveryDatabaseRequestFromDomain(request database.UpsertRepositoryRequest) (*qovery.DatabaseRequest, error) {
+	dbType, err := qovery.NewDatabaseTypeEnumFromValue(request.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidTypeParam.Error())
+	}
+
+	mode, err := qovery.NewDatabaseModeEnumFromValue(request.Mode)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidModeParam.Error())
+	}
+
+	accessibility, err := newQoveryDatabaseAccessibilityFromDomain(request.Accessibility)
+	if err != nil {
+		return nil, err
+	}
+
+	annotationsGroups, err := NewQoveryServiceAnnotationsGroupRequestFromDomain(request.AnnotationsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	labelsGroups, err := NewQoveryServiceLabelsGroupRequestFromDomain(request.LabelsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.DatabaseRequest{
+		Name:              request.Name,
+		IconUri:           request.IconUri,
+		Type:              *dbType,
+		Version:           request.Version,
+		Mode:              *mode,
+		Accessibility:     accessibility,
+		Cpu:               request.CPU,
+		Memory:            request.Memory,
+		Storage:           request.Storage,
+		InstanceType:      request.InstanceType,
+		AnnotationsGroups: annotationsGroups,
+		LabelsGroups:      labelsGroups,
+	}, nil
+}
+
+// newQoveryDatabaseEditRequestFromDomain takes the domain request database.UpsertRepositoryRequest and turns it into a qovery.DatabaseEditRequest to make the api call.
+func newQoveryDatabaseEditRequestFromDomain(request database.UpsertRepositoryRequest) (*qovery.DatabaseEditRequest, error) {
+	accessibility, err := newQoveryDatabaseAccessibilityFromDomain(request.Accessibility)
+	if err != nil {
+		return nil, err
+	}
+
+	annotationsGroups, err := NewQoveryServiceAnnotationsGroupRequestFromDomain(request.AnnotationsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	labelsGroups, err := NewQoveryServiceLabelsGroupRequestFromDomain(request.LabelsGroupIds)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.DatabaseEditRequest{
+		Name:              &request.Name,
+		IconUri:           request.IconUri,
+		Version:           &request.Version,
+		Accessibility:     accessibility,
+		Cpu:               request.CPU,
+		Memory:            request.Memory,
+		Storage:           request.Storage,
+		InstanceType:      request.InstanceType,
+		AnnotationsGroups: annotationsGroups,
+		LabelsGroups:      labelsGroups,
+	}, nil
+}
+
+func newQoveryDatabaseAccessibilityFromDomain(accessibility *string) (*qovery.DatabaseAccessibilityEnum, error) {
+	if accessibility == nil {
+		return nil, nil
+	}
+
+	qoveryAccessibility, err := qovery.NewDatabaseAccessibilityEnumFromValue(*accessibility)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidAccessibilityParam.Error())
+	}
+
+	return qoveryAccessibility, nil
+}
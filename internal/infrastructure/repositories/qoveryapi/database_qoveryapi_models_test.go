@@ -0,0 +1,122 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+func TestNewDomainDatabaseFromQovery(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Database      *qovery.Database
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_nil_database",
+			Database:      nil,
+			ExpectedError: database.ErrNilDatabase,
+		},
+		{
+			TestName: "success",
+			Database: &qovery.Database{
+				Id: gofakeit.UUID(),
+				Environment: qovery.ReferenceObject{
+					Id: gofakeit.UUID(),
+				},
+				Name:          gofakeit.Name(),
+				IconUri:       "app://qovery-console/database",
+				Type:          qovery.DATABASETYPEENUM_POSTGRESQL,
+				Version:       "16",
+				Mode:          qovery.DATABASEMODEENUM_MANAGED,
+				Accessibility: new(qovery.DATABASEACCESSIBILITYENUM_PRIVATE),
+				InstanceType:  new("db.t3.micro"),
+				Storage:       new(int32(10)),
+				Host:          new("zdb.example.com"),
+				Port:          new(int32(5432)),
+				AnnotationsGroups: []qovery.OrganizationAnnotationsGroupResponse{
+					{Id: gofakeit.UUID()},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			credentials := &qovery.Credentials{Login: "superuser", Password: "secret"}
+			db, err := newDomainDatabaseFromQovery(tc.Database, credentials, gofakeit.UUID(), true)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, db)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, db.IsValid())
+			assert.Equal(t, tc.Database.Id, db.ID.String())
+			assert.Equal(t, tc.Database.Environment.Id, db.EnvironmentID.String())
+			assert.Equal(t, string(tc.Database.Type), db.Type.String())
+			assert.Equal(t, string(tc.Database.Mode), db.Mode.String())
+			assert.Equal(t, string(*tc.Database.Accessibility), db.Accessibility.String())
+			assert.Equal(t, tc.Database.InstanceType, db.InstanceType)
+			assert.Equal(t, *tc.Database.Host, db.ExternalHost)
+			assert.Equal(t, tc.Database.Port, db.Port)
+			assert.Equal(t, credentials.Login, db.Login)
+			assert.Equal(t, credentials.Password, db.Password)
+			assert.True(t, db.IsSkipped)
+			assert.Equal(t, []string{tc.Database.AnnotationsGroups[0].Id}, db.AnnotationsGroupIds)
+			assert.Empty(t, db.LabelsGroupIds)
+		})
+	}
+}
+
+func TestNewQoveryDatabaseRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	request := database.UpsertRepositoryRequest{
+		Name:                gofakeit.Name(),
+		Type:                "MYSQL",
+		Version:             "8.0",
+		Mode:                "CONTAINER",
+		Accessibility:       new("PUBLIC"),
+		CPU:                 new(int32(250)),
+		Memory:              new(int32(256)),
+		LabelsGroupIds:      []string{gofakeit.UUID()},
+		AnnotationsGroupIds: []string{},
+	}
+
+	req, err := newQoveryDatabaseRequestFromDomain(request)
+	require.NoError(t, err)
+	assert.Equal(t, request.Name, req.Name)
+	assert.Equal(t, qovery.DATABASETYPEENUM_MYSQL, req.Type)
+	assert.Equal(t, qovery.DATABASEMODEENUM_CONTAINER, req.Mode)
+	assert.Equal(t, qovery.DATABASEACCESSIBILITYENUM_PUBLIC, *req.Accessibility)
+	assert.Equal(t, request.CPU, req.Cpu)
+	require.Len(t, req.LabelsGroups, 1)
+	assert.Equal(t, request.LabelsGroupIds[0], req.LabelsGroups[0].Id)
+
+	editReq, err := newQoveryDatabaseEditRequestFromDomain(request)
+	require.NoError(t, err)
+	assert.Equal(t, request.Name, *editReq.Name)
+	assert.Equal(t, request.Version, *editReq.Version)
+	assert.Equal(t, qovery.DATABASEACCESSIBILITYENUM_PUBLIC, *editReq.Accessibility)
+
+	invalid := request
+	invalid.Accessibility = new("INTERNAL")
+	_, err = newQoveryDatabaseRequestFromDomain(invalid)
+	assert.ErrorContains(t, err, database.ErrInvalidAccessibilityParam.Error())
+	_, err = newQoveryDatabaseEditRequestFromDomain(invalid)
+	assert.ErrorContains(t, err, database.ErrInvalidAccessibilityParam.Error())
+}
@@ -10,19 +10,23 @@ import (
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/polling"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 type deploymentStageQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
-func newDeploymentStageQoveryAPI(client *qovery.APIClient) (deploymentstage.Repository, error) {
+func newDeploymentStageQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (deploymentstage.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &deploymentStageQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
@@ -37,6 +41,7 @@ func (c deploymentStageQoveryAPI) Create(ctx context.Context, environmentID stri
 	if err != nil || resp.StatusCode >= 400 {
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceDeploymentStage, request.Name, resp, err)
 	}
+	defer c.invalidate()
 
 	if request.IsAfter != nil {
 		_, resp, err = c.client.DeploymentStageMainCallsAPI.
@@ -67,9 +72,15 @@ func (c deploymentStageQoveryAPI) Create(ctx context.Context, environmentID stri
 }
 
 func (c deploymentStageQoveryAPI) Get(ctx context.Context, environmentID string, deploymentStageID string) (*deploymentstage.DeploymentStage, error) {
-	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetDeploymentStage(ctx, deploymentStageID).Execute()
-	if deploymentStage == nil {
-		return nil, apierrors.NewReadAPIError(apierrors.APIResourceDeploymentStage, deploymentStageID, resp, err)
+	deploymentStage, err := readcache.Fetch(ctx, c.readCache, readcache.Key(readCacheDeploymentStage, deploymentStageID), func(ctx context.Context) (*qovery.DeploymentStageResponse, error) {
+		deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetDeploymentStage(ctx, deploymentStageID).Execute()
+		if deploymentStage == nil {
+			return nil, apierrors.NewReadAPIError(apierrors.APIResourceDeploymentStage, deploymentStageID, resp, err)
+		}
+		return deploymentStage, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return deploymentstage.NewDeploymentStage(deploymentstage.NewDeploymentStageParams{
@@ -81,13 +92,19 @@ func (c deploymentStageQoveryAPI) Get(ctx context.Context, environmentID string,
 }
 
 func (c deploymentStageQoveryAPI) GetAllByEnvironmentID(ctx context.Context, environmentID string) (*[]deploymentstage.DeploymentStage, error) {
-	result, resp, err := c.client.DeploymentStageMainCallsAPI.ListEnvironmentDeploymentStage(ctx, environmentID).Execute()
+	result, err := readcache.Fetch(ctx, c.readCache, readcache.Key(readCacheDeploymentStage, "environment", environmentID), func(ctx context.Context) (*qovery.DeploymentStageResponseList, error) {
+		result, resp, err := c.client.DeploymentStageMainCallsAPI.ListEnvironmentDeploymentStage(ctx, environmentID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode > 200 {
+			return nil, errors.New("Wrong environment id")
+		}
+		return result, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode > 200 {
-		return nil, errors.New("Wrong environment id")
-	}
 
 	var array []deploymentstage.DeploymentStage
 	for _, deploymentStage := range result.Results {
@@ -116,6 +133,7 @@ func (c deploymentStageQoveryAPI) Update(ctx context.Context, deploymentStageID
 	if err != nil || resp.StatusCode >= 400 {
 		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceDeploymentStage, deploymentStageID, resp, err)
 	}
+	defer c.invalidate()
 
 	if request.IsAfter != nil {
 		_, resp, err = c.client.DeploymentStageMainCallsAPI.
@@ -157,7 +175,7 @@ func (c deploymentStageQoveryAPI) Delete(ctx context.Context, deploymentStageID
 	}
 
 	// 2. Wait for environment to be in a stable state
-	if err := c.waitForEnvironmentFinalState(ctx, stage.Environment.Id); err != nil {
+	if err := waitForEnvironmentFinalState(ctx, c.client, stage.Environment.Id); err != nil {
 		return errors.Wrap(err, "failed to wait for environment final state")
 	}
 
@@ -170,6 +188,8 @@ func (c deploymentStageQoveryAPI) Delete(ctx context.Context, deploymentStageID
 
 		// Success case
 		if err == nil && resp.StatusCode < 300 {
+			c.invalidate()
+
 			// 4. Wait for deployment stage to be fully deleted
 			if err := c.waitForDeploymentStageDeletion(ctx, deploymentStageID); err != nil {
 				return errors.Wrap(err, "deployment stage delete initiated but failed to confirm deletion")
@@ -210,11 +230,17 @@ func (c deploymentStageQoveryAPI) Delete(ctx context.Context, deploymentStageID
 	)
 }
 
+// invalidate drops the cached deployment stages after a write, as the services may have moved between stages.
+func (c deploymentStageQoveryAPI) invalidate() {
+	c.readCache.Invalidate(readCacheDeploymentStage+"/", readCacheServiceDeploymentStage+"/")
+}
+
 // waitForEnvironmentFinalState polls until the environment reaches a stable state
-func (c deploymentStageQoveryAPI) waitForEnvironmentFinalState(ctx context.Context, environmentID string) error {
+func waitForEnvironmentFinalState(ctx context.Context, client *qovery.APIClient, environmentID string) error {
 	timeout := time.After(2 * time.Hour)
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
+	intervals := polling.ServiceStrategy.Intervals()
+	timer := time.NewTimer(intervals.Next())
+	defer timer.Stop()
 
 	for {
 		select {
@@ -222,22 +248,23 @@ func (c deploymentStageQoveryAPI) waitForEnvironmentFinalState(ctx context.Conte
 			return nil // Timeout - proceed anyway
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
-			status, resp, err := c.client.EnvironmentMainCallsAPI.GetEnvironmentStatus(ctx, environmentID).Execute()
+		case <-timer.C:
+			status, resp, err := client.EnvironmentMainCallsAPI.GetEnvironmentStatus(ctx, environmentID).Execute()
 			if err != nil || resp.StatusCode >= 400 {
 				// If we can't get status, continue anyway
 				return nil
 			}
 
-			if c.isEnvironmentInFinalState(status.State) {
+			if isEnvironmentInFinalState(status.State) {
 				return nil
 			}
+			timer.Reset(intervals.Next())
 		}
 	}
 }
 
 // isEnvironmentInFinalState checks if environment is in a stable state
-func (c deploymentStageQoveryAPI) isEnvironmentInFinalState(state qovery.StateEnum) bool {
+func isEnvironmentInFinalState(state qovery.StateEnum) bool {
 	stateStr := string(state)
 	// Not in processing/waiting/queued state
 	return !strings.HasSuffix(stateStr, "ING") &&
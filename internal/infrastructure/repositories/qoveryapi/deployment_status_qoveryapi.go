@@ -3,13 +3,15 @@ package qoveryapi
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/polling"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 )
 
 type deploymentStatusQoveryAPI struct {
@@ -58,51 +60,23 @@ func (d deploymentStatusQoveryAPI) CheckEnvironmentExists(ctx context.Context, e
 type waitFunc func(ctx context.Context) (bool, error)
 
 func waitWithDefaultTimeout(ctx context.Context, f waitFunc) error {
-	defaultWaitTimeout := 4 * time.Hour
-	return wait(ctx, f, &defaultWaitTimeout)
-}
-
-func wait(ctx context.Context, f waitFunc, timeout *time.Duration) error {
-	// Run the function once before waiting
-	ok, err := f(ctx)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
-	}
-
-	ticker := time.NewTicker(10 * time.Second)
-	timeoutTicker := time.NewTicker(*timeout)
-
-	for {
-		select {
-		case <-timeoutTicker.C:
-			return nil
-		case <-ticker.C:
-			ok, apiErr := f(ctx)
-			if apiErr != nil {
-				return apiErr
-			}
-			if ok {
-				return nil
-			}
-		}
-	}
+	return polling.Wait(ctx, f, polling.ServiceStrategy, polling.TransientErrorStrategy, 4*time.Hour)
 }
 
 func (d deploymentStatusQoveryAPI) newEnvironmentWaitForTerminalStateBeforeDeploying(environmentID uuid.UUID) waitFunc {
+	progress := telemetry.NewProgressTracker("Waiting for environment terminal state before deploying")
 	return func(ctx context.Context) (bool, error) {
-		status, response, err := d.client.EnvironmentMainCallsAPI.GetEnvironmentStatus(ctx, environmentID.String()).Execute()
+		statuses, response, err := d.client.EnvironmentMainCallsAPI.GetEnvironmentStatuses(ctx, environmentID.String()).Execute()
 		if err != nil || response.StatusCode >= 400 {
 			return false, err
 		}
+		observeEnvironmentStatuses(ctx, progress, statuses)
+		status := statuses.Environment
 
 		switch status.State {
 		// In progress
 		case "BUILDING", "CANCELING", "DELETE_QUEUED", "DELETING", "DEPLOYING", "STOPPING",
 			"STOP_QUEUED", "RESTART_QUEUED", "RESTARTING", "DEPLOYMENT_QUEUED", "QUEUED":
-			tflog.Info(ctx, fmt.Sprintf("Environment deployment in progress with current status %s...", status.State))
 			return false, nil
 		// Finished with error
 		case "READY", "DEPLOYMENT_ERROR", "DELETE_ERROR", "STOP_ERROR", "RESTART_ERROR",
@@ -116,20 +90,22 @@ func (d deploymentStatusQoveryAPI) newEnvironmentWaitForTerminalStateBeforeDeplo
 }
 
 func (d deploymentStatusQoveryAPI) newEnvironmentWaitForExpectedDesiredState(environmentID uuid.UUID, desiredState newdeployment.DeploymentDesiredState) waitFunc {
+	progress := telemetry.NewProgressTracker(fmt.Sprintf("Waiting for environment to be %s", desiredState))
 	return func(ctx context.Context) (bool, error) {
-		status, response, err := d.client.EnvironmentMainCallsAPI.GetEnvironmentStatus(ctx, environmentID.String()).Execute()
+		statuses, response, err := d.client.EnvironmentMainCallsAPI.GetEnvironmentStatuses(ctx, environmentID.String()).Execute()
 		if err != nil {
-			if response.StatusCode == 404 && desiredState == newdeployment.DELETED {
+			if response != nil && response.StatusCode == 404 && desiredState == newdeployment.DELETED {
 				return true, nil
 			}
 			return false, err
 		}
+		observeEnvironmentStatuses(ctx, progress, statuses)
+		status := statuses.Environment
 
 		switch status.State {
 		// In progress
 		case "BUILDING", "CANCELING", "DELETE_QUEUED", "DELETING", "DEPLOYING", "STOPPING",
 			"STOP_QUEUED", "RESTART_QUEUED", "RESTARTING", "DEPLOYMENT_QUEUED", "QUEUED", "READY":
-			tflog.Info(ctx, fmt.Sprintf("Environment deployment in progress with target status %s...", desiredState))
 			return false, nil
 		// Finished with error
 		case "BUILD_ERROR", "DEPLOYMENT_ERROR", "DELETE_ERROR", "STOP_ERROR", "RESTART_ERROR":
@@ -143,3 +119,33 @@ func (d deploymentStatusQoveryAPI) newEnvironmentWaitForExpectedDesiredState(env
 		return false, fmt.Errorf("Unexpected deployment status having status: %s", status.State)
 	}
 }
+
+// observeEnvironmentStatuses reports the state of the environment and of each of its services to the progress tracker of the current wait.
+func observeEnvironmentStatuses(ctx context.Context, progress *telemetry.ProgressTracker, statuses *qovery.EnvironmentStatuses) {
+	progress.Observe(ctx, "environment "+statuses.Environment.Id, string(statuses.Environment.State))
+	services := []struct {
+		kind     string
+		statuses []qovery.Status
+	}{
+		{kind: "application", statuses: statuses.Applications},
+		{kind: "container", statuses: statuses.Containers},
+		{kind: "job", statuses: statuses.Jobs},
+		{kind: "database", statuses: statuses.Databases},
+		{kind: "helm", statuses: statuses.Helms},
+		{kind: "terraform", statuses: statuses.Terraforms},
+	}
+	for _, service := range services {
+		for _, serviceStatus := range service.statuses {
+			progress.Observe(ctx, service.kind+" "+serviceStatus.Id, string(serviceStatus.State))
+		}
+	}
+	progress.Heartbeat(ctx, isPendingState)
+}
+
+// isPendingState returns whether the given state is a processing, waiting or queued state.
+func isPendingState(state string) bool {
+	return strings.HasSuffix(state, "ING") ||
+		strings.HasSuffix(state, "_WAITING") ||
+		strings.HasSuffix(state, "_QUEUED") ||
+		state == "QUEUED"
+}
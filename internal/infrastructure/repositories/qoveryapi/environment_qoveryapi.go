@@ -7,21 +7,24 @@ import (
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 // environmentQoveryAPI implements the interface environment.Repository.
 type environmentQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
 // newEnvironmentQoveryAPI return a new instance of an environment.Repository that uses Qovery's API.
-func newEnvironmentQoveryAPI(client *qovery.APIClient) (environment.Repository, error) {
+func newEnvironmentQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (environment.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &environmentQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
@@ -44,12 +47,19 @@ func (c environmentQoveryAPI) Create(ctx context.Context, projectID string, requ
 }
 
 // Get calls Qovery's API to retrieve an environment using the given environmentID.
+// The response is shared through the read cache with the resources reading the same environment.
 func (c environmentQoveryAPI) Get(ctx context.Context, environmentID string) (*environment.Environment, error) {
-	env, resp, err := c.client.EnvironmentMainCallsAPI.
-		GetEnvironment(ctx, environmentID).
-		Execute()
-	if err != nil || resp.StatusCode >= 400 {
-		return nil, apierrors.NewReadAPIError(apierrors.APIResourceEnvironment, environmentID, resp, err)
+	env, err := readcache.Fetch(ctx, c.readCache, readcache.Key(readCacheEnvironment, environmentID), func(ctx context.Context) (*qovery.Environment, error) {
+		env, resp, err := c.client.EnvironmentMainCallsAPI.
+			GetEnvironment(ctx, environmentID).
+			Execute()
+		if err != nil || resp.StatusCode >= 400 {
+			return nil, apierrors.NewReadAPIError(apierrors.APIResourceEnvironment, environmentID, resp, err)
+		}
+		return env, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return newDomainEnvironmentFromQovery(env)
@@ -69,6 +79,7 @@ func (c environmentQoveryAPI) Update(ctx context.Context, environmentID string,
 	if err != nil || resp.StatusCode >= 400 {
 		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceEnvironment, environmentID, resp, err)
 	}
+	c.readCache.Invalidate(readcache.Key(readCacheEnvironment, environmentID))
 
 	return newDomainEnvironmentFromQovery(env)
 }
@@ -81,6 +92,7 @@ func (c environmentQoveryAPI) Delete(ctx context.Context, environmentID string)
 	if err != nil || resp.StatusCode >= 300 {
 		return apierrors.NewDeleteAPIError(apierrors.APIResourceEnvironment, environmentID, resp, err)
 	}
+	c.readCache.Invalidate(readcache.Key(readCacheEnvironment, environmentID))
 
 	return nil
 }
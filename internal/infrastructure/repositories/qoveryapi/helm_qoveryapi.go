@@ -11,6 +11,7 @@ import (
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/helm"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 // Ensure helmQoveryAPI defined types fully satisfy the helm.Repository interface.
@@ -18,22 +19,24 @@ var _ helm.Repository = helmQoveryAPI{}
 
 // helmQoveryAPI implements the interface helm.Repository.
 type helmQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
 // newHelmQoveryAPI return a new instance of a helm.Repository that uses Qovery's API.
-func newHelmQoveryAPI(client *qovery.APIClient) (helm.Repository, error) {
+func newHelmQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (helm.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &helmQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
 // Create calls Qovery's API to create a helm for an organization using the given organizationID and request.
-func (c helmQoveryAPI) Create(ctx context.Context, environmentID string, request helm.UpsertRepositoryRequest) (*helm.Helm, error) {
+func (c helmQoveryAPI) Create(ctx context.Context, environmentID string, request helm.UpsertRepositoryRequest) (_ *helm.Helm, err error) {
 	req, err := newQoveryHelmRequestFromDomain(request)
 	if err != nil {
 		return nil, errors.Wrap(err, helm.ErrInvalidHelmUpsertRequest.Error())
@@ -47,6 +50,13 @@ func (c helmQoveryAPI) Create(ctx context.Context, environmentID string, request
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceHelm, request.Name, resp, err)
 	}
 
+	// From now on the helm exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*helm.Helm, error) {
+			return c.Get(ctx, newHelm.Id, request.AdvancedSettingsJson, false)
+		})
+	}()
+
 	// Create custom domains
 	if !request.CustomDomains.IsEmpty() {
 		for _, customDomain := range request.CustomDomains.Create {
@@ -79,6 +89,9 @@ func (c helmQoveryAPI) Create(ctx context.Context, environmentID string, request
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceHelm, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newHelm.Id)
+
 	// Get helm deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, newHelm.Id).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -104,12 +117,12 @@ func (c helmQoveryAPI) Get(ctx context.Context, helmID string, advancedSettingsJ
 	}
 
 	// Get helm deployment stage
-	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, helmID).Execute()
-	if err != nil || (resp != nil && resp.StatusCode >= 400) {
-		return nil, apierrors.NewReadAPIError(apierrors.APIResourceHelm, helmID, resp, err)
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceHelm, helmID)
+	if err != nil {
+		return nil, err
 	}
 
-	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).ReadServiceAdvancedSettings(domain.HELM, helmID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsServiceWithCache(c.client.GetConfig(), newAdvancedSettingsDefaultsCache(c.client.GetConfig(), c.readCache)).ReadServiceAdvancedSettings(domain.HELM, helmID, advancedSettingsJsonFromState, isTriggeredFromImport)
 	if err != nil {
 		return nil, apierrors.NewReadAPIError(apierrors.APIResourceHelm, helmID, nil, err)
 	}
@@ -191,6 +204,9 @@ func (c helmQoveryAPI) Update(ctx context.Context, helmID string, request helm.U
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceHelm, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, helmID)
+
 	// Get helm deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, helmID).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -226,5 +242,6 @@ func (c helmQoveryAPI) Delete(ctx context.Context, helmID string) error {
 		return apierrors.NewDeleteAPIError(apierrors.APIResourceHelm, helmID, resp, err)
 	}
 
+	invalidateServiceDeploymentStage(c.readCache, helmID)
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 // Ensure jobQoveryAPI defined types fully satisfy the job.Repository interface.
@@ -17,22 +18,24 @@ var _ job.Repository = jobQoveryAPI{}
 
 // jobQoveryAPI implements the interface job.Repository.
 type jobQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
 // newJobQoveryAPI return a new instance of a job.Repository that uses Qovery's API.
-func newJobQoveryAPI(client *qovery.APIClient) (job.Repository, error) {
+func newJobQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (job.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &jobQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
 // Create calls Qovery's API to create a job for an organization using the given organizationID and request.
-func (c jobQoveryAPI) Create(ctx context.Context, environmentID string, request job.UpsertRepositoryRequest) (*job.Job, error) {
+func (c jobQoveryAPI) Create(ctx context.Context, environmentID string, request job.UpsertRepositoryRequest) (_ *job.Job, err error) {
 	req, err := newQoveryJobRequestFromDomain(request)
 	if err != nil {
 		return nil, errors.Wrap(err, job.ErrInvalidJobUpsertRequest.Error())
@@ -53,6 +56,13 @@ func (c jobQoveryAPI) Create(ctx context.Context, environmentID string, request
 		newJobId = newJob.LifecycleJobResponse.Id
 	}
 
+	// From now on the job exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*job.Job, error) {
+			return c.Get(ctx, newJobId, request.AdvancedSettingsJson, false)
+		})
+	}()
+
 	// Attach job to deployment stage
 	if len(request.DeploymentStageID) > 0 {
 		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, newJobId, request.IsSkipped)
@@ -67,6 +77,9 @@ func (c jobQoveryAPI) Create(ctx context.Context, environmentID string, request
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceJob, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newJobId)
+
 	// Get job deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, newJobId).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -86,12 +99,12 @@ func (c jobQoveryAPI) Get(ctx context.Context, jobID string, advancedSettingsJso
 	}
 
 	// Get job deployment stage
-	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, jobID).Execute()
-	if err != nil || (resp != nil && resp.StatusCode >= 400) {
-		return nil, apierrors.NewReadAPIError(apierrors.APIResourceJob, jobID, resp, err)
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceJob, jobID)
+	if err != nil {
+		return nil, err
 	}
 
-	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).ReadServiceAdvancedSettings(domain.JOB, jobID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsServiceWithCache(c.client.GetConfig(), newAdvancedSettingsDefaultsCache(c.client.GetConfig(), c.readCache)).ReadServiceAdvancedSettings(domain.JOB, jobID, advancedSettingsJsonFromState, isTriggeredFromImport)
 	if err != nil {
 		return nil, apierrors.NewReadAPIError(apierrors.APIResourceJob, jobID, nil, err)
 	}
@@ -128,6 +141,9 @@ func (c jobQoveryAPI) Update(ctx context.Context, jobID string, request job.Upse
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceJob, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, jobID)
+
 	// Get job deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, jobID).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -157,5 +173,6 @@ func (c jobQoveryAPI) Delete(ctx context.Context, jobID string) error {
 		return apierrors.NewDeleteAPIError(apierrors.APIResourceJob, jobID, resp, err)
 	}
 
+	invalidateServiceDeploymentStage(c.readCache, jobID)
 	return nil
 }
@@ -0,0 +1,24 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+// partiallyCreated returns err as a *common.PartialCreateError holding the resource read back from the API,
+// for a failure happening once the resource exists (custom domains, deployment stage attachment, advanced settings...).
+// err is returned as is if it is nil or if the resource cannot be read back either.
+func partiallyCreated[T any](ctx context.Context, err error, get func(ctx context.Context) (*T, error)) error {
+	if err == nil {
+		return nil
+	}
+
+	// The resource must be read back even if the creation failed because the context was canceled.
+	created, getErr := get(context.WithoutCancel(ctx))
+	if getErr != nil {
+		return err
+	}
+
+	return common.NewPartialCreateError(created, err)
+}
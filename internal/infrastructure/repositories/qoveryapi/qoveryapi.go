@@ -17,9 +17,12 @@ import (
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apitoken"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/customrole"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
@@ -31,6 +34,8 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/terraformservice"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 )
 
 var (
@@ -57,9 +62,16 @@ type QoveryAPI struct {
 	CredentialsAzure                credentials.AzureRepository
 	CredentialsEksAnywhereVsphere   credentials.EksAnywhereVsphereRepository
 	Organization                    organization.Repository
+	Cluster                         cluster.Repository
 	Project                         project.Repository
 	ProjectEnvironmentVariable      variable.Repository
 	ProjectSecret                   secret.Repository
+	Application                     application.Repository
+	ApplicationDeployment           deployment.Repository
+	ApplicationEnvironmentVariable  variable.Repository
+	ApplicationSecret               secret.Repository
+	Database                        database.Repository
+	DatabaseDeployment              deployment.Repository
 	Container                       container.Repository
 	ContainerDeployment             deployment.Repository
 	ContainerEnvironmentVariable    variable.Repository
@@ -109,8 +121,13 @@ type QoveryAPI struct {
 func New(configs ...Configuration) (*QoveryAPI, error) {
 	// Initialize the qovery api client.
 	cfg := qovery.NewConfiguration()
+	cfg.HTTPClient = telemetry.NewHTTPClient(telemetry.SubsystemQoveryAPI)
 	apiClient := qovery.NewAPIClient(cfg)
 
+	// Initialize the read cache shared by the repositories, so that the resources read in parallel
+	// during a plan or an apply share the responses of their common parents.
+	readCache := readcache.New(readcache.DefaultTTL)
+
 	// Initialize repositories implementations.
 	credentialsAwsAPI, err := newCredentialsAwsQoveryAPI(apiClient)
 	if err != nil {
@@ -142,6 +159,11 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
+	clusterAPI, err := newClusterQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
 	projectAPI, err := newProjectQoveryAPI(apiClient)
 	if err != nil {
 		return nil, err
@@ -157,7 +179,37 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	containerAPI, err := newContainerQoveryAPI(apiClient)
+	applicationAPI, err := newApplicationQoveryAPI(apiClient, readCache)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationDeploymentAPI, err := newApplicationDeploymentQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationEnvironmentVariableAPI, err := newApplicationEnvironmentVariablesQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationSecretAPI, err := newApplicationSecretsQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseAPI, err := newDatabaseQoveryAPI(apiClient, readCache)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseDeploymentAPI, err := newDatabaseDeploymentQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	containerAPI, err := newContainerQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +234,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	jobAPI, err := newJobQoveryAPI(apiClient)
+	jobAPI, err := newJobQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +254,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	environmentAPI, err := newEnvironmentQoveryAPI(apiClient)
+	environmentAPI, err := newEnvironmentQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +274,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	deploymentStageAPI, err := newDeploymentStageQoveryAPI(apiClient)
+	deploymentStageAPI, err := newDeploymentStageQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +289,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	helmAPI, err := newHelmQoveryAPI(apiClient)
+	helmAPI, err := newHelmQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +324,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
-	terraformServiceAPI, err := newTerraformServiceQoveryAPI(apiClient)
+	terraformServiceAPI, err := newTerraformServiceQoveryAPI(apiClient, readCache)
 	if err != nil {
 		return nil, err
 	}
@@ -371,9 +423,16 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		CredentialsAzure:                credentialsAzureAPI,
 		CredentialsEksAnywhereVsphere:   credentialsEksAnywhereVsphereAPI,
 		Organization:                    organizationAPI,
+		Cluster:                         clusterAPI,
 		Project:                         projectAPI,
 		ProjectEnvironmentVariable:      projectEnvironmentVariableAPI,
 		ProjectSecret:                   projectSecretAPI,
+		Application:                     applicationAPI,
+		ApplicationDeployment:           applicationDeploymentAPI,
+		ApplicationEnvironmentVariable:  applicationEnvironmentVariableAPI,
+		ApplicationSecret:               applicationSecretAPI,
+		Database:                        databaseAPI,
+		DatabaseDeployment:              databaseDeploymentAPI,
 		Container:                       containerAPI,
 		ContainerDeployment:             containerDeploymentAPI,
 		ContainerEnvironmentVariable:    containerEnvironmentVariableAPI,
@@ -0,0 +1,38 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
+)
+
+// Prefixes of the keys of the responses shared through the read cache.
+const (
+	readCacheEnvironment            = "environment"
+	readCacheDeploymentStage        = "deployment_stage"
+	readCacheServiceDeploymentStage = "service_deployment_stage"
+)
+
+// getServiceDeploymentStage returns the deployment stage of the given service.
+// The response is shared through the read cache and must not be modified.
+func getServiceDeploymentStage(ctx context.Context, client *qovery.APIClient, cache *readcache.Cache, resource apierrors.APIResource, serviceID string) (*qovery.DeploymentStageResponse, error) {
+	return readcache.Fetch(ctx, cache, readcache.Key(readCacheServiceDeploymentStage, serviceID), func(ctx context.Context) (*qovery.DeploymentStageResponse, error) {
+		deploymentStage, resp, err := client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, serviceID).Execute()
+		if err != nil || (resp != nil && resp.StatusCode >= 400) {
+			return nil, apierrors.NewReadAPIError(resource, serviceID, resp, err)
+		}
+		return deploymentStage, nil
+	})
+}
+
+// invalidateServiceDeploymentStage drops the cached deployment stages after a write on the given service,
+// as it may have been attached to another deployment stage.
+func invalidateServiceDeploymentStage(cache *readcache.Cache, serviceID string) {
+	cache.Invalidate(
+		readcache.Key(readCacheServiceDeploymentStage, serviceID),
+		readCacheDeploymentStage+"/",
+	)
+}
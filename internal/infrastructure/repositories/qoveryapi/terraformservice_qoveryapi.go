@@ -10,6 +10,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/terraformservice"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/readcache"
 )
 
 // Ensure terraformServiceQoveryAPI defined types fully satisfy the terraformservice.Repository interface.
@@ -17,22 +18,24 @@ var _ terraformservice.Repository = terraformServiceQoveryAPI{}
 
 // terraformServiceQoveryAPI implements the interface terraformservice.Repository.
 type terraformServiceQoveryAPI struct {
-	client *qovery.APIClient
+	client    *qovery.APIClient
+	readCache *readcache.Cache
 }
 
 // newTerraformServiceQoveryAPI return a new instance of a terraformservice.Repository that uses Qovery's API.
-func newTerraformServiceQoveryAPI(client *qovery.APIClient) (terraformservice.Repository, error) {
+func newTerraformServiceQoveryAPI(client *qovery.APIClient, readCache *readcache.Cache) (terraformservice.Repository, error) {
 	if client == nil {
 		return nil, ErrInvalidQoveryAPIClient
 	}
 
 	return &terraformServiceQoveryAPI{
-		client: client,
+		client:    client,
+		readCache: readCache,
 	}, nil
 }
 
 // Create calls Qovery's API to create a terraform service for an environment using the given environmentID and request.
-func (c terraformServiceQoveryAPI) Create(ctx context.Context, environmentID string, request terraformservice.UpsertRepositoryRequest) (*terraformservice.TerraformService, error) {
+func (c terraformServiceQoveryAPI) Create(ctx context.Context, environmentID string, request terraformservice.UpsertRepositoryRequest) (_ *terraformservice.TerraformService, err error) {
 	req, err := newQoveryTerraformRequestFromDomain(request)
 	if err != nil {
 		return nil, errors.Wrap(err, terraformservice.ErrInvalidTerraformServiceUpsertRequest.Error())
@@ -46,6 +49,13 @@ func (c terraformServiceQoveryAPI) Create(ctx context.Context, environmentID str
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceTerraformService, request.Name, resp, err)
 	}
 
+	// From now on the terraform service exists: a failing step returns it as partially created so that it is not lost.
+	defer func() {
+		err = partiallyCreated(ctx, err, func(ctx context.Context) (*terraformservice.TerraformService, error) {
+			return c.Get(ctx, newTerraform.Id, request.AdvancedSettingsJson, false)
+		})
+	}()
+
 	// Attach terraform service to deployment stage
 	if len(request.DeploymentStageID) > 0 {
 		response, err := attachServiceToDeploymentStage(ctx, c.client, request.DeploymentStageID, newTerraform.Id, request.IsSkipped)
@@ -60,6 +70,9 @@ func (c terraformServiceQoveryAPI) Create(ctx context.Context, environmentID str
 		return nil, apierrors.NewCreateAPIError(apierrors.APIResourceTerraformService, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, newTerraform.Id)
+
 	// Get terraform service deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, newTerraform.Id).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -79,12 +92,12 @@ func (c terraformServiceQoveryAPI) Get(ctx context.Context, terraformServiceID s
 	}
 
 	// Get terraform service deployment stage
-	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, terraform.Id).Execute()
-	if err != nil || (resp != nil && resp.StatusCode >= 400) {
-		return nil, apierrors.NewReadAPIError(apierrors.APIResourceTerraformService, terraform.Id, resp, err)
+	deploymentStage, err := getServiceDeploymentStage(ctx, c.client, c.readCache, apierrors.APIResourceTerraformService, terraform.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsService(c.client.GetConfig()).ReadServiceAdvancedSettings(domain.TERRAFORM, terraformServiceID, advancedSettingsJsonFromState, isTriggeredFromImport)
+	advancedSettingsAsJson, err := advanced_settings.NewServiceAdvancedSettingsServiceWithCache(c.client.GetConfig(), newAdvancedSettingsDefaultsCache(c.client.GetConfig(), c.readCache)).ReadServiceAdvancedSettings(domain.TERRAFORM, terraformServiceID, advancedSettingsJsonFromState, isTriggeredFromImport)
 	if err != nil {
 		return nil, apierrors.NewReadAPIError(apierrors.APIResourceTerraformService, terraformServiceID, nil, err)
 	}
@@ -121,6 +134,9 @@ func (c terraformServiceQoveryAPI) Update(ctx context.Context, terraformServiceI
 		return nil, apierrors.NewUpdateAPIError(apierrors.APIResourceTerraformService, request.Name, nil, err)
 	}
 
+	// The deployment stage cached for the service may be outdated after the attachment.
+	invalidateServiceDeploymentStage(c.readCache, terraform.Id)
+
 	// Get terraform service deployment stage
 	deploymentStage, resp, err := c.client.DeploymentStageMainCallsAPI.GetServiceDeploymentStage(ctx, terraform.Id).Execute()
 	if err != nil || (resp != nil && resp.StatusCode >= 400) {
@@ -150,6 +166,7 @@ func (c terraformServiceQoveryAPI) Delete(ctx context.Context, terraformServiceI
 		return apierrors.NewDeleteAPIError(apierrors.APIResourceTerraformService, terraformServiceID, resp, err)
 	}
 
+	invalidateServiceDeploymentStage(c.readCache, terraformServiceID)
 	return nil
 }
 
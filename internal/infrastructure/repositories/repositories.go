@@ -5,6 +5,7 @@ import (
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/annotations_group"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apitoken"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdCredentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdDestinationClusterMapping"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/labels_group"
@@ -18,9 +19,11 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/customrole"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
@@ -49,9 +52,16 @@ type Repositories struct {
 	CredentialsAzure                credentials.AzureRepository
 	CredentialsEksAnywhereVsphere   credentials.EksAnywhereVsphereRepository
 	Organization                    organization.Repository
+	Cluster                         cluster.Repository
 	Project                         project.Repository
 	ProjectEnvironmentVariable      variable.Repository
 	ProjectSecret                   secret.Repository
+	Application                     application.Repository
+	ApplicationDeployment           deployment.Repository
+	ApplicationEnvironmentVariable  variable.Repository
+	ApplicationSecret               secret.Repository
+	Database                        database.Repository
+	DatabaseDeployment              deployment.Repository
 	Container                       container.Repository
 	ContainerDeployment             deployment.Repository
 	ContainerEnvironmentVariable    variable.Repository
@@ -132,9 +142,16 @@ func WithQoveryAPI(apiToken string, providerVersion string, host string) Configu
 		repos.CredentialsAzure = qoveryAPI.CredentialsAzure
 		repos.CredentialsEksAnywhereVsphere = qoveryAPI.CredentialsEksAnywhereVsphere
 		repos.Organization = qoveryAPI.Organization
+		repos.Cluster = qoveryAPI.Cluster
 		repos.Project = qoveryAPI.Project
 		repos.ProjectEnvironmentVariable = qoveryAPI.ProjectEnvironmentVariable
 		repos.ProjectSecret = qoveryAPI.ProjectSecret
+		repos.Application = qoveryAPI.Application
+		repos.ApplicationDeployment = qoveryAPI.ApplicationDeployment
+		repos.ApplicationEnvironmentVariable = qoveryAPI.ApplicationEnvironmentVariable
+		repos.ApplicationSecret = qoveryAPI.ApplicationSecret
+		repos.Database = qoveryAPI.Database
+		repos.DatabaseDeployment = qoveryAPI.DatabaseDeployment
 		repos.Container = qoveryAPI.Container
 		repos.Job = qoveryAPI.Job
 		repos.JobDeployment = qoveryAPI.JobDeployment
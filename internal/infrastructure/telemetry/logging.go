@@ -0,0 +1,147 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// SubsystemClient is the tflog subsystem of the API calls made by the legacy client.Client.
+	// Its level can be set with the TF_LOG_PROVIDER_QOVERY_CLIENT environment variable.
+	SubsystemClient = "client"
+	// SubsystemQoveryAPI is the tflog subsystem of the API calls made by the qoveryapi repositories.
+	// Its level can be set with the TF_LOG_PROVIDER_QOVERY_QOVERYAPI environment variable.
+	SubsystemQoveryAPI = "qoveryapi"
+
+	// logLevelEnvPrefix is the prefix of the environment variables setting the level of the provider subsystems.
+	logLevelEnvPrefix = "TF_LOG_PROVIDER_QOVERY"
+	// correlationIDHeader is the request header carrying the correlation ID of an API call.
+	correlationIDHeader = "X-Request-Id"
+)
+
+// LoggingTransport is an http.RoundTripper logging each request sent to the Qovery API in a tflog subsystem.
+// Requests and responses are logged at DEBUG, their redacted bodies at TRACE.
+type LoggingTransport struct {
+	base      http.RoundTripper
+	subsystem string
+}
+
+// NewLoggingTransport returns a LoggingTransport wrapping the given http.RoundTripper and logging in the given subsystem.
+// http.DefaultTransport is used when base is nil.
+func NewLoggingTransport(base http.RoundTripper, subsystem string) *LoggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &LoggingTransport{
+		base:      base,
+		subsystem: subsystem,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	correlationID := uuid.NewString()
+	ctx := t.newLoggingContext(req, correlationID)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("qovery.correlation_id", correlationID))
+
+	req = req.Clone(req.Context())
+	req.Header.Set(correlationIDHeader, correlationID)
+
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Sending API request")
+	if len(requestBody) > 0 {
+		tflog.SubsystemTrace(ctx, t.subsystem, "API request body", map[string]any{
+			"body": loggedBody{path: req.URL.Path, content: requestBody},
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	duration := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, t.subsystem, "API request failed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"error":       err.Error(),
+		})
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, t.subsystem, "Failed to read API response", map[string]any{
+			"status":      resp.StatusCode,
+			"duration_ms": duration.Milliseconds(),
+			"error":       err.Error(),
+		})
+		return nil, err
+	}
+	tflog.SubsystemDebug(ctx, t.subsystem, "Received API response", map[string]any{
+		"status":      resp.StatusCode,
+		"duration_ms": duration.Milliseconds(),
+	})
+	if len(responseBody) > 0 {
+		tflog.SubsystemTrace(ctx, t.subsystem, "API response body", map[string]any{
+			"status": resp.StatusCode,
+			"body":   loggedBody{path: req.URL.Path, content: responseBody},
+		})
+	}
+
+	return resp, nil
+}
+
+// newLoggingContext returns a context with the transport subsystem initialized and the request fields set on every log line.
+func (t *LoggingTransport) newLoggingContext(req *http.Request, correlationID string) context.Context {
+	ctx := tflog.NewSubsystem(req.Context(), t.subsystem, tflog.WithLevelFromEnv(logLevelEnvPrefix, t.subsystem))
+	ctx = tflog.SubsystemSetField(ctx, t.subsystem, "method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, t.subsystem, "path", req.URL.Path)
+	return tflog.SubsystemSetField(ctx, t.subsystem, "correlation_id", correlationID)
+}
+
+// loggedBody is a logged request or response body. It is only redacted when the log line is written, so that
+// bodies are not parsed when the subsystem is not at TRACE.
+type loggedBody struct {
+	path    string
+	content []byte
+}
+
+// String implements the fmt.Stringer interface used by the text log format.
+func (b loggedBody) String() string {
+	return RedactBody(b.path, b.content)
+}
+
+// MarshalJSON implements the json.Marshaler interface used by the JSON log format.
+func (b loggedBody) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// readBody reads the given body and replaces it with an in-memory copy so that it can still be consumed.
+// The original body is closed, even when it cannot be read.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	original := *body
+	defer func() { _ = original.Close() }()
+
+	content, err := io.ReadAll(original)
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+
+	return content, nil
+}
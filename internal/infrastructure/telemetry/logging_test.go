@@ -0,0 +1,132 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package telemetry
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLoggingTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	var sentBody string
+	var sentCorrelationID string
+	transport := NewLoggingTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		sentBody = string(body)
+		sentCorrelationID = req.Header.Get(correlationIDHeader)
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1","token":"response-token"}`)),
+		}, nil
+	}), SubsystemQoveryAPI)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.qovery.com/organization/1/apiToken", strings.NewReader(`{"name":"ci","password":"request-password"}`))
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// Bodies are still sent and returned untouched.
+	assert.Equal(t, `{"name":"ci","password":"request-password"}`, sentBody)
+	assert.Equal(t, `{"id":"1","token":"response-token"}`, string(responseBody))
+	assert.NotEmpty(t, sentCorrelationID)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	for _, entry := range entries {
+		assert.Equal(t, "provider."+SubsystemQoveryAPI, entry["@module"])
+		assert.Equal(t, http.MethodPost, entry["method"])
+		assert.Equal(t, "/organization/1/apiToken", entry["path"])
+		assert.Equal(t, sentCorrelationID, entry["correlation_id"])
+	}
+	assert.Equal(t, float64(http.StatusCreated), entries[2]["status"])
+	assert.NotContains(t, output.String(), "request-password")
+	assert.NotContains(t, output.String(), "response-token")
+	assert.Contains(t, entries[1]["body"], redactedValue)
+	assert.Contains(t, entries[3]["body"], redactedValue)
+}
+
+func TestLoggingTransport_RoundTrip_BodiesNotLoggedBelowTrace(t *testing.T) {
+	t.Setenv(logLevelEnvPrefix+"_QOVERYAPI", "DEBUG")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	transport := NewLoggingTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1"}`)),
+		}, nil
+	}), SubsystemQoveryAPI)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.qovery.com/organization", strings.NewReader(`{"name":"org"}`))
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"1"}`, string(responseBody))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.NotContains(t, entry, "body")
+	}
+}
+
+type failingBody struct {
+	closed bool
+}
+
+func (b *failingBody) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (b *failingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestLoggingTransport_RoundTrip_ClosesUnreadableResponseBody(t *testing.T) {
+	t.Parallel()
+
+	body := &failingBody{}
+	transport := NewLoggingTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: body}, nil
+	}), SubsystemQoveryAPI)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://api.qovery.com/organization", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "connection reset")
+	assert.True(t, body.closed)
+}
@@ -0,0 +1,102 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultHeartbeatInterval is the minimum delay between two heartbeats of a ProgressTracker.
+const defaultHeartbeatInterval = 1 * time.Minute
+
+// ProgressTracker reports the progress of a wait loop: it logs at INFO each state transition of the awaited resources
+// and periodically a heartbeat listing the resources still pending.
+// It is meant to be created once per wait and fed with the states read at each poll.
+type ProgressTracker struct {
+	operation         string
+	heartbeatInterval time.Duration
+	now               func() time.Time
+
+	mu            sync.Mutex
+	startedAt     time.Time
+	lastHeartbeat time.Time
+	states        map[string]string
+}
+
+// NewProgressTracker returns a ProgressTracker for the given operation, e.g. "cluster deployment".
+func NewProgressTracker(operation string) *ProgressTracker {
+	return newProgressTracker(operation, defaultHeartbeatInterval, time.Now)
+}
+
+func newProgressTracker(operation string, heartbeatInterval time.Duration, now func() time.Time) *ProgressTracker {
+	startedAt := now()
+	return &ProgressTracker{
+		operation:         operation,
+		heartbeatInterval: heartbeatInterval,
+		now:               now,
+		startedAt:         startedAt,
+		lastHeartbeat:     startedAt,
+		states:            make(map[string]string),
+	}
+}
+
+// Observe records the current state of the given resource and logs the transition when it changed since the last poll.
+func (p *ProgressTracker) Observe(ctx context.Context, resource string, state string) {
+	p.mu.Lock()
+	previous, known := p.states[resource]
+	p.states[resource] = state
+	p.mu.Unlock()
+
+	if known && previous == state {
+		return
+	}
+
+	fields := map[string]any{
+		"operation": p.operation,
+		"resource":  resource,
+		"state":     state,
+	}
+	if !known {
+		tflog.Info(ctx, fmt.Sprintf("%s: %s is %s", p.operation, resource, state), fields)
+		return
+	}
+
+	fields["previous_state"] = previous
+	tflog.Info(ctx, fmt.Sprintf("%s: %s %s -> %s", p.operation, resource, previous, state), fields)
+}
+
+// Heartbeat logs the resources whose state is still pending according to isPending, at most once per heartbeat interval.
+func (p *ProgressTracker) Heartbeat(ctx context.Context, isPending func(state string) bool) {
+	p.mu.Lock()
+	now := p.now()
+	if now.Sub(p.lastHeartbeat) < p.heartbeatInterval {
+		p.mu.Unlock()
+		return
+	}
+	p.lastHeartbeat = now
+
+	pending := make([]string, 0, len(p.states))
+	for resource, state := range p.states {
+		if isPending(state) {
+			pending = append(pending, fmt.Sprintf("%s (%s)", resource, state))
+		}
+	}
+	elapsed := now.Sub(p.startedAt).Round(time.Second)
+	p.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	sort.Strings(pending)
+
+	tflog.Info(ctx, fmt.Sprintf("%s: still waiting after %s for %s", p.operation, elapsed, strings.Join(pending, ", ")), map[string]any{
+		"operation":       p.operation,
+		"elapsed_seconds": int64(elapsed.Seconds()),
+		"pending":         pending,
+	})
+}
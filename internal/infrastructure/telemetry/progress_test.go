@@ -0,0 +1,59 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package telemetry
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTracker(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	progress := newProgressTracker("Waiting for environment to be DEPLOYED", time.Minute, func() time.Time { return now })
+	isPending := func(state string) bool { return strings.HasSuffix(state, "ING") }
+
+	// First poll: initial states are logged, no heartbeat before the interval elapsed.
+	progress.Observe(ctx, "application a", "QUEUED")
+	progress.Observe(ctx, "container b", "BUILDING")
+	progress.Heartbeat(ctx, isPending)
+
+	// Second poll: only the transition is logged.
+	now = now.Add(30 * time.Second)
+	progress.Observe(ctx, "application a", "BUILDING")
+	progress.Observe(ctx, "container b", "BUILDING")
+	progress.Heartbeat(ctx, isPending)
+
+	// Third poll: the heartbeat lists the pending resources.
+	now = now.Add(45 * time.Second)
+	progress.Observe(ctx, "application a", "DEPLOYED")
+	progress.Observe(ctx, "container b", "BUILDING")
+	progress.Heartbeat(ctx, isPending)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	messages := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, "info", entry["@level"])
+		messages = append(messages, entry["@message"].(string))
+	}
+	assert.Equal(t, []string{
+		"Waiting for environment to be DEPLOYED: application a is QUEUED",
+		"Waiting for environment to be DEPLOYED: container b is BUILDING",
+		"Waiting for environment to be DEPLOYED: application a QUEUED -> BUILDING",
+		"Waiting for environment to be DEPLOYED: application a BUILDING -> DEPLOYED",
+		"Waiting for environment to be DEPLOYED: still waiting after 1m15s for container b (BUILDING)",
+	}, messages)
+}
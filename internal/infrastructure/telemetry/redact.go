@@ -0,0 +1,159 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// redactedValue replaces the value of sensitive fields in logged bodies.
+	redactedValue = "**REDACTED**"
+	// maxLoggedBodySize is the maximum number of bytes of a body written to the logs.
+	maxLoggedBodySize = 64 * 1024
+)
+
+// sensitiveKeyFragments lists the fragments of JSON keys whose values must never be logged.
+// Keys are normalized before matching, see normalizeKey.
+var sensitiveKeyFragments = []string{
+	"token",
+	"password",
+	"secret",
+	"credential",
+	"kubeconfig",
+	"privatekey",
+	"apikey",
+	"accesskey",
+	"authorization",
+	"certificate",
+}
+
+// secretPathFragments lists URL path fragments of endpoints managing secrets.
+// The `value` field of their payloads is redacted on top of the sensitive keys.
+// The `/variable` endpoints manage both variables and secrets, and their edit payloads do not tell them apart.
+var secretPathFragments = []string{
+	"/secret",
+	"/variable",
+}
+
+// kubeconfigPathFragment is the URL path fragment of the endpoints whose whole body is a kubeconfig.
+const kubeconfigPathFragment = "/kubeconfig"
+
+// RedactBody returns a printable version of a JSON request or response body where the values of sensitive fields are redacted.
+// Bodies that are not JSON are never logged as they may contain kubeconfigs or credentials files.
+func RedactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > maxLoggedBodySize {
+		return fmt.Sprintf("<%d bytes body omitted>", len(body))
+	}
+
+	// Kubeconfigs are sent as JSON strings, which the sensitive keys cannot catch.
+	if strings.Contains(strings.ToLower(path), kubeconfigPathFragment) {
+		return fmt.Sprintf("<%d bytes kubeconfig body omitted>", len(body))
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Sprintf("<%d bytes non-JSON body omitted>", len(body))
+	}
+	if isKubeconfig(payload) {
+		return fmt.Sprintf("<%d bytes kubeconfig body omitted>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactValue(payload, isSecretPath(path)))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes body omitted>", len(body))
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any, redactValueField bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		// The value of a variable flagged as secret is redacted whatever the endpoint.
+		redactSiblingValue := redactValueField || hasSecretFlag(v)
+		for key, fieldValue := range v {
+			if isRedactable(fieldValue) && isSensitiveKey(key, redactSiblingValue) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(fieldValue, redactValueField)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item, redactValueField)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// isRedactable reports whether the value of a sensitive key must be redacted.
+// Null values and booleans such as `is_secret` carry no secret and are kept.
+func isRedactable(value any) bool {
+	switch value.(type) {
+	case nil, bool:
+		return false
+	default:
+		return true
+	}
+}
+
+// hasSecretFlag reports whether the object is flagged as secret, e.g. a variable created with `is_secret: true`.
+func hasSecretFlag(object map[string]any) bool {
+	for key, value := range object {
+		if isSecret, ok := value.(bool); ok && isSecret && normalizeKey(key) == "issecret" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isKubeconfig reports whether the payload is a kubeconfig written as JSON.
+func isKubeconfig(payload any) bool {
+	object, ok := payload.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	kind, _ := object["kind"].(string)
+	_, hasUsers := object["users"]
+	return kind == "Config" && hasUsers
+}
+
+func isSensitiveKey(key string, redactValueField bool) bool {
+	normalized := normalizeKey(key)
+	if redactValueField && normalized == "value" {
+		return true
+	}
+
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSecretPath(path string) bool {
+	lowerPath := strings.ToLower(path)
+	for _, fragment := range secretPathFragments {
+		if strings.Contains(lowerPath, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeKey lowercases the key and strips separators so that `access_key_id`, `accessKeyId` and `access-key-id` match the same fragment.
+func normalizeKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
@@ -0,0 +1,114 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Path     string
+		Body     string
+		Expected string
+	}{
+		{
+			TestName: "empty_body",
+			Path:     "/organization",
+			Body:     "",
+			Expected: "",
+		},
+		{
+			TestName: "no_sensitive_fields",
+			Path:     "/organization",
+			Body:     `{"name":"my-org","plan":"TEAM"}`,
+			Expected: `{"name":"my-org","plan":"TEAM"}`,
+		},
+		{
+			TestName: "sensitive_fields",
+			Path:     "/organization/id/awsCredentials",
+			Body:     `{"name":"creds","access_key_id":"AKIA","secret_access_key":"shh","token":"tok","password":"pwd"}`,
+			Expected: `{"access_key_id":"**REDACTED**","name":"creds","password":"**REDACTED**","secret_access_key":"**REDACTED**","token":"**REDACTED**"}`,
+		},
+		{
+			TestName: "nested_sensitive_fields",
+			Path:     "/cluster",
+			Body:     `{"results":[{"name":"a","kubeconfig":"apiVersion: v1"},{"name":"b","is_secret":true}]}`,
+			Expected: `{"results":[{"kubeconfig":"**REDACTED**","name":"a"},{"is_secret":true,"name":"b"}]}`,
+		},
+		{
+			TestName: "secret_value",
+			Path:     "/application/id/secret",
+			Body:     `{"key":"DB_PASSWORD","value":"hunter2"}`,
+			Expected: `{"key":"DB_PASSWORD","value":"**REDACTED**"}`,
+		},
+		{
+			TestName: "variable_value_is_kept",
+			Path:     "/application/id/environmentVariable",
+			Body:     `{"key":"LOG_LEVEL","value":"debug"}`,
+			Expected: `{"key":"LOG_LEVEL","value":"debug"}`,
+		},
+		{
+			TestName: "secret_flagged_variable_value",
+			Path:     "/service/id/helmSecrets",
+			Body:     `{"variables":[{"key":"DB_PASS","value":"hunter2","is_secret":true},{"key":"LOG_LEVEL","value":"debug","is_secret":false}]}`,
+			Expected: `{"variables":[{"is_secret":true,"key":"DB_PASS","value":"**REDACTED**"},{"is_secret":false,"key":"LOG_LEVEL","value":"debug"}]}`,
+		},
+		{
+			TestName: "variable_endpoint_value",
+			Path:     "/variable",
+			Body:     `{"key":"DB_PASS","value":"hunter2","is_secret":true}`,
+			Expected: `{"is_secret":true,"key":"DB_PASS","value":"**REDACTED**"}`,
+		},
+		{
+			TestName: "variable_endpoint_edit_value",
+			Path:     "/variable/id",
+			Body:     `{"key":"DB_PASS","value":"hunter2"}`,
+			Expected: `{"key":"DB_PASS","value":"**REDACTED**"}`,
+		},
+		{
+			TestName: "non_string_sensitive_fields",
+			Path:     "/organization/id/gcpCredentials",
+			Body:     `{"name":"creds","gcp_credentials":{"type":"service_account","private_key":"-----BEGIN"},"tokens":["a","b"],"pin_code_password":1234,"is_secret":null}`,
+			Expected: `{"gcp_credentials":"**REDACTED**","is_secret":null,"name":"creds","pin_code_password":"**REDACTED**","tokens":"**REDACTED**"}`,
+		},
+		{
+			TestName: "kubeconfig_string_body",
+			Path:     "/organization/id/cluster/id/kubeconfig",
+			Body:     `"apiVersion: v1\nkind: Config\n"`,
+			Expected: "<32 bytes kubeconfig body omitted>",
+		},
+		{
+			TestName: "kubeconfig_json_body",
+			Path:     "/cluster/id/status",
+			Body:     `{"apiVersion":"v1","kind":"Config","users":[{"name":"u","user":{"client-key-data":"a2V5"}}]}`,
+			Expected: "<92 bytes kubeconfig body omitted>",
+		},
+		{
+			TestName: "kubeconfig_body",
+			Path:     "/cluster/id/kubeconfig",
+			Body:     "apiVersion: v1\nkind: Config\n",
+			Expected: "<28 bytes kubeconfig body omitted>",
+		},
+		{
+			TestName: "non_json_body",
+			Path:     "/cluster/id/logs",
+			Body:     "apiVersion: v1\nkind: Config\n",
+			Expected: "<28 bytes non-JSON body omitted>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.Expected, RedactBody(tc.Path, []byte(tc.Body)))
+		})
+	}
+}
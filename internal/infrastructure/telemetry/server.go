@@ -0,0 +1,145 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+	operationPlan   = "plan"
+	operationImport = "import"
+)
+
+// Ensure providerServer defined types fully satisfy the tfprotov6.ProviderServer interface.
+var _ tfprotov6.ProviderServer = providerServer{}
+
+// providerServer wraps a tfprotov6.ProviderServer to create a root span for each resource and data source operation.
+// HTTP calls and waits made while handling the operation are recorded as child spans.
+// StopProvider is passed through: Terraform sends it on cancellation while operations are still finishing,
+// so the pending spans are only flushed once the server has stopped serving, see Shutdown.
+type providerServer struct {
+	tfprotov6.ProviderServer
+}
+
+// WrapProviderServer returns a tfprotov6.ProviderServer tracing the operations handled by the given server.
+func WrapProviderServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	return providerServer{
+		ProviderServer: server,
+	}
+}
+
+func (s providerServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, operationRead)
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	endOperationSpan(span, diagnosticsOf(resp), err)
+	return resp, err
+}
+
+func (s providerServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, operationPlan)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	var diags []*tfprotov6.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, applyOperation(req))
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	var diags []*tfprotov6.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s providerServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, operationImport)
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	var diags []*tfprotov6.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, operationRead)
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	var diags []*tfprotov6.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func startOperationSpan(ctx context.Context, typeName string, operation string) (context.Context, trace.Span) {
+	return StartSpan(ctx, typeName+"."+operation,
+		attribute.String("terraform.type_name", typeName),
+		attribute.String("terraform.operation", operation),
+	)
+}
+
+// endOperationSpan marks the span as failed when the operation returned an error or error diagnostics, then ends it.
+func endOperationSpan(span trace.Span, diags []*tfprotov6.Diagnostic, err error) {
+	if err != nil {
+		EndSpan(span, err)
+		return
+	}
+
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, d.Summary)
+			span.AddEvent("diagnostic", trace.WithAttributes(
+				attribute.String("summary", d.Summary),
+				attribute.String("detail", d.Detail),
+			))
+		}
+	}
+	span.End()
+}
+
+func diagnosticsOf(resp *tfprotov6.ReadResourceResponse) []*tfprotov6.Diagnostic {
+	if resp == nil {
+		return nil
+	}
+	return resp.Diagnostics
+}
+
+// applyOperation infers whether an ApplyResourceChange request is a create, an update or a delete:
+// the prior state is null on create and the planned state is null on delete.
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	switch {
+	case isNullDynamicValue(req.PriorState):
+		return operationCreate
+	case isNullDynamicValue(req.PlannedState):
+		return operationDelete
+	default:
+		return operationUpdate
+	}
+}
+
+// isNullDynamicValue reports whether v encodes a null value, which msgpack represents as the single 0xc0 byte.
+func isNullDynamicValue(v *tfprotov6.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+	if len(v.MsgPack) > 0 {
+		return len(v.MsgPack) == 1 && v.MsgPack[0] == 0xc0
+	}
+	return len(v.JSON) == 0 || string(v.JSON) == "null"
+}
@@ -0,0 +1,31 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNullDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isNullDynamicValue(nil))
+	assert.True(t, isNullDynamicValue(&tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}))
+	assert.True(t, isNullDynamicValue(&tfprotov6.DynamicValue{JSON: []byte("null")}))
+	assert.False(t, isNullDynamicValue(&tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa2, 0x69, 0x64, 0xc0}}))
+}
+
+func TestApplyOperation(t *testing.T) {
+	t.Parallel()
+
+	value := &tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa2, 0x69, 0x64, 0xc0}}
+	null := &tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}
+
+	assert.Equal(t, operationCreate, applyOperation(&tfprotov6.ApplyResourceChangeRequest{PriorState: null, PlannedState: value}))
+	assert.Equal(t, operationUpdate, applyOperation(&tfprotov6.ApplyResourceChangeRequest{PriorState: value, PlannedState: value}))
+	assert.Equal(t, operationDelete, applyOperation(&tfprotov6.ApplyResourceChangeRequest{PriorState: value, PlannedState: null}))
+}
@@ -0,0 +1,186 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracingEnabledEnvName enables the OTLP exporter when set to a true value.
+	// The collector endpoint is read from the standard OTEL_EXPORTER_OTLP_* environment variables.
+	TracingEnabledEnvName = "QOVERY_TRACING_ENABLED"
+	// TracingFileEnvName enables the file exporter, writing spans as JSON to the given path.
+	TracingFileEnvName = "QOVERY_TRACING_FILE"
+
+	// instrumentationName is the name of the tracer used by the provider.
+	instrumentationName = "github.com/qovery/terraform-provider-qovery"
+	// serviceName is the service name reported in the exported spans.
+	serviceName = "terraform-provider-qovery"
+)
+
+var (
+	// ErrTracingAlreadyConfigured is returned when Setup is called with a different configuration after tracing has been started.
+	ErrTracingAlreadyConfigured = errors.New("tracing is already configured")
+
+	mu             sync.Mutex
+	tracerProvider *sdktrace.TracerProvider
+	activeConfig   *Config
+	closers        []func() error
+)
+
+// Config holds the tracing configuration, either read from the environment or from the provider block.
+type Config struct {
+	// Enabled turns on the OTLP/HTTP exporter.
+	Enabled bool
+	// OTLPEndpoint overrides the OTLP/HTTP collector endpoint (e.g. http://localhost:4318).
+	// When empty, the standard OTEL_EXPORTER_OTLP_* environment variables are used.
+	OTLPEndpoint string
+	// FilePath turns on the file exporter, spans are written as JSON to this path.
+	FilePath string
+	// Version is the provider version reported as the service version.
+	Version string
+}
+
+// IsEnabled returns whether at least one exporter is configured.
+func (c Config) IsEnabled() bool {
+	return c.Enabled || c.FilePath != ""
+}
+
+// ConfigFromEnv returns a Config read from the QOVERY_TRACING_* environment variables.
+func ConfigFromEnv() Config {
+	enabled, _ := strconv.ParseBool(os.Getenv(TracingEnabledEnvName))
+	return Config{
+		Enabled:  enabled,
+		FilePath: os.Getenv(TracingFileEnvName),
+	}
+}
+
+// Setup installs a global tracer provider exporting spans according to the given Config.
+// It is a no-op when no exporter is configured or when tracing has already been set up with the same configuration,
+// since Terraform may configure the provider several times within the same process.
+func Setup(ctx context.Context, cfg Config) error {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if tracerProvider != nil {
+		if *activeConfig == cfg {
+			return nil
+		}
+		return ErrTracingAlreadyConfigured
+	}
+
+	var opts []sdktrace.TracerProviderOption
+	var exporterClosers []func() error
+
+	if cfg.Enabled {
+		var otlpOpts []otlptracehttp.Option
+		if cfg.OTLPEndpoint != "" {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		exporter, err := otlptracehttp.New(ctx, otlpOpts...)
+		if err != nil {
+			return errors.Wrap(err, "failed to create otlp trace exporter")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	if cfg.FilePath != "" {
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return errors.Wrap(err, "failed to open trace file")
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			_ = file.Close()
+			return errors.Wrap(err, "failed to create file trace exporter")
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		exporterClosers = append(exporterClosers, file.Close)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(cfg.Version),
+	))
+	if err != nil {
+		return errors.Wrap(err, "failed to create trace resource")
+	}
+	opts = append(opts, sdktrace.WithResource(res))
+
+	tracerProvider = sdktrace.NewTracerProvider(opts...)
+	activeConfig = &cfg
+	closers = exporterClosers
+	otel.SetTracerProvider(tracerProvider)
+
+	return nil
+}
+
+// Shutdown flushes the pending spans and stops the exporters.
+// It is called once by main after the provider server has stopped serving, and is safe to call when tracing has not been set up.
+func Shutdown(ctx context.Context) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+
+	err := tracerProvider.Shutdown(ctx)
+	for _, closeFunc := range closers {
+		if closeErr := closeFunc(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	tracerProvider = nil
+	activeConfig = nil
+	closers = nil
+
+	return err
+}
+
+// Tracer returns the tracer used to instrument the provider.
+// Spans are dropped unless Setup has installed an exporting tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartSpan starts a new span with the given name and attributes as a child of the span in ctx, if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on the span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Poll runs a single iteration of a wait loop inside a "wait.poll" span.
+func Poll(ctx context.Context, iteration int, f func(ctx context.Context) (bool, error)) (bool, error) {
+	ctx, span := StartSpan(ctx, "wait.poll", attribute.Int("wait.iteration", iteration))
+	ok, err := f(ctx)
+	span.SetAttributes(attribute.Bool("wait.done", ok))
+	EndSpan(span, err)
+	return ok, err
+}
@@ -0,0 +1,68 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		StatusCode         int
+		ExpectedSpanStatus codes.Code
+	}{
+		{
+			TestName:           "success",
+			StatusCode:         http.StatusOK,
+			ExpectedSpanStatus: codes.Unset,
+		},
+		{
+			TestName:           "error_status_code",
+			StatusCode:         http.StatusNotFound,
+			ExpectedSpanStatus: codes.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			previous := otel.GetTracerProvider()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+			t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.StatusCode)
+			}))
+			defer server.Close()
+
+			resp, err := NewHTTPClient(SubsystemQoveryAPI).Get(server.URL + "/organization")
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, "HTTP GET", spans[0].Name())
+			assert.Equal(t, tc.ExpectedSpanStatus, spans[0].Status().Code)
+
+			attributes := attribute.NewSet(spans[0].Attributes()...)
+			path, ok := attributes.Value("url.path")
+			require.True(t, ok)
+			assert.Equal(t, "/organization", path.AsString())
+			statusCode, ok := attributes.Value("http.response.status_code")
+			require.True(t, ok)
+			assert.Equal(t, int64(tc.StatusCode), statusCode.AsInt64())
+		})
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 	"github.com/qovery/terraform-provider-qovery/qovery"
 )
 
@@ -27,12 +30,19 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/Qovery/qovery",
-		Debug:   debugMode,
+	// The provider server is wrapped to trace resource and data source operations when tracing is enabled.
+	serverFactory := func() tfprotov6.ProviderServer {
+		return telemetry.WrapProviderServer(providerserver.NewProtocol6(qovery.New(version)())())
 	}
 
-	if err := providerserver.Serve(context.Background(), qovery.New(version), opts); err != nil {
+	var opts []tf6server.ServeOpt
+	if debugMode {
+		opts = append(opts, tf6server.WithManagedDebug())
+	}
+
+	err := tf6server.Serve("registry.terraform.io/Qovery/qovery", serverFactory, opts...)
+	_ = telemetry.Shutdown(context.Background())
+	if err != nil {
 		log.Fatal(err)
 	}
 }
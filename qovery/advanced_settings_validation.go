@@ -47,6 +47,37 @@ func warnUnknownClusterAdvancedSettings(
 	warnUnknownAdvancedSettingsKeys(ctx, svc.UnknownSettingKeys, cfg, diags)
 }
 
+// validateAdvancedSettingsValues adds a plan-time warning for each advanced setting in
+// advanced_settings_json whose value is not valid for the given service type.
+func validateAdvancedSettingsValues(
+	ctx context.Context,
+	svc *advanced_settings.ServiceAdvancedSettingsService,
+	serviceType int,
+	cfg tfsdk.Config,
+	diags *diag.Diagnostics,
+) {
+	if svc == nil {
+		return
+	}
+	validateAdvancedSettingsValuesWith(ctx, func(advancedSettingsJson string) ([]advanced_settings.InvalidSettingValue, error) {
+		return svc.InvalidSettingValues(serviceType, advancedSettingsJson)
+	}, cfg, diags)
+}
+
+// validateClusterAdvancedSettingsValues adds a plan-time warning for each advanced setting in
+// advanced_settings_json whose value is not valid for a cluster.
+func validateClusterAdvancedSettingsValues(
+	ctx context.Context,
+	svc *advanced_settings.ClusterAdvancedSettingsService,
+	cfg tfsdk.Config,
+	diags *diag.Diagnostics,
+) {
+	if svc == nil {
+		return
+	}
+	validateAdvancedSettingsValuesWith(ctx, svc.InvalidSettingValues, cfg, diags)
+}
+
 // warnUnknownAdvancedSettingsKeys reads advanced_settings_json from the config, resolves the
 // unknown keys through lookup, and adds a plan-time warning for each. It never blocks the
 // plan: a null/unknown attribute or any error (config read, defaults fetch, or JSON parse)
@@ -57,16 +88,12 @@ func warnUnknownAdvancedSettingsKeys(
 	cfg tfsdk.Config,
 	diags *diag.Diagnostics,
 ) {
-	var raw types.String
-	if d := cfg.GetAttribute(ctx, path.Root(advancedSettingsJSONAttr), &raw); d.HasError() {
-		// Reading config failed (e.g. during destroy when config is null). Nothing to validate.
-		return
-	}
-	if raw.IsNull() || raw.IsUnknown() || raw.ValueString() == "" {
+	advancedSettingsJson, ok := configAdvancedSettingsJSON(ctx, cfg)
+	if !ok {
 		return
 	}
 
-	unknown, err := lookup(raw.ValueString())
+	unknown, err := lookup(advancedSettingsJson)
 	if err != nil {
 		tflog.Warn(ctx, "could not validate advanced settings keys", map[string]any{
 			"error": err.Error(),
@@ -87,3 +114,55 @@ func warnUnknownAdvancedSettingsKeys(
 		)
 	}
 }
+
+// validateAdvancedSettingsValuesWith reads advanced_settings_json from the config, resolves the
+// invalid values through lookup, and adds a plan-time warning for each, reporting the exact key of
+// the setting. The expected values are inferred from the default settings, so they never block the
+// plan: the API has the final say on apply. A null/unknown attribute or any error (config read,
+// defaults fetch, or JSON parse) degrades silently to "no warning".
+func validateAdvancedSettingsValuesWith(
+	ctx context.Context,
+	lookup func(advancedSettingsJson string) ([]advanced_settings.InvalidSettingValue, error),
+	cfg tfsdk.Config,
+	diags *diag.Diagnostics,
+) {
+	advancedSettingsJson, ok := configAdvancedSettingsJSON(ctx, cfg)
+	if !ok {
+		return
+	}
+
+	invalid, err := lookup(advancedSettingsJson)
+	if err != nil {
+		tflog.Warn(ctx, "could not validate advanced settings values", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, setting := range invalid {
+		diags.AddAttributeWarning(
+			path.Root(advancedSettingsJSONAttr),
+			fmt.Sprintf("Possibly invalid advanced setting %q", setting.Key),
+			fmt.Sprintf(
+				"The value of the advanced setting %q may be invalid: %s. "+
+					"This check is inferred from the default value and the key of the setting, "+
+					"the API has the final say on apply.",
+				setting.Key, setting.Reason,
+			),
+		)
+	}
+}
+
+// configAdvancedSettingsJSON returns advanced_settings_json from the config. It reports false
+// if the attribute cannot be read or is null, unknown or empty.
+func configAdvancedSettingsJSON(ctx context.Context, cfg tfsdk.Config) (string, bool) {
+	var raw types.String
+	if d := cfg.GetAttribute(ctx, path.Root(advancedSettingsJSONAttr), &raw); d.HasError() {
+		// Reading config failed (e.g. during destroy when config is null). Nothing to validate.
+		return "", false
+	}
+	if raw.IsNull() || raw.IsUnknown() || raw.ValueString() == "" {
+		return "", false
+	}
+	return raw.ValueString(), true
+}
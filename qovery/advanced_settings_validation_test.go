@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 )
 
 // testAdvancedSettingsConfig builds a tfsdk.Config holding a single advanced_settings_json
@@ -121,3 +123,82 @@ func TestWarnUnknownClusterAdvancedSettings_NilServiceIsNoop(t *testing.T) {
 		t.Fatalf("expected no diagnostics with nil service, got %v", diags)
 	}
 }
+
+func TestValidateAdvancedSettingsValuesWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName         string
+		value            tftypes.Value
+		lookup           func(advancedSettingsJson string) ([]advanced_settings.InvalidSettingValue, error)
+		expectedWarnings []string
+	}{
+		{
+			testName: "type_mismatches_produce_one_warning_each",
+			value:    tftypes.NewValue(tftypes.String, `{"network.ingress.cors_enabled": "true", "network.ingress.proxy_body_size_mb": "100"}`),
+			lookup: func(string) ([]advanced_settings.InvalidSettingValue, error) {
+				return []advanced_settings.InvalidSettingValue{
+					{Key: "network.ingress.cors_enabled", Reason: "expected a value of type boolean, got a value of type string"},
+					{Key: "network.ingress.proxy_body_size_mb", Reason: "expected a value of type number, got a value of type string"},
+				}, nil
+			},
+			expectedWarnings: []string{"network.ingress.cors_enabled", "network.ingress.proxy_body_size_mb"},
+		},
+		{
+			testName: "heuristic_findings_produce_warnings",
+			value:    tftypes.NewValue(tftypes.String, `{"network.ingress.cors_enabled": "true", "liveness_probe.initial_delay_seconds": 1.5}`),
+			lookup: func(string) ([]advanced_settings.InvalidSettingValue, error) {
+				return []advanced_settings.InvalidSettingValue{
+					{Key: "liveness_probe.initial_delay_seconds", Reason: "expected an integer, got 1.5"},
+					{Key: "network.ingress.cors_enabled", Reason: "expected a value of type boolean, got a value of type string"},
+				}, nil
+			},
+			expectedWarnings: []string{"liveness_probe.initial_delay_seconds", "network.ingress.cors_enabled"},
+		},
+		{
+			testName: "valid_values_no_warning",
+			value:    tftypes.NewValue(tftypes.String, `{"network.ingress.cors_enabled": true}`),
+			lookup: func(string) ([]advanced_settings.InvalidSettingValue, error) {
+				return nil, nil
+			},
+		},
+		{
+			testName: "unknown_value_no_warning_lookup_not_called",
+			value:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			lookup: func(string) ([]advanced_settings.InvalidSettingValue, error) {
+				panic("lookup must not be called for an unknown attribute")
+			},
+		},
+		{
+			testName: "lookup_error_degrades_to_no_warning",
+			value:    tftypes.NewValue(tftypes.String, `{"network.ingress.cors_enabled": "true"}`),
+			lookup: func(string) ([]advanced_settings.InvalidSettingValue, error) {
+				return nil, errors.New("api unreachable")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			validateAdvancedSettingsValuesWith(context.Background(), tc.lookup, testAdvancedSettingsConfig(tc.value), &diags)
+
+			if diags.HasError() {
+				t.Fatalf("expected no errors, got %v", diags.Errors())
+			}
+
+			warnings := diags.Warnings()
+			if len(warnings) != len(tc.expectedWarnings) {
+				t.Fatalf("got %d warnings, want %d: %v", len(warnings), len(tc.expectedWarnings), warnings)
+			}
+			for i, key := range tc.expectedWarnings {
+				if !strings.Contains(warnings[i].Summary(), key) || !strings.Contains(warnings[i].Detail(), key) {
+					t.Errorf("warning %d %q does not mention key %q", i, warnings[i].Summary(), key)
+				}
+			}
+		})
+	}
+}
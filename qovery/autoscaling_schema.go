@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/autoscaling"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
@@ -270,23 +269,6 @@ func toQoveryAutoscaling(o types.Object) *autoscaling.AutoscalingPolicy {
 	return policy
 }
 
-// toQoveryAutoscalingRequest converts the Terraform `autoscaling` object directly
-// into the API request model. Used by the application resource, which builds the
-// qovery.ApplicationRequest in the legacy client layer rather than via the DDD
-// repository. Returns (nil, nil) when the block is absent.
-func toQoveryAutoscalingRequest(o types.Object) (*qovery.AutoscalingPolicyRequest, error) {
-	policy := toQoveryAutoscaling(o)
-	if policy == nil {
-		return nil, nil
-	}
-
-	req, err := autoscaling.ToQoveryRequest(*policy)
-	if err != nil {
-		return nil, err
-	}
-	return &req, nil
-}
-
 // fromAutoscaling converts the shared domain model into a Terraform object.
 // Returns a null object when the policy is absent so an unset block stays unset.
 func fromAutoscaling(p *autoscaling.AutoscalingPolicy) types.Object {
@@ -336,18 +318,6 @@ func fromAutoscaling(p *autoscaling.AutoscalingPolicy) types.Object {
 	})
 }
 
-// fromAutoscalingResponse converts the API response model directly into a
-// Terraform object. Used by the application resource (legacy client layer).
-// A serialization error (re-marshalling an already-decoded config_json map,
-// which cannot realistically fail) degrades to a null object.
-func fromAutoscalingResponse(res *qovery.AutoscalingPolicyResponse) types.Object {
-	policy, err := autoscaling.FromQoveryResponse(res)
-	if err != nil {
-		return types.ObjectNull(autoscalingAttrTypes())
-	}
-	return fromAutoscaling(policy)
-}
-
 // validateAutoscalingPlan enforces, at plan time, the KEDA constraints the
 // backend would otherwise only reject *after* mutating the service (leaving the
 // resource partially updated / untracked). It is meant to be called from a
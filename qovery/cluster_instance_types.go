@@ -0,0 +1,161 @@
+package qovery
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// clusterInstanceTypesFS holds the catalog of the cluster instance types, refreshed by scripts/fetch_instance_types.sh.
+//
+//go:embed data/cluster_instance_types/*.json
+var clusterInstanceTypesFS embed.FS
+
+// clusterInstanceTypesDir is the directory of the catalog files in clusterInstanceTypesFS.
+const clusterInstanceTypesDir = "data/cluster_instance_types"
+
+// clusterInstanceTypesFiles maps the cloud providers to the file of their catalog.
+var clusterInstanceTypesFiles = map[string]string{
+	"AWS":   "aws.json",
+	"GCP":   "gcp.json",
+	"SCW":   "scaleway.json",
+	"AZURE": "azure.json",
+}
+
+// clusterInstanceTypesCloudProviders are the cloud providers covered by the catalog.
+var clusterInstanceTypesCloudProviders = []string{"AWS", "AZURE", "GCP", "SCW"}
+
+// clusterInstanceTypeArchitectures are the architectures of the cluster instance types.
+var clusterInstanceTypeArchitectures = []string{"AMD64", "ARM64"}
+
+// clusterInstanceType is an instance type of the catalog.
+type clusterInstanceType struct {
+	Type         string `json:"type"`
+	CPU          int64  `json:"cpu"`
+	RAMInGB      int64  `json:"ram_in_gb"`
+	Architecture string `json:"architecture"`
+}
+
+// clusterInstanceTypesCatalog holds the instance types by cloud provider and region.
+type clusterInstanceTypesCatalog map[string]map[string][]clusterInstanceType
+
+// loadClusterInstanceTypesCatalog parses the embedded catalog once.
+var loadClusterInstanceTypesCatalog = sync.OnceValues(func() (clusterInstanceTypesCatalog, error) {
+	catalog := make(clusterInstanceTypesCatalog, len(clusterInstanceTypesFiles))
+	for cloudProvider, file := range clusterInstanceTypesFiles {
+		content, err := clusterInstanceTypesFS.ReadFile(clusterInstanceTypesDir + "/" + file)
+		if err != nil {
+			return nil, err
+		}
+
+		regions := make(map[string][]clusterInstanceType)
+		if err := json.Unmarshal(content, &regions); err != nil {
+			return nil, fmt.Errorf("cannot parse the instance types of %s: %w", cloudProvider, err)
+		}
+		catalog[cloudProvider] = regions
+	}
+	return catalog, nil
+})
+
+// instanceTypes returns the instance types of the given cloud provider and region, and whether the region is in the catalog.
+func (c clusterInstanceTypesCatalog) instanceTypes(cloudProvider string, region string) ([]clusterInstanceType, bool) {
+	instanceTypes, ok := c[cloudProvider][region]
+	return instanceTypes, ok
+}
+
+// regions returns the regions of the given cloud provider, sorted.
+func (c clusterInstanceTypesCatalog) regions(cloudProvider string) []string {
+	regions := make([]string, 0, len(c[cloudProvider]))
+	for region := range c[cloudProvider] {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// clusterInstanceTypesFilter selects the instance types of the catalog, the zero value of a field matching every instance type.
+type clusterInstanceTypesFilter struct {
+	Architecture string
+	MinCPU       int64
+	MinRAMInGB   int64
+}
+
+// filterClusterInstanceTypes returns the instance types matching the filter, in the order of the catalog.
+func filterClusterInstanceTypes(instanceTypes []clusterInstanceType, filter clusterInstanceTypesFilter) []clusterInstanceType {
+	filtered := make([]clusterInstanceType, 0, len(instanceTypes))
+	for _, instanceType := range instanceTypes {
+		if filter.Architecture != "" && instanceType.Architecture != filter.Architecture {
+			continue
+		}
+		if instanceType.CPU < filter.MinCPU || instanceType.RAMInGB < filter.MinRAMInGB {
+			continue
+		}
+		filtered = append(filtered, instanceType)
+	}
+	return filtered
+}
+
+// validateClusterInstanceTypeConfig validates that the instance type is in the embedded catalog of the cloud provider
+// and region of the cluster.
+func validateClusterInstanceTypeConfig(cloudProvider types.String, region types.String, instanceType types.String) diag.Diagnostics {
+	catalog, err := loadClusterInstanceTypesCatalog()
+	if err != nil {
+		return nil
+	}
+	return validateClusterInstanceTypeConfigWith(catalog, cloudProvider, region, instanceType)
+}
+
+// validateClusterInstanceTypeConfigWith validates that the instance type is in the given catalog of the cloud provider
+// and region of the cluster. Regions missing from the catalog are not validated, as they may have been added since the
+// catalog was refreshed.
+func validateClusterInstanceTypeConfigWith(catalog clusterInstanceTypesCatalog, cloudProvider types.String, region types.String, instanceType types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range []types.String{cloudProvider, region, instanceType} {
+		if v.IsNull() || v.IsUnknown() {
+			return diags
+		}
+	}
+	if instanceType.ValueString() == "" || instanceType.ValueString() == cluster.InstanceTypeAutoPilot {
+		return diags
+	}
+
+	instanceTypes, ok := catalog.instanceTypes(cloudProvider.ValueString(), region.ValueString())
+	if !ok {
+		return diags
+	}
+
+	normalizedInstanceType := normalizeClusterInstanceType(instanceType.ValueString())
+	if slices.ContainsFunc(instanceTypes, func(t clusterInstanceType) bool {
+		return normalizeClusterInstanceType(t.Type) == normalizedInstanceType
+	}) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("instance_type"),
+		"Unknown instance type",
+		fmt.Sprintf(
+			"The instance type %q is not available for %s clusters in region %q. "+
+				"Use the qovery_cluster_instance_types data source to list the available instance types.",
+			instanceType.ValueString(), cloudProvider.ValueString(), region.ValueString(),
+		),
+	)
+	return diags
+}
+
+// normalizeClusterInstanceType returns the instance type in the legacy format also accepted by the API,
+// e.g. `T3A_MEDIUM` for `t3a.medium` or `DEV1_L` for `DEV1-L`.
+func normalizeClusterInstanceType(instanceType string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(instanceType))
+}
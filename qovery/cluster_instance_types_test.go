@@ -0,0 +1,187 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterInstanceTypesCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := loadClusterInstanceTypesCatalog()
+	require.NoError(t, err)
+
+	for _, cloudProvider := range clusterInstanceTypesCloudProviders {
+		for _, region := range catalog.regions(cloudProvider) {
+			instanceTypes, ok := catalog.instanceTypes(cloudProvider, region)
+			require.True(t, ok)
+			require.NotEmpty(t, instanceTypes, "no instance type for %s in %s", cloudProvider, region)
+			assert.True(t, sort.SliceIsSorted(instanceTypes, func(i, j int) bool {
+				return instanceTypes[i].Type < instanceTypes[j].Type
+			}), "instance types of %s in %s are not sorted", cloudProvider, region)
+
+			for _, instanceType := range instanceTypes {
+				assert.NotEmpty(t, instanceType.Type)
+				assert.Positive(t, instanceType.CPU, instanceType.Type)
+				assert.Positive(t, instanceType.RAMInGB, instanceType.Type)
+				assert.True(t, slices.Contains(clusterInstanceTypeArchitectures, instanceType.Architecture), instanceType.Type)
+			}
+		}
+	}
+}
+
+func TestFilterClusterInstanceTypes(t *testing.T) {
+	t.Parallel()
+
+	instanceTypes := []clusterInstanceType{
+		{Type: "c6g.large", CPU: 2, RAMInGB: 4, Architecture: "ARM64"},
+		{Type: "m6g.large", CPU: 2, RAMInGB: 8, Architecture: "ARM64"},
+		{Type: "t3a.large", CPU: 2, RAMInGB: 8, Architecture: "AMD64"},
+		{Type: "t3a.xlarge", CPU: 4, RAMInGB: 16, Architecture: "AMD64"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   clusterInstanceTypesFilter
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			filter:   clusterInstanceTypesFilter{},
+			expected: []string{"c6g.large", "m6g.large", "t3a.large", "t3a.xlarge"},
+		},
+		{
+			name:     "ARM64 with at least 8 GB",
+			filter:   clusterInstanceTypesFilter{Architecture: "ARM64", MinRAMInGB: 8},
+			expected: []string{"m6g.large"},
+		},
+		{
+			name:     "at least 4 vCPUs",
+			filter:   clusterInstanceTypesFilter{MinCPU: 4},
+			expected: []string{"t3a.xlarge"},
+		},
+		{
+			name:     "no match",
+			filter:   clusterInstanceTypesFilter{Architecture: "ARM64", MinCPU: 4},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered := filterClusterInstanceTypes(instanceTypes, tt.filter)
+			names := make([]string, 0, len(filtered))
+			for _, instanceType := range filtered {
+				names = append(names, instanceType.Type)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestValidateClusterInstanceTypeConfig(t *testing.T) {
+	t.Parallel()
+
+	catalog := clusterInstanceTypesCatalog{
+		"AWS": {
+			"eu-west-3": {{Type: "t3a.medium", CPU: 2, RAMInGB: 4, Architecture: "AMD64"}},
+		},
+		"GCP": {
+			"europe-west9": {{Type: "e2-standard-2", CPU: 2, RAMInGB: 8, Architecture: "AMD64"}},
+		},
+		"SCW": {
+			"fr-par-1": {{Type: "DEV1-L", CPU: 4, RAMInGB: 8, Architecture: "AMD64"}},
+			"pl-waw-1": {{Type: "DEV1-L", CPU: 4, RAMInGB: 8, Architecture: "AMD64"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		cloudProvider types.String
+		region        types.String
+		instanceType  types.String
+		expectError   bool
+	}{
+		{
+			name:          "known AWS instance type",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("eu-west-3"),
+			instanceType:  types.StringValue("t3a.medium"),
+		},
+		{
+			name:          "known AWS instance type in the legacy format",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("eu-west-3"),
+			instanceType:  types.StringValue("T3A_MEDIUM"),
+		},
+		{
+			name:          "unknown AWS instance type",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("eu-west-3"),
+			instanceType:  types.StringValue("t3a.mediun"),
+			expectError:   true,
+		},
+		{
+			name:          "instance type of another cloud provider",
+			cloudProvider: types.StringValue("SCW"),
+			region:        types.StringValue("fr-par-1"),
+			instanceType:  types.StringValue("t3a.medium"),
+			expectError:   true,
+		},
+		{
+			name:          "known Scaleway instance type",
+			cloudProvider: types.StringValue("SCW"),
+			region:        types.StringValue("pl-waw-1"),
+			instanceType:  types.StringValue("DEV1-L"),
+		},
+		{
+			name:          "GKE Autopilot",
+			cloudProvider: types.StringValue("GCP"),
+			region:        types.StringValue("europe-west9"),
+			instanceType:  types.StringValue("AUTO_PILOT"),
+		},
+		{
+			name:          "region missing from the catalog is not validated",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("ap-unknown-1"),
+			instanceType:  types.StringValue("t3a.mediun"),
+		},
+		{
+			name:          "on premise cluster is not validated",
+			cloudProvider: types.StringValue("ON_PREMISE"),
+			region:        types.StringValue("on-premise"),
+			instanceType:  types.StringValue("anything"),
+		},
+		{
+			name:          "unknown instance type value",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("eu-west-3"),
+			instanceType:  types.StringUnknown(),
+		},
+		{
+			name:          "null instance type",
+			cloudProvider: types.StringValue("AWS"),
+			region:        types.StringValue("eu-west-3"),
+			instanceType:  types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateClusterInstanceTypeConfigWith(catalog, tt.cloudProvider, tt.region, tt.instanceType)
+			assert.Equal(t, tt.expectError, diags.HasError(), diags)
+		})
+	}
+}
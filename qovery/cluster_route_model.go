@@ -2,11 +2,14 @@ package qovery
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
 var clusterRouteAttrTypes = map[string]attr.Type{
@@ -42,16 +45,14 @@ func (routes ClusterRouteList) toTerraformSet(ctx context.Context, initialPlanCl
 	return set
 }
 
-func (routes ClusterRouteList) toUpsertRequest() client.ClusterRoutingTable {
-	list := make([]client.ClusterRoute, 0, len(routes))
+func (routes ClusterRouteList) toDomainRoutingTable() cluster.RoutingTable {
+	list := make(cluster.RoutingTable, 0, len(routes))
 
 	for _, r := range routes {
-		list = append(list, r.toUpsertRequest())
+		list = append(list, r.toDomainRoute())
 	}
 
-	return client.ClusterRoutingTable{
-		Routes: list,
-	}
+	return list
 }
 
 type ClusterRoute struct {
@@ -74,15 +75,15 @@ func (r ClusterRoute) toTerraformObject() types.Object {
 	return terraformObjectValue
 }
 
-func (r ClusterRoute) toUpsertRequest() client.ClusterRoute {
-	return client.ClusterRoute{
+func (r ClusterRoute) toDomainRoute() cluster.Route {
+	return cluster.Route{
 		Description: ToString(r.Description),
 		Destination: ToString(r.Destination),
 		Target:      ToString(r.Target),
 	}
 }
 
-func fromClusterRoute(r client.ClusterRoute) ClusterRoute {
+func fromClusterRoute(r cluster.Route) ClusterRoute {
 	return ClusterRoute{
 		Description: FromString(r.Description),
 		Destination: FromString(r.Destination),
@@ -90,13 +91,9 @@ func fromClusterRoute(r client.ClusterRoute) ClusterRoute {
 	}
 }
 
-func fromClusterRoutingTable(routingTable *client.ClusterRoutingTable) ClusterRouteList {
-	if routingTable == nil {
-		return nil
-	}
-
-	list := make([]ClusterRoute, 0, len(routingTable.Routes))
-	for _, v := range routingTable.Routes {
+func fromClusterRoutingTable(routingTable cluster.RoutingTable) ClusterRouteList {
+	list := make([]ClusterRoute, 0, len(routingTable))
+	for _, v := range routingTable {
 		list = append(list, fromClusterRoute(v))
 	}
 
@@ -126,3 +123,50 @@ func toClusterRouteList(routes types.Set) ClusterRouteList {
 
 	return clusterRoutes
 }
+
+// newClusterRouteSet returns the set of the routes of the given routing table.
+func newClusterRouteSet(routingTable cluster.RoutingTable) (types.Set, diag.Diagnostics) {
+	elements := make([]attr.Value, 0, len(routingTable))
+	for _, r := range routingTable {
+		elements = append(elements, fromClusterRoute(r).toTerraformObject())
+	}
+
+	return types.SetValue(types.ObjectType{AttrTypes: clusterRouteAttrTypes}, elements)
+}
+
+// validateClusterRoutesConfig validates the destinations and targets of the given routes, and that their destinations are unique.
+// Overlapping destinations are valid, as the traffic is routed by the most specific route, and are reported as warnings.
+// It is meant to be called at plan time, the routes are only validated once they are all known.
+func validateClusterRoutesConfig(p path.Path, routes types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if routes.IsNull() || routes.IsUnknown() {
+		return diags
+	}
+
+	for _, elem := range routes.Elements() {
+		if elem.IsUnknown() {
+			return diags
+		}
+		for _, v := range elem.(types.Object).Attributes() {
+			if v.IsUnknown() {
+				return diags
+			}
+		}
+	}
+
+	routingTable := toClusterRouteList(routes).toDomainRoutingTable()
+	if err := routingTable.Validate(); err != nil {
+		diags.AddAttributeError(p, "Invalid routing table", err.Error())
+		return diags
+	}
+
+	for _, overlap := range routingTable.OverlappingDestinations() {
+		diags.AddAttributeWarning(
+			p,
+			"Overlapping routes",
+			fmt.Sprintf("The route destinations %s and %s overlap: the traffic to the addresses they share is routed by the most specific route.", overlap[0], overlap[1]),
+		)
+	}
+
+	return diags
+}
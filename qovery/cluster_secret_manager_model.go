@@ -2,8 +2,15 @@ package qovery
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
@@ -31,6 +38,120 @@ var secretManagerAccessAttrTypes = map[string]attr.Type{
 	"authentication": types.ObjectType{AttrTypes: secretManagerAuthAttrTypes},
 }
 
+// secretManagerEndpointOptionalFields are the endpoint fields whose use depends on the endpoint type.
+var secretManagerEndpointOptionalFields = []string{"project_id"}
+
+// secretManagerEndpointFields lists, for each endpoint type, the optional endpoint fields it requires.
+// The other optional fields must not be set.
+var secretManagerEndpointFields = map[string][]string{
+	"AWS_PARAMETER_STORE": {},
+	"AWS_SECRET_MANAGER":  {},
+	"GCP_SECRET_MANAGER":  {"project_id"},
+}
+
+// secretManagerAuthOptionalFields are the authentication fields whose use depends on the authentication type.
+var secretManagerAuthOptionalFields = []string{"role_arn", "region", "access_key", "secret_key", "json_credentials"}
+
+// secretManagerAuthFields lists, for each authentication type, the optional authentication fields it requires.
+// The other optional fields must not be set.
+var secretManagerAuthFields = map[string][]string{
+	"AUTOMATICALLY_CONFIGURED": {},
+	"AWS_ROLE_ARN":             {"role_arn"},
+	"AWS_STATIC_CREDENTIALS":   {"region", "access_key", "secret_key"},
+	"GCP_JSON_CREDENTIALS":     {"json_credentials"},
+}
+
+// validateSecretManagerAccessesConfig validates at plan time that each secret manager access sets exactly the fields
+// required by its endpoint and authentication types, and that both types belong to the same cloud provider.
+// Unknown values are left to the apply.
+func validateSecretManagerAccessesConfig(accesses types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if accesses.IsNull() || accesses.IsUnknown() {
+		return diags
+	}
+
+	for _, elem := range accesses.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		accessPath := path.Root("secret_manager_accesses").AtSetValue(elem)
+
+		endpoint, _ := obj.Attributes()["endpoint"].(types.Object)
+		auth, _ := obj.Attributes()["authentication"].(types.Object)
+		endpointType := validateSecretManagerFieldsConfig(&diags, accessPath.AtName("endpoint"), endpoint, secretManagerEndpointOptionalFields, secretManagerEndpointFields)
+		authType := validateSecretManagerFieldsConfig(&diags, accessPath.AtName("authentication"), auth, secretManagerAuthOptionalFields, secretManagerAuthFields)
+
+		// Endpoint and authentication types are prefixed with their cloud provider, e.g. AWS_ROLE_ARN only applies to AWS endpoints.
+		if endpointType != "" && authType != "" && authType != "AUTOMATICALLY_CONFIGURED" &&
+			strings.Split(endpointType, "_")[0] != strings.Split(authType, "_")[0] {
+			diags.AddAttributeError(
+				accessPath.AtName("authentication").AtName("type"),
+				"Invalid secret manager authentication",
+				fmt.Sprintf("authentication.type %s cannot be used with endpoint.type %s.", authType, endpointType),
+			)
+		}
+
+		if authType == "GCP_JSON_CREDENTIALS" {
+			jsonCredentials, _ := auth.Attributes()["json_credentials"].(types.String)
+			if !jsonCredentials.IsNull() && !jsonCredentials.IsUnknown() && jsonCredentials.ValueString() != "" &&
+				!isBase64JSONObject(jsonCredentials.ValueString()) {
+				diags.AddAttributeError(
+					accessPath.AtName("authentication").AtName("json_credentials"),
+					"Invalid secret manager authentication",
+					"authentication.json_credentials must be the base64-encoded JSON key of a GCP service account, e.g. base64encode(file(\"key.json\")).",
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// isBase64JSONObject returns whether value is a base64-encoded JSON object, the format the API expects for the JSON
+// credentials of a GCP service account.
+func isBase64JSONObject(value string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return false
+	}
+	var object map[string]any
+	return json.Unmarshal(decoded, &object) == nil
+}
+
+// validateSecretManagerFieldsConfig checks that the given endpoint or authentication object sets exactly the optional
+// fields required by its type, and returns the type, or an empty string if it is not known.
+func validateSecretManagerFieldsConfig(diags *diag.Diagnostics, p path.Path, obj types.Object, optionalFields []string, fieldsByType map[string][]string) string {
+	if obj.IsNull() || obj.IsUnknown() {
+		return ""
+	}
+
+	attrs := obj.Attributes()
+	typeAttr, _ := attrs["type"].(types.String)
+	requiredFields, ok := fieldsByType[typeAttr.ValueString()]
+	if typeAttr.IsNull() || typeAttr.IsUnknown() || !ok {
+		return ""
+	}
+
+	for _, name := range optionalFields {
+		field, _ := attrs[name].(types.String)
+		if field.IsUnknown() {
+			continue
+		}
+
+		switch required := slices.Contains(requiredFields, name); {
+		case required && (field.IsNull() || field.ValueString() == ""):
+			diags.AddAttributeError(p.AtName(name), "Missing required attribute",
+				fmt.Sprintf("%s must be set when type is %s.", name, typeAttr.ValueString()))
+		case !required && !field.IsNull():
+			diags.AddAttributeError(p.AtName(name), "Conflicting attribute",
+				fmt.Sprintf("%s must not be set when type is %s.", name, typeAttr.ValueString()))
+		}
+	}
+
+	return typeAttr.ValueString()
+}
+
 func toQoverySecretManagerAccessRequests(set types.Set) ([]qovery.SecretManagerAccessRequest, error) {
 	if set.IsNull() || set.IsUnknown() {
 		return nil, nil
@@ -4,10 +4,13 @@ package qovery
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/qovery/qovery-client-go"
 	"github.com/stretchr/testify/assert"
@@ -685,3 +688,104 @@ func TestAcc_FromQoverySecretManagerAccesses(t *testing.T) {
 		})
 	}
 }
+
+// --- validateSecretManagerAccessesConfig ------------------------------------
+
+func TestValidateSecretManagerAccessesConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		access         types.Object
+		wantErrorPaths []string
+	}{
+		{
+			name:   "aws_secret_manager_role_arn",
+			access: makeAccessObj("", "my-access", awsSecretEndpointObj("us-east-1"), roleArnAuthObj("arn:aws:iam::123456789012:role/my-role")),
+		},
+		{
+			name:   "aws_parameter_store_static_credentials",
+			access: makeAccessObj("", "my-access", awsParamEndpointObj("us-east-1"), staticCredsAuthObj("us-east-1", "AKIA123", "secret")),
+		},
+		{
+			name:   "gcp_secret_manager_base64_json_credentials",
+			access: makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), gcpJsonCredsAuthObj(base64.StdEncoding.EncodeToString([]byte(`{"type":"service_account"}`)))),
+		},
+		{
+			name:   "gcp_secret_manager_automatically_configured",
+			access: makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), autoAuthObj()),
+		},
+		{
+			name: "unknown_secret_key",
+			access: makeAccessObj("", "my-access", awsParamEndpointObj("us-east-1"), types.ObjectValueMust(secretManagerAuthAttrTypes, map[string]attr.Value{
+				"type":             types.StringValue("AWS_STATIC_CREDENTIALS"),
+				"role_arn":         types.StringNull(),
+				"region":           types.StringValue("us-east-1"),
+				"access_key":       types.StringValue("AKIA123"),
+				"secret_key":       types.StringUnknown(),
+				"json_credentials": types.StringNull(),
+			})),
+		},
+		{
+			name:           "gcp_secret_manager_missing_project_id",
+			access:         makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", ""), autoAuthObj()),
+			wantErrorPaths: []string{"endpoint.project_id"},
+		},
+		{
+			name:           "aws_parameter_store_with_project_id",
+			access:         makeAccessObj("", "my-access", makeEndpointObj("AWS_PARAMETER_STORE", "us-east-1", "my-project"), autoAuthObj()),
+			wantErrorPaths: []string{"endpoint.project_id"},
+		},
+		{
+			name:           "static_credentials_missing_secret_key",
+			access:         makeAccessObj("", "my-access", awsParamEndpointObj("us-east-1"), staticCredsAuthObj("us-east-1", "AKIA123", "")),
+			wantErrorPaths: []string{"authentication.secret_key"},
+		},
+		{
+			name:           "role_arn_with_static_credentials",
+			access:         makeAccessObj("", "my-access", awsParamEndpointObj("us-east-1"), makeAuthObj("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/my-role", "", "AKIA123", "", "")),
+			wantErrorPaths: []string{"authentication.access_key"},
+		},
+		{
+			name:           "automatically_configured_with_json_credentials",
+			access:         makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), makeAuthObj("AUTOMATICALLY_CONFIGURED", "", "", "", "", `{"type":"service_account"}`)),
+			wantErrorPaths: []string{"authentication.json_credentials"},
+		},
+		{
+			name:           "gcp_json_credentials_with_aws_endpoint",
+			access:         makeAccessObj("", "my-access", awsSecretEndpointObj("us-east-1"), gcpJsonCredsAuthObj(base64.StdEncoding.EncodeToString([]byte(`{"type":"service_account"}`)))),
+			wantErrorPaths: []string{"authentication.type"},
+		},
+		{
+			name:           "gcp_json_credentials_not_json",
+			access:         makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), gcpJsonCredsAuthObj("not-json")),
+			wantErrorPaths: []string{"authentication.json_credentials"},
+		},
+		{
+			name:           "gcp_json_credentials_raw_json",
+			access:         makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), gcpJsonCredsAuthObj(`{"type":"service_account"}`)),
+			wantErrorPaths: []string{"authentication.json_credentials"},
+		},
+		{
+			name:           "gcp_json_credentials_base64_not_json_object",
+			access:         makeAccessObj("", "my-access", gcpSecretEndpointObj("europe-west1", "my-project"), gcpJsonCredsAuthObj(base64.StdEncoding.EncodeToString([]byte(`"service_account"`)))),
+			wantErrorPaths: []string{"authentication.json_credentials"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateSecretManagerAccessesConfig(createSecretManagerAccesses(tc.access))
+
+			errorPaths := make([]string, 0, len(diags.Errors()))
+			for _, d := range diags.Errors() {
+				p := d.(diag.DiagnosticWithPath).Path().String()
+				errorPaths = append(errorPaths, p[strings.LastIndex(p, "].")+2:])
+			}
+			assert.ElementsMatch(t, tc.wantErrorPaths, errorPaths)
+		})
+	}
+}
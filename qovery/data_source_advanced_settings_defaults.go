@@ -0,0 +1,223 @@
+package qovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ datasource.DataSourceWithConfigure = &advancedSettingsDefaultsDataSource{}
+
+// advancedSettingsDefaultsClusterType is the type of the advanced settings defaults of clusters.
+const advancedSettingsDefaultsClusterType = "cluster"
+
+// advancedSettingsDefaultsServiceTypes maps the types of the advanced settings defaults of services to their service type.
+// Databases have no advanced settings in the Qovery API.
+var advancedSettingsDefaultsServiceTypes = map[string]int{
+	"application": domain.APPLICATION,
+	"container":   domain.CONTAINER,
+	"job":         domain.JOB,
+	"helm":        domain.HELM,
+	"terraform":   domain.TERRAFORM,
+}
+
+var advancedSettingsDefaultsTypes = []string{"application", "container", "job", "helm", "terraform", advancedSettingsDefaultsClusterType}
+
+type AdvancedSettingsDefaults struct {
+	Type                 types.String  `tfsdk:"type"`
+	AdvancedSettingsJson types.String  `tfsdk:"advanced_settings_json"`
+	AdvancedSettings     types.Dynamic `tfsdk:"advanced_settings"`
+}
+
+type advancedSettingsDefaultsDataSource struct {
+	advancedSettingsService        *advanced_settings.ServiceAdvancedSettingsService
+	clusterAdvancedSettingsService *advanced_settings.ClusterAdvancedSettingsService
+}
+
+func newAdvancedSettingsDefaultsDataSource() datasource.DataSource {
+	return &advancedSettingsDefaultsDataSource{}
+}
+
+func (d advancedSettingsDefaultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_advanced_settings_defaults"
+}
+
+func (d *advancedSettingsDefaultsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.advancedSettingsService = provider.advancedSettingsService
+	d.clusterAdvancedSettingsService = provider.clusterAdvancedSettingsService
+}
+
+func (d advancedSettingsDefaultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Use this data source to retrieve the default advanced settings of a service type or of clusters.",
+		MarkdownDescription: "Use this data source to retrieve the default advanced settings of a service type or of clusters, e.g. to merge overrides onto them before setting `advanced_settings_json`.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: descriptions.NewStringEnumDescription(
+					"Type of the resource whose default advanced settings are retrieved. Databases have no advanced settings.",
+					advancedSettingsDefaultsTypes,
+					nil,
+				),
+				MarkdownDescription: descriptions.NewStringEnumDescription(
+					"Type of the resource whose default advanced settings are retrieved. Databases have no advanced settings.",
+					advancedSettingsDefaultsTypes,
+					nil,
+				),
+				Required: true,
+				Validators: []validator.String{
+					validators.NewStringEnumValidator(advancedSettingsDefaultsTypes),
+				},
+			},
+			"advanced_settings_json": schema.StringAttribute{
+				Description:         "Default advanced settings as a JSON object, keyed by setting.",
+				MarkdownDescription: "Default advanced settings as a JSON object, keyed by setting.",
+				Computed:            true,
+			},
+			"advanced_settings": schema.DynamicAttribute{
+				Description:         "Default advanced settings as an object keyed by setting, each setting keeping the type of its default value. Settings without default value are null.",
+				MarkdownDescription: "Default advanced settings as an object keyed by setting, each setting keeping the type of its default value. Settings without default value are `null`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read qovery advanced settings defaults data source
+func (d advancedSettingsDefaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var data AdvancedSettingsDefaults
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get advanced settings defaults from API
+	defaults, err := d.fetchDefaults(data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error on advanced settings defaults read", err.Error())
+		return
+	}
+
+	state, diags := convertAdvancedSettingsDefaultsToTerraform(data.Type.ValueString(), defaults)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "read advanced settings defaults", map[string]any{"type": state.Type.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d advancedSettingsDefaultsDataSource) fetchDefaults(settingsType string) (map[string]any, error) {
+	if settingsType == advancedSettingsDefaultsClusterType {
+		return d.clusterAdvancedSettingsService.DefaultSettings()
+	}
+
+	serviceType, ok := advancedSettingsDefaultsServiceTypes[settingsType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported advanced settings type %q", settingsType)
+	}
+	return d.advancedSettingsService.DefaultSettings(serviceType)
+}
+
+func convertAdvancedSettingsDefaultsToTerraform(settingsType string, defaults map[string]any) (AdvancedSettingsDefaults, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Map keys are sorted by json.Marshal, keeping the JSON stable across reads.
+	defaultsJson, err := json.Marshal(defaults)
+	if err != nil {
+		diags.AddError("Error on advanced settings defaults read", err.Error())
+		return AdvancedSettingsDefaults{}, diags
+	}
+
+	settings, err := jsonValueToAttr(defaults)
+	if err != nil {
+		diags.AddError("Error on advanced settings defaults read", err.Error())
+		return AdvancedSettingsDefaults{}, diags
+	}
+
+	return AdvancedSettingsDefaults{
+		Type:                 types.StringValue(settingsType),
+		AdvancedSettingsJson: types.StringValue(string(defaultsJson)),
+		AdvancedSettings:     types.DynamicValue(settings),
+	}, diags
+}
+
+// jsonValueToAttr converts a value decoded by encoding/json to the Terraform value of the same type.
+// Lists are converted to tuples, as their elements may have different types, and null to a null string.
+func jsonValueToAttr(value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case string:
+		return types.StringValue(v), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, e := range v {
+			element, err := jsonValueToAttr(e)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, element.Type(context.Background()))
+			elements = append(elements, element)
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("cannot convert list: %v", diags.Errors())
+		}
+		return tuple, nil
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			attribute, err := jsonValueToAttr(e)
+			if err != nil {
+				return nil, err
+			}
+			attributeTypes[k] = attribute.Type(context.Background())
+			attributes[k] = attribute
+		}
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("cannot convert object: %v", diags.Errors())
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value of type %T", value)
+	}
+}
@@ -0,0 +1,59 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAdvancedSettingsDefaultsToTerraform(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]any{
+		"network.ingress.cors_enabled":           false,
+		"network.ingress.proxy_body_size_mb":     float64(100),
+		"network.ingress.whitelist_source_range": "0.0.0.0/0",
+		"security.service_account_name":          nil,
+		"deployment.toleration.effects":          []any{"NoSchedule", float64(1)},
+		"deployment.affinity.node.required":      map[string]any{"zone": "a"},
+	}
+
+	state, diags := convertAdvancedSettingsDefaultsToTerraform("application", defaults)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "application", state.Type.ValueString())
+	assert.JSONEq(t, `{
+		"network.ingress.cors_enabled": false,
+		"network.ingress.proxy_body_size_mb": 100,
+		"network.ingress.whitelist_source_range": "0.0.0.0/0",
+		"security.service_account_name": null,
+		"deployment.toleration.effects": ["NoSchedule", 1],
+		"deployment.affinity.node.required": {"zone": "a"}
+	}`, state.AdvancedSettingsJson.ValueString())
+
+	settings, ok := state.AdvancedSettings.UnderlyingValue().(types.Object)
+	require.True(t, ok, "advanced settings must be an object, got %T", state.AdvancedSettings.UnderlyingValue())
+	attributes := settings.Attributes()
+	assert.Len(t, attributes, len(defaults))
+	assert.Equal(t, types.BoolValue(false), attributes["network.ingress.cors_enabled"])
+	assert.Equal(t, types.NumberValue(big.NewFloat(100)), attributes["network.ingress.proxy_body_size_mb"])
+	assert.Equal(t, types.StringValue("0.0.0.0/0"), attributes["network.ingress.whitelist_source_range"])
+	assert.True(t, attributes["security.service_account_name"].IsNull())
+	assert.Equal(t, types.TupleValueMust(
+		[]attr.Type{types.StringType, types.NumberType},
+		[]attr.Value{types.StringValue("NoSchedule"), types.NumberValue(big.NewFloat(1))},
+	), attributes["deployment.toleration.effects"])
+	assert.Equal(t, types.ObjectValueMust(
+		map[string]attr.Type{"zone": types.StringType},
+		map[string]attr.Value{"zone": types.StringValue("a")},
+	), attributes["deployment.affinity.node.required"])
+	assert.Equal(t, types.DynamicType, state.AdvancedSettings.Type(context.Background()))
+}
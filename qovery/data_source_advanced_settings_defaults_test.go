@@ -0,0 +1,48 @@
+//go:build integration && !unit
+
+package qovery_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_AdvancedSettingsDefaultsDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAdvancedSettingsDefaultsDataSourceConfig("application"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qovery_advanced_settings_defaults.test", "type", "application"),
+					resource.TestMatchResourceAttr("data.qovery_advanced_settings_defaults.test", "advanced_settings_json", regexp.MustCompile(`"deployment.termination_grace_period_seconds"`)),
+				),
+			},
+			{
+				Config: testAccAdvancedSettingsDefaultsDataSourceConfig("cluster"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qovery_advanced_settings_defaults.test", "type", "cluster"),
+					resource.TestMatchResourceAttr("data.qovery_advanced_settings_defaults.test", "advanced_settings_json", regexp.MustCompile(`"registry.image_retention_time"`)),
+				),
+			},
+			{
+				Config:      testAccAdvancedSettingsDefaultsDataSourceConfig("database"),
+				ExpectError: regexp.MustCompile("string value must be one of"),
+			},
+		},
+	})
+}
+
+func testAccAdvancedSettingsDefaultsDataSourceConfig(settingsType string) string {
+	return fmt.Sprintf(`
+data "qovery_advanced_settings_defaults" "test" {
+  type = "%s"
+}
+`, settingsType)
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
@@ -21,7 +21,7 @@ import (
 var _ datasource.DataSourceWithConfigure = &applicationDataSource{}
 
 type applicationDataSource struct {
-	client *client.Client
+	applicationService application.Service
 }
 
 func newApplicationDataSource() datasource.DataSource {
@@ -47,7 +47,7 @@ func (d *applicationDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	d.client = provider.client
+	d.applicationService = provider.applicationService
 }
 
 func (r applicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -809,13 +809,13 @@ func (d applicationDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Get application from API
-	application, apiErr := d.client.GetApplication(ctx, data.Id.ValueString(), data.AdvancedSettingsJson.ValueString(), true)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := d.applicationService.Get(ctx, data.Id.ValueString(), data.AdvancedSettingsJson.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error on application read", err.Error())
 		return
 	}
 
-	state := convertResponseToApplication(ctx, data, application)
+	state := convertDomainApplicationToApplication(ctx, data, app)
 	tflog.Trace(ctx, "read application", map[string]any{"application_id": state.Id.ValueString()})
 
 	// Set state
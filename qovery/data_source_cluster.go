@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 )
 
@@ -21,7 +21,7 @@ import (
 var _ datasource.DataSourceWithConfigure = &clusterDataSource{}
 
 type clusterDataSource struct {
-	client *client.Client
+	clusterService cluster.Service
 }
 
 func newClusterDataSource() datasource.DataSource {
@@ -47,7 +47,7 @@ func (d *clusterDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	d.client = provider.client
+	d.clusterService = provider.clusterService
 }
 
 func (r clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -114,6 +114,11 @@ func (r clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"kubernetes_version": schema.StringAttribute{
+				Description:         "Kubernetes version of the cluster.",
+				MarkdownDescription: "Kubernetes version of the cluster (e.g., `1.32`).",
+				Computed:            true,
+			},
 			"instance_type": schema.StringAttribute{
 				Description:         "Instance type of the cluster. I.e: For Aws `t3a.xlarge`, for Scaleway `DEV-L`, and not set for Karpenter-enabled clusters",
 				MarkdownDescription: "Instance type of the cluster nodes (e.g., `t3a.xlarge` for AWS, `DEV1-L` for Scaleway, `AUTO_PILOT` for GCP).",
@@ -524,6 +529,215 @@ func (r clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 											},
 										},
 									},
+									"gpu_override": schema.SingleNestedAttribute{
+										Description:         "Defines the Qovery GPU node pool",
+										MarkdownDescription: "Configuration of the GPU node pool (requirements, consolidation, resource limits, spot instances and disk size).",
+										Optional:            true,
+										Computed:            false,
+										Attributes: map[string]schema.Attribute{
+											"requirements": schema.ListNestedAttribute{
+												Description:         "List of requirements for the GPU node pool",
+												MarkdownDescription: "Node selection requirements for the GPU node pool.",
+												Optional:            true,
+												Computed:            false,
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"key": schema.StringAttribute{
+															Description:         "The key of the requirement (e.g., InstanceFamily, InstanceSize, Arch)",
+															MarkdownDescription: "Requirement key (`InstanceFamily`, `InstanceSize`, or `Arch`).",
+															Required:            true,
+															Computed:            false,
+															Validators: []validator.String{
+																validators.NewStringEnumValidator([]string{"InstanceFamily", "InstanceSize", "Arch"}),
+															},
+														},
+														"operator": schema.StringAttribute{
+															Description:         "The operator for the requirement (e.g., In)",
+															MarkdownDescription: "Requirement operator. Currently only `In` is supported.",
+															Required:            true,
+															Computed:            false,
+															Validators: []validator.String{
+																validators.NewStringEnumValidator([]string{"In"}),
+															},
+														},
+														"values": schema.ListAttribute{
+															Description:         "List of values for the requirement",
+															MarkdownDescription: "Allowed values for the requirement.",
+															Required:            true,
+															Computed:            false,
+															ElementType:         types.StringType,
+														},
+													},
+												},
+											},
+											"consolidation": schema.SingleNestedAttribute{
+												Description:         "Specifies the period to consolidate GPU nodes",
+												MarkdownDescription: "Node consolidation schedule for the GPU node pool.",
+												Optional:            true,
+												Computed:            false,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Whether the consolidation schedule is active.",
+														MarkdownDescription: "Whether the consolidation schedule is active.",
+														Required:            true,
+														Computed:            false,
+													},
+													"days": schema.ListAttribute{
+														Description:         "Days of the week when consolidation runs.",
+														MarkdownDescription: "Days of the week when consolidation runs.",
+														Required:            true,
+														Computed:            false,
+														ElementType:         types.StringType,
+													},
+													"start_time": schema.StringAttribute{
+														Description:         "Start time for the consolidation window in ISO-8601 time format.",
+														MarkdownDescription: "Start time in ISO-8601 format (`PThh:mm`).",
+														Required:            true,
+														Computed:            false,
+													},
+													"duration": schema.StringAttribute{
+														Description:         "Duration of the consolidation window in ISO-8601 duration format.",
+														MarkdownDescription: "Duration in ISO-8601 format (`PThhHmmM`).",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"limits": schema.SingleNestedAttribute{
+												Description:         "Specifies the limits to apply on the GPU node pool",
+												MarkdownDescription: "Resource limits for the GPU node pool.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Enabled the limit",
+														MarkdownDescription: "Whether resource limits are enforced.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_cpu_in_vcpu": schema.Int64Attribute{
+														Description:         "Maximum number of vCPU cores for the GPU node pool.",
+														MarkdownDescription: "Maximum total vCPU cores for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_memory_in_gibibytes": schema.Int64Attribute{
+														Description:         "Maximum memory in GiB for the GPU node pool.",
+														MarkdownDescription: "Maximum total memory in GiB for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_gpu": schema.Int64Attribute{
+														Description:         "Maximum number of GPUs for the GPU node pool.",
+														MarkdownDescription: "Maximum total number of GPUs for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"spot_enabled": schema.BoolAttribute{
+												Description:         "Enable spot instances for the GPU node pool",
+												MarkdownDescription: "Whether spot instances are used for the GPU node pool.",
+												Optional:            true,
+												Computed:            false,
+											},
+											"disk_size_in_gib": schema.Int64Attribute{
+												Description:         "Disk size in GiB for the GPU nodes.",
+												MarkdownDescription: "Root disk size in GiB for the GPU nodes.",
+												Optional:            true,
+												Computed:            false,
+											},
+											"disk_iops": schema.Int64Attribute{
+												Description:         "Disk IOPS for the GPU nodes.",
+												MarkdownDescription: "Root disk IOPS (operations per second) for the GPU nodes.",
+												Optional:            true,
+												Computed:            false,
+											},
+											"disk_throughput": schema.Int64Attribute{
+												Description:         "Disk throughput in MB/s for the GPU nodes.",
+												MarkdownDescription: "Root disk throughput in MB/s for the GPU nodes.",
+												Optional:            true,
+												Computed:            false,
+											},
+											"consolidate_after": schema.StringAttribute{
+												Description:         "Time to wait before consolidating empty or underutilized GPU nodes.",
+												MarkdownDescription: "Time to wait before consolidating empty or underutilized GPU nodes (e.g., `10m`).",
+												Optional:            true,
+												Computed:            false,
+											},
+										},
+									},
+									"cronjob_override": schema.SingleNestedAttribute{
+										Description:         "Defines some overridden options for Qovery cronjob node pool",
+										MarkdownDescription: "Override options of the cronjob node pool (consolidation, resource limits and consolidation delay).",
+										Optional:            true,
+										Computed:            false,
+										Attributes: map[string]schema.Attribute{
+											"consolidation": schema.SingleNestedAttribute{
+												Description:         "Specifies the period to consolidate cronjob nodes",
+												MarkdownDescription: "Node consolidation schedule for the cronjob node pool.",
+												Optional:            true,
+												Computed:            false,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Whether the consolidation schedule is active.",
+														MarkdownDescription: "Whether the consolidation schedule is active.",
+														Required:            true,
+														Computed:            false,
+													},
+													"days": schema.ListAttribute{
+														Description:         "Days of the week when consolidation runs.",
+														MarkdownDescription: "Days of the week when consolidation runs.",
+														Required:            true,
+														Computed:            false,
+														ElementType:         types.StringType,
+													},
+													"start_time": schema.StringAttribute{
+														Description:         "Start time for the consolidation window in ISO-8601 time format.",
+														MarkdownDescription: "Start time in ISO-8601 format (`PThh:mm`).",
+														Required:            true,
+														Computed:            false,
+													},
+													"duration": schema.StringAttribute{
+														Description:         "Duration of the consolidation window in ISO-8601 duration format.",
+														MarkdownDescription: "Duration in ISO-8601 format (`PThhHmmM`).",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"limits": schema.SingleNestedAttribute{
+												Description:         "Specifies the limits to apply on the cronjob node pool",
+												MarkdownDescription: "Resource limits for the cronjob node pool.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Enabled the limit",
+														MarkdownDescription: "Whether resource limits are enforced.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_cpu_in_vcpu": schema.Int64Attribute{
+														Description:         "Maximum number of vCPU cores for the cronjob node pool.",
+														MarkdownDescription: "Maximum total vCPU cores for the cronjob node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_memory_in_gibibytes": schema.Int64Attribute{
+														Description:         "Maximum memory in GiB for the cronjob node pool.",
+														MarkdownDescription: "Maximum total memory in GiB for the cronjob node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"consolidate_after": schema.StringAttribute{
+												Description:         "Time to wait before consolidating empty or underutilized cronjob nodes.",
+												MarkdownDescription: "Time to wait before consolidating empty or underutilized cronjob nodes (e.g., `10m`).",
+												Optional:            true,
+												Computed:            false,
+											},
+										},
+									},
 								},
 							},
 						},
@@ -844,7 +1058,7 @@ func (r clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 									Sensitive:   true,
 								},
 								"json_credentials": schema.StringAttribute{
-									Description: "GCP service account JSON credentials. Required when type is GCP_JSON_CREDENTIALS.",
+									Description: "Base64-encoded JSON key of a GCP service account, e.g. `base64encode(file(\"key.json\"))`. Required when type is GCP_JSON_CREDENTIALS.",
 									Optional:    true,
 									Sensitive:   true,
 								},
@@ -853,6 +1067,7 @@ func (r clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionDataSourceAttribute("cluster"),
 		},
 	}
 }
@@ -867,13 +1082,13 @@ func (d clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get cluster from the API
-	cluster, apiErr := d.client.GetCluster(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.AdvancedSettingsJson.ValueString(), true)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	c, err := d.clusterService.Get(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.AdvancedSettingsJson.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error on cluster read", err.Error())
 		return
 	}
 
-	state := convertResponseToCluster(ctx, cluster, data)
+	state := convertDomainClusterToCluster(ctx, c, data)
 	tflog.Trace(ctx, "read cluster", map[string]any{"cluster_id": state.Id.ValueString()})
 
 	// Set state
@@ -0,0 +1,186 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ datasource.DataSource = &clusterInstanceTypesDataSource{}
+
+type ClusterInstanceTypes struct {
+	CloudProvider types.String                `tfsdk:"cloud_provider"`
+	Region        types.String                `tfsdk:"region"`
+	Architecture  types.String                `tfsdk:"architecture"`
+	MinCPU        types.Int64                 `tfsdk:"min_cpu"`
+	MinRAMInGB    types.Int64                 `tfsdk:"min_ram_in_gb"`
+	InstanceTypes []ClusterInstanceTypesEntry `tfsdk:"instance_types"`
+}
+
+type ClusterInstanceTypesEntry struct {
+	Type         types.String `tfsdk:"type"`
+	CPU          types.Int64  `tfsdk:"cpu"`
+	RAMInGB      types.Int64  `tfsdk:"ram_in_gb"`
+	Architecture types.String `tfsdk:"architecture"`
+}
+
+// clusterInstanceTypesDataSource lists the instance types of the catalog embedded in the provider, so it needs no API call.
+type clusterInstanceTypesDataSource struct{}
+
+func newClusterInstanceTypesDataSource() datasource.DataSource {
+	return &clusterInstanceTypesDataSource{}
+}
+
+func (d clusterInstanceTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_instance_types"
+}
+
+func (d clusterInstanceTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Use this data source to list the instance types available for the nodes of a cluster, from the catalog embedded in the provider.",
+		MarkdownDescription: "Use this data source to list the instance types available for the nodes of a cluster, from the catalog embedded in the provider. The catalog is refreshed with each release of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: descriptions.NewStringEnumDescription(
+					"Cloud provider of the cluster.",
+					clusterInstanceTypesCloudProviders,
+					nil,
+				),
+				MarkdownDescription: descriptions.NewStringEnumDescription(
+					"Cloud provider of the cluster.",
+					clusterInstanceTypesCloudProviders,
+					nil,
+				),
+				Required: true,
+				Validators: []validator.String{
+					validators.NewStringEnumValidator(clusterInstanceTypesCloudProviders),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description:         "Region of the cluster (zone for Scaleway, e.g. `fr-par-1`).",
+				MarkdownDescription: "Region of the cluster (zone for Scaleway, e.g. `fr-par-1`).",
+				Required:            true,
+			},
+			"architecture": schema.StringAttribute{
+				Description: descriptions.NewStringEnumDescription(
+					"Only list the instance types of this architecture.",
+					clusterInstanceTypeArchitectures,
+					nil,
+				),
+				MarkdownDescription: descriptions.NewStringEnumDescription(
+					"Only list the instance types of this architecture.",
+					clusterInstanceTypeArchitectures,
+					nil,
+				),
+				Optional: true,
+				Validators: []validator.String{
+					validators.NewStringEnumValidator(clusterInstanceTypeArchitectures),
+				},
+			},
+			"min_cpu": schema.Int64Attribute{
+				Description:         "Only list the instance types with at least this number of vCPUs.",
+				MarkdownDescription: "Only list the instance types with at least this number of vCPUs.",
+				Optional:            true,
+			},
+			"min_ram_in_gb": schema.Int64Attribute{
+				Description:         "Only list the instance types with at least this memory in GB.",
+				MarkdownDescription: "Only list the instance types with at least this memory in GB.",
+				Optional:            true,
+			},
+			"instance_types": schema.ListNestedAttribute{
+				Description:         "Instance types matching the filters, sorted by type.",
+				MarkdownDescription: "Instance types matching the filters, sorted by type.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description:         "Instance type, to be used as the `instance_type` of the cluster.",
+							MarkdownDescription: "Instance type, to be used as the `instance_type` of the cluster.",
+							Computed:            true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description:         "Number of vCPUs of the instance type.",
+							MarkdownDescription: "Number of vCPUs of the instance type.",
+							Computed:            true,
+						},
+						"ram_in_gb": schema.Int64Attribute{
+							Description:         "Memory of the instance type in GB.",
+							MarkdownDescription: "Memory of the instance type in GB.",
+							Computed:            true,
+						},
+						"architecture": schema.StringAttribute{
+							Description:         "Architecture of the instance type.",
+							MarkdownDescription: "Architecture of the instance type.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read qovery cluster instance types data source
+func (d clusterInstanceTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var data ClusterInstanceTypes
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get instance types from the catalog
+	catalog, err := loadClusterInstanceTypesCatalog()
+	if err != nil {
+		resp.Diagnostics.AddError("Error on cluster instance types read", err.Error())
+		return
+	}
+
+	instanceTypes, ok := catalog.instanceTypes(data.CloudProvider.ValueString(), data.Region.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Error on cluster instance types read",
+			fmt.Sprintf(
+				"The region %q is not in the catalog of the %s instance types. Available regions: %s.",
+				data.Region.ValueString(), data.CloudProvider.ValueString(), strings.Join(catalog.regions(data.CloudProvider.ValueString()), ", "),
+			),
+		)
+		return
+	}
+
+	filtered := filterClusterInstanceTypes(instanceTypes, clusterInstanceTypesFilter{
+		Architecture: data.Architecture.ValueString(),
+		MinCPU:       data.MinCPU.ValueInt64(),
+		MinRAMInGB:   data.MinRAMInGB.ValueInt64(),
+	})
+
+	data.InstanceTypes = make([]ClusterInstanceTypesEntry, 0, len(filtered))
+	for _, instanceType := range filtered {
+		data.InstanceTypes = append(data.InstanceTypes, ClusterInstanceTypesEntry{
+			Type:         types.StringValue(instanceType.Type),
+			CPU:          types.Int64Value(instanceType.CPU),
+			RAMInGB:      types.Int64Value(instanceType.RAMInGB),
+			Architecture: types.StringValue(instanceType.Architecture),
+		})
+	}
+	tflog.Trace(ctx, "read cluster instance types", map[string]any{
+		"cloud_provider": data.CloudProvider.ValueString(),
+		"region":         data.Region.ValueString(),
+		"count":          len(data.InstanceTypes),
+	})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
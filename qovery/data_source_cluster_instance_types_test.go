@@ -0,0 +1,48 @@
+//go:build integration && !unit
+
+package qovery_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ClusterInstanceTypesDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "qovery_cluster_instance_types" "test" {
+  cloud_provider = "AWS"
+  region         = "eu-west-3"
+  architecture   = "ARM64"
+  min_ram_in_gb  = 8
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.qovery_cluster_instance_types.test", "instance_types.*", map[string]string{
+						"type":         "m6g.large",
+						"cpu":          "2",
+						"ram_in_gb":    "8",
+						"architecture": "ARM64",
+					}),
+				),
+			},
+			{
+				Config: `
+data "qovery_cluster_instance_types" "test" {
+  cloud_provider = "AWS"
+  region         = "unknown-region"
+}
+`,
+				ExpectError: regexp.MustCompile(`is not in the catalog`),
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package qovery
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ datasource.DataSource = &clusterKubernetesVersionsDataSource{}
+
+// clusterKubernetesVersionsCloudProviders are the cloud providers whose kubernetes versions are managed by Qovery.
+var clusterKubernetesVersionsCloudProviders = []string{"AWS", "AZURE", "GCP", "SCW"}
+
+type ClusterKubernetesVersions struct {
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	Versions      []types.String `tfsdk:"versions"`
+	LatestVersion types.String   `tfsdk:"latest_version"`
+}
+
+// clusterKubernetesVersionsDataSource lists the kubernetes versions supported by the provider, so it needs no API call.
+type clusterKubernetesVersionsDataSource struct{}
+
+func newClusterKubernetesVersionsDataSource() datasource.DataSource {
+	return &clusterKubernetesVersionsDataSource{}
+}
+
+func (d clusterKubernetesVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_kubernetes_versions"
+}
+
+func (d clusterKubernetesVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Use this data source to list the kubernetes versions supported for the clusters of a cloud provider, from a static snapshot embedded in the provider.",
+		MarkdownDescription: "Use this data source to list the kubernetes versions supported for the clusters of a cloud provider, to be used as the `kubernetes_version` of a `qovery_cluster`. The list is a static snapshot embedded in the provider and refreshed with its releases: it is not read from the Qovery API and may lag behind the versions Qovery supports.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: descriptions.NewStringEnumDescription(
+					"Cloud provider of the cluster.",
+					clusterKubernetesVersionsCloudProviders,
+					nil,
+				),
+				MarkdownDescription: descriptions.NewStringEnumDescription(
+					"Cloud provider of the cluster.",
+					clusterKubernetesVersionsCloudProviders,
+					nil,
+				),
+				Required: true,
+				Validators: []validator.String{
+					validators.NewStringEnumValidator(clusterKubernetesVersionsCloudProviders),
+				},
+			},
+			"versions": schema.ListAttribute{
+				Description:         "Supported kubernetes versions, from the oldest to the newest.",
+				MarkdownDescription: "Supported kubernetes versions, from the oldest to the newest.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description:         "Newest supported kubernetes version.",
+				MarkdownDescription: "Newest supported kubernetes version.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read qovery cluster kubernetes versions data source
+func (d clusterKubernetesVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var data ClusterKubernetesVersions
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = convertKubernetesVersionsToTerraform(data.CloudProvider.ValueString(), cluster.SupportedKubernetesVersions[cluster.CloudProvider(data.CloudProvider.ValueString())])
+	tflog.Trace(ctx, "read cluster kubernetes versions", map[string]any{
+		"cloud_provider": data.CloudProvider.ValueString(),
+		"count":          len(data.Versions),
+	})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func convertKubernetesVersionsToTerraform(cloudProvider string, versions []string) ClusterKubernetesVersions {
+	data := ClusterKubernetesVersions{
+		CloudProvider: types.StringValue(cloudProvider),
+		Versions:      make([]types.String, 0, len(versions)),
+		LatestVersion: types.StringNull(),
+	}
+	for _, version := range versions {
+		data.Versions = append(data.Versions, types.StringValue(version))
+	}
+	if len(versions) > 0 {
+		data.LatestVersion = types.StringValue(versions[len(versions)-1])
+	}
+	return data
+}
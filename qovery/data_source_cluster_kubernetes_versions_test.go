@@ -0,0 +1,31 @@
+//go:build integration && !unit
+
+package qovery_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ClusterKubernetesVersionsDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "qovery_cluster_kubernetes_versions" "test" {
+  cloud_provider = "AWS"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.qovery_cluster_kubernetes_versions.test", "latest_version"),
+					resource.TestCheckTypeSetElemAttrPair("data.qovery_cluster_kubernetes_versions.test", "versions.*", "data.qovery_cluster_kubernetes_versions.test", "latest_version"),
+				),
+			},
+		},
+	})
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 )
 
@@ -18,7 +18,7 @@ import (
 var _ datasource.DataSource = databaseDataSource{}
 
 type databaseDataSource struct {
-	client *client.Client
+	databaseService database.Service
 }
 
 func newDatabaseDataSource() datasource.DataSource {
@@ -44,7 +44,7 @@ func (d *databaseDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = provider.client
+	d.databaseService = provider.databaseService
 }
 
 func (d databaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -211,6 +211,7 @@ func (d databaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"deletion_protection": deletionProtectionDataSourceAttribute("database"),
 		},
 	}
 }
@@ -225,12 +226,13 @@ func (d databaseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Get database from API
-	database, apiErr := d.client.GetDatabase(ctx, data.Id.ValueString())
-	if apiErr != nil {
+	db, err := d.databaseService.Get(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error on database read", err.Error())
 		return
 	}
 
-	state := convertResponseToDatabase(ctx, data, database)
+	state := convertDomainDatabaseToDatabase(ctx, data, db)
 	tflog.Trace(ctx, "read database", map[string]any{"database_id": state.Id.ValueString()})
 
 	// Set state
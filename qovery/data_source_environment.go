@@ -397,6 +397,7 @@ func (r environmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionDataSourceAttribute("environment"),
 		},
 	}
 }
@@ -289,6 +289,7 @@ func (r projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionDataSourceAttribute("project"),
 		},
 	}
 }
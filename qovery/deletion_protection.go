@@ -0,0 +1,55 @@
+package qovery
+
+import (
+	"fmt"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionResourceAttribute returns the `deletion_protection` attribute of the resource with the given name.
+// The Qovery API has no deletion protection: it is only held by the Terraform state of the resource,
+// and checked by its Delete before calling the API.
+func deletionProtectionResourceAttribute(resourceName string) schema.BoolAttribute {
+	description := fmt.Sprintf("Prevents the %s from being deleted by Terraform. When enabled, any apply destroying or replacing the %s fails: the protection must first be disabled in a separate apply. Default: `false`.", resourceName, resourceName)
+	return schema.BoolAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// deletionProtectionDataSourceAttribute returns the `deletion_protection` attribute of the data source of the resource with the given name.
+func deletionProtectionDataSourceAttribute(resourceName string) datasourceschema.BoolAttribute {
+	description := fmt.Sprintf("Deletion protection is only held by the `qovery_%s` resource, it is always `false` for the data source.", resourceName)
+	return datasourceschema.BoolAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Computed:            true,
+	}
+}
+
+// deletionProtectionFromState returns the deletion protection of the given state, which is null for imported
+// resources and data sources.
+func deletionProtectionFromState(deletionProtection types.Bool) types.Bool {
+	return types.BoolValue(deletionProtection.ValueBool())
+}
+
+// checkDeletionProtection adds an error to diags if the deletion protection of the resource with the given name is
+// enabled. It reports whether the deletion must be aborted.
+func checkDeletionProtection(diags *diag.Diagnostics, deletionProtection types.Bool, resourceName string, resourceID string) bool {
+	if !deletionProtection.ValueBool() {
+		return false
+	}
+
+	diags.AddError(
+		fmt.Sprintf("Error on %s delete", resourceName),
+		fmt.Sprintf("The %s %s cannot be deleted because `deletion_protection` is enabled. Set `deletion_protection = false` and apply before deleting it.", resourceName, resourceID),
+	)
+	return true
+}
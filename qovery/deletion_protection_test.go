@@ -0,0 +1,57 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName           string
+		DeletionProtection types.Bool
+		ExpectedAbort      bool
+	}{
+		{
+			TestName:           "protection_enabled",
+			DeletionProtection: types.BoolValue(true),
+			ExpectedAbort:      true,
+		},
+		{
+			TestName:           "protection_disabled",
+			DeletionProtection: types.BoolValue(false),
+		},
+		{
+			TestName:           "protection_null_for_states_saved_before_the_attribute_existed",
+			DeletionProtection: types.BoolNull(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			abort := checkDeletionProtection(&diags, tc.DeletionProtection, "database", "some-id")
+			assert.Equal(t, tc.ExpectedAbort, abort)
+			assert.Equal(t, tc.ExpectedAbort, diags.HasError())
+			if tc.ExpectedAbort {
+				assert.Contains(t, diags[0].Detail(), "deletion_protection")
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionFromState(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, types.BoolValue(false), deletionProtectionFromState(types.BoolNull()))
+	assert.Equal(t, types.BoolValue(true), deletionProtectionFromState(types.BoolValue(true)))
+}
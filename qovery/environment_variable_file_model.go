@@ -6,9 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
@@ -89,36 +87,6 @@ func (vars EnvironmentVariableFileList) diffRequest(old EnvironmentVariableFileL
 	return diff
 }
 
-func (vars EnvironmentVariableFileList) diff(old EnvironmentVariableFileList) client.EnvironmentVariablesDiff {
-	diff := client.EnvironmentVariablesDiff{
-		Create: []client.EnvironmentVariableCreateRequest{},
-		Update: []client.EnvironmentVariableUpdateRequest{},
-		Delete: []client.EnvironmentVariableDeleteRequest{},
-	}
-
-	for _, e := range old {
-		if updatedVar := vars.find(ToString(e.Key)); updatedVar != nil {
-			if updatedVar.MountPath != e.MountPath {
-				// mount_path changed — delete + recreate
-				diff.Delete = append(diff.Delete, client.EnvironmentVariableDeleteRequest{Id: ToString(e.Id)})
-				diff.Create = append(diff.Create, updatedVar.toCreateRequest())
-			} else if updatedVar.Value != e.Value || updatedVar.Description != e.Description {
-				diff.Update = append(diff.Update, e.toUpdateRequest(*updatedVar))
-			}
-		} else {
-			diff.Delete = append(diff.Delete, client.EnvironmentVariableDeleteRequest{Id: ToString(e.Id)})
-		}
-	}
-
-	for _, e := range vars {
-		if !old.contains(e) {
-			diff.Create = append(diff.Create, e.toCreateRequest())
-		}
-	}
-
-	return diff
-}
-
 type EnvironmentVariableFile struct {
 	Id          types.String `tfsdk:"id"`
 	Key         types.String `tfsdk:"key"`
@@ -142,21 +110,6 @@ func (e EnvironmentVariableFile) toTerraformObject() types.Object {
 	return terraformObjectValue
 }
 
-func (e EnvironmentVariableFile) toCreateRequest() client.EnvironmentVariableCreateRequest {
-	req := client.EnvironmentVariableCreateRequest{
-		EnvironmentVariableRequest: qovery.EnvironmentVariableRequest{
-			Key:         ToString(e.Key),
-			Value:       ToStringPointer(e.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(e.Description)),
-		},
-	}
-	mountPath := ToString(e.MountPath)
-	if mountPath != "" {
-		req.EnvironmentVariableRequest.MountPath = *qovery.NewNullableString(&mountPath)
-	}
-	return req
-}
-
 func (e EnvironmentVariableFile) toDiffCreateRequest() variable.DiffCreateRequest {
 	return variable.DiffCreateRequest{
 		UpsertRequest: variable.UpsertRequest{
@@ -168,22 +121,6 @@ func (e EnvironmentVariableFile) toDiffCreateRequest() variable.DiffCreateReques
 	}
 }
 
-func (e EnvironmentVariableFile) toUpdateRequest(new EnvironmentVariableFile) client.EnvironmentVariableUpdateRequest {
-	req := client.EnvironmentVariableUpdateRequest{
-		Id: ToString(e.Id),
-		EnvironmentVariableEditRequest: qovery.EnvironmentVariableEditRequest{
-			Key:         ToString(e.Key),
-			Value:       ToStringPointer(new.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(new.Description)),
-		},
-	}
-	mountPath := ToString(e.MountPath)
-	if mountPath != "" {
-		req.EnvironmentVariableEditRequest.MountPath = *qovery.NewNullableString(&mountPath)
-	}
-	return req
-}
-
 func (e EnvironmentVariableFile) toDiffUpdateRequest(new EnvironmentVariableFile) variable.DiffUpdateRequest {
 	return variable.DiffUpdateRequest{
 		VariableID: ToString(e.Id),
@@ -199,6 +136,7 @@ func (e EnvironmentVariableFile) toDiffUpdateRequest(new EnvironmentVariableFile
 func (e EnvironmentVariableFile) toDiffDeleteRequest() variable.DiffDeleteRequest {
 	return variable.DiffDeleteRequest{
 		VariableID: ToString(e.Id),
+		Key:        ToString(e.Key),
 	}
 }
 
@@ -268,37 +206,3 @@ func convertDomainVariableToEnvironmentVariableFile(v variable.Variable, variabl
 		Description: description,
 	}
 }
-
-func fromEnvironmentVariableFileList(ctx context.Context, initialState types.Set, vars []*qovery.EnvironmentVariable, scope qovery.APIVariableScopeEnum, variableType string) EnvironmentVariableFileList {
-	variableMap := buildVariableFileMap(ctx, initialState)
-
-	list := make([]EnvironmentVariableFile, 0, len(vars))
-	for _, v := range vars {
-		if v.Scope != scope || string(v.VariableType) != variableType {
-			continue
-		}
-		currentVariable := variableMap[v.Key]
-		description := FromNullableString(v.Description)
-		if currentVariable.Description.IsNull() && !initialState.IsNull() {
-			description = basetypes.NewStringNull()
-		}
-		mountPath := ""
-		if v.MountPath.IsSet() && v.MountPath.Get() != nil {
-			mountPath = *v.MountPath.Get()
-		}
-		list = append(list, EnvironmentVariableFile{
-			Id:          FromString(v.Id),
-			Key:         FromString(v.Key),
-			Value:       FromStringPointer(v.Value),
-			MountPath:   FromString(mountPath),
-			Description: description,
-		})
-	}
-
-	// Return nil only if list is empty and original state is nil
-	if len(list) == 0 && initialState.IsNull() {
-		return nil
-	}
-	// Otherwise return the list, even empty (`[]` in the terraform file)
-	return list
-}
@@ -7,9 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
@@ -110,32 +108,6 @@ func (vars EnvironmentVariableList) diffRequest(old EnvironmentVariableList) var
 	return diff
 }
 
-func (vars EnvironmentVariableList) diff(old EnvironmentVariableList) client.EnvironmentVariablesDiff {
-	diff := client.EnvironmentVariablesDiff{
-		Create: []client.EnvironmentVariableCreateRequest{},
-		Update: []client.EnvironmentVariableUpdateRequest{},
-		Delete: []client.EnvironmentVariableDeleteRequest{},
-	}
-
-	for _, e := range old {
-		if updatedVar := vars.find(ToString(e.Key)); updatedVar != nil {
-			if updatedVar.Value != e.Value || updatedVar.Description != e.Description {
-				diff.Update = append(diff.Update, e.toUpdateRequest(*updatedVar))
-			}
-		} else {
-			diff.Delete = append(diff.Delete, e.toDeleteRequest())
-		}
-	}
-
-	for _, e := range vars {
-		if !old.contains(e) {
-			diff.Create = append(diff.Create, e.toCreateRequest())
-		}
-	}
-
-	return diff
-}
-
 type EnvironmentVariable struct {
 	Id          types.String `tfsdk:"id"`
 	Key         types.String `tfsdk:"key"`
@@ -157,16 +129,6 @@ func (e EnvironmentVariable) toTerraformObject() types.Object {
 	return terraformObjectValue
 }
 
-func (e EnvironmentVariable) toCreateRequest() client.EnvironmentVariableCreateRequest {
-	return client.EnvironmentVariableCreateRequest{
-		EnvironmentVariableRequest: qovery.EnvironmentVariableRequest{
-			Key:         ToString(e.Key),
-			Value:       ToStringPointer(e.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(e.Description)),
-		},
-	}
-}
-
 func (e EnvironmentVariable) toDiffCreateRequest() variable.DiffCreateRequest {
 	return variable.DiffCreateRequest{
 		UpsertRequest: variable.UpsertRequest{
@@ -177,17 +139,6 @@ func (e EnvironmentVariable) toDiffCreateRequest() variable.DiffCreateRequest {
 	}
 }
 
-func (e EnvironmentVariable) toUpdateRequest(new EnvironmentVariable) client.EnvironmentVariableUpdateRequest {
-	return client.EnvironmentVariableUpdateRequest{
-		Id: ToString(e.Id),
-		EnvironmentVariableEditRequest: qovery.EnvironmentVariableEditRequest{
-			Key:         ToString(e.Key),
-			Value:       ToStringPointer(new.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(new.Description)),
-		},
-	}
-}
-
 func (e EnvironmentVariable) toDiffUpdateRequest(new EnvironmentVariable) variable.DiffUpdateRequest {
 	return variable.DiffUpdateRequest{
 		VariableID: ToString(e.Id),
@@ -199,65 +150,13 @@ func (e EnvironmentVariable) toDiffUpdateRequest(new EnvironmentVariable) variab
 	}
 }
 
-func (e EnvironmentVariable) toDeleteRequest() client.EnvironmentVariableDeleteRequest {
-	return client.EnvironmentVariableDeleteRequest{
-		Id: ToString(e.Id),
-	}
-}
-
 func (e EnvironmentVariable) toDiffDeleteRequest() variable.DiffDeleteRequest {
 	return variable.DiffDeleteRequest{
 		VariableID: ToString(e.Id),
+		Key:        ToString(e.Key),
 	}
 }
 
-func fromEnvironmentVariable(v *qovery.EnvironmentVariable, currentVariable *EnvironmentVariable) EnvironmentVariable {
-	description := FromNullableString(v.Description)
-	if currentVariable != nil && currentVariable.Description.IsNull() {
-		description = basetypes.NewStringNull()
-	}
-	return EnvironmentVariable{
-		Id:          FromString(v.Id),
-		Key:         FromString(v.Key),
-		Value:       FromStringPointer(v.Value),
-		Description: description,
-	}
-}
-
-func fromEnvironmentVariableList(vars []*qovery.EnvironmentVariable, scope qovery.APIVariableScopeEnum, variableType string) EnvironmentVariableList {
-	list := make([]EnvironmentVariable, 0, len(vars))
-	for _, v := range vars {
-		if v.Scope != scope || string(v.VariableType) != variableType {
-			continue
-		}
-		list = append(list, fromEnvironmentVariable(v, nil))
-	}
-
-	if len(list) == 0 {
-		return nil
-	}
-	return list
-}
-
-func fromEnvironmentVariableListWithNullableInitialState(ctx context.Context, initialState types.Set, vars []*qovery.EnvironmentVariable, scope qovery.APIVariableScopeEnum, variableType string) EnvironmentVariableList {
-	list := make([]EnvironmentVariable, 0, len(vars))
-	variableMap := buildVariableMap(ctx, initialState)
-	for _, v := range vars {
-		if v.Scope != scope || string(v.VariableType) != variableType {
-			continue
-		}
-		currentVariable := variableMap[v.Key]
-		list = append(list, fromEnvironmentVariable(v, &currentVariable))
-	}
-
-	// Return nil only if list is empty and original state is nil
-	if len(list) == 0 && initialState.IsNull() {
-		return nil
-	}
-	// Otherwise return the list, even empty (`[]` in the terraform file)
-	return list
-}
-
 func toEnvironmentVariable(v types.Object) EnvironmentVariable {
 	return EnvironmentVariable{
 		Id:          v.Attributes()["id"].(types.String),
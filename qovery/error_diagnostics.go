@@ -0,0 +1,215 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	domainapierrors "github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+// schemaTyper is implemented by the schema of a plan, a state or a config (e.g. req.Plan.Schema).
+// It is used to map the fields reported by the api to the attribute paths of the resource.
+type schemaTyper interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// attributeErrorMapping attaches a domain error to the attribute it originates from.
+type attributeErrorMapping struct {
+	// err is the domain error, matched anywhere in the error chain.
+	err error
+	// path is the attribute the error is attached to.
+	path path.Path
+	// hint is an optional remediation hint appended to the diagnostic detail.
+	hint string
+}
+
+// attributeHints are remediation hints appended to the diagnostics of api field errors, keyed by attribute name.
+var attributeHints = map[string]string{
+	"external_port": "External ports must be between 1 and 65535 and unique among the publicly accessible ports of the service.",
+	"internal_port": "Internal ports must be between 1 and 65535 and declared only once per service.",
+	"schedule":      "Use a standard cron expression with 5 fields (minute, hour, day of month, month, day of week), e.g. `0 */6 * * *`.",
+	"timezone":      "Use an IANA time zone name, e.g. `Europe/Paris` or `Etc/UTC`.",
+	"instance_type": "Check that the instance type is available for the cloud provider and region of the cluster.",
+	"cpu":           "CPU is expressed in millicores, e.g. `500` for half a core.",
+	"memory":        "Memory is expressed in MB, e.g. `512`.",
+}
+
+// fieldIndexRegexp matches the list indexes of a dotted field path, e.g. `[1]` in `ports[1].external_port`.
+var fieldIndexRegexp = regexp.MustCompile(`\[(\d+)]`)
+
+// camelCaseRegexp matches the boundaries of camelCase field names.
+var camelCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// addErrorDiagnostics appends err to diags. Known domain errors and api field-level validation errors
+// are attached to the attribute they relate to with a remediation hint, any other error is added as a
+// generic error under the given summary.
+func addErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, schema schemaTyper, summary string, err error, mappings []attributeErrorMapping) {
+	if err == nil {
+		return
+	}
+
+	if mapping, ok := findAttributeErrorMapping(err, mappings); ok {
+		diags.AddAttributeError(mapping.path, summary, withHint(err.Error(), mapping.hint))
+		return
+	}
+
+	if apiErr := domainapierrors.NewAPIErrorFromError(err); apiErr != nil {
+		if addFieldErrorDiagnostics(ctx, diags, schema, summary, apiErr.FieldErrors()) {
+			return
+		}
+	}
+
+	diags.AddError(summary, err.Error())
+}
+
+// addAPIErrorDiagnostics is the client-layer twin of addErrorDiagnostics, for resources calling the legacy client.
+func addAPIErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, schema schemaTyper, apiErr *apierrors.APIError) {
+	if apiErr == nil {
+		return
+	}
+
+	if addFieldErrorDiagnostics(ctx, diags, schema, apiErr.Summary(), apiErr.FieldErrors()) {
+		return
+	}
+
+	diags.AddError(apiErr.Summary(), apiErr.Detail())
+}
+
+// addFieldErrorDiagnostics adds an attribute error for each field error that can be mapped to an attribute of the schema.
+// Field errors that cannot be mapped are added as generic errors. It reports whether any diagnostic was added.
+func addFieldErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, schema schemaTyper, summary string, fieldErrors []domainapierrors.FieldError) bool {
+	for _, fieldError := range fieldErrors {
+		detail := fieldError.Message
+		if detail == "" {
+			detail = "The value is invalid."
+		}
+
+		attributePath, ok := attributePathFromField(ctx, schema, fieldError.Field)
+		if !ok {
+			diags.AddError(summary, fmt.Sprintf("%s: %s", fieldError.Field, detail))
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, summary, withHint(detail, attributeHints[lastStepName(attributePath)]))
+	}
+
+	return len(fieldErrors) > 0
+}
+
+func findAttributeErrorMapping(err error, mappings []attributeErrorMapping) (attributeErrorMapping, bool) {
+	for _, mapping := range mappings {
+		// Domain errors are wrapped with their message rather than their identity (errors.Wrap(err, ErrX.Error())),
+		// so the chain message is also checked.
+		if errors.Is(err, mapping.err) || containsErrorMessage(err.Error(), mapping.err.Error()) {
+			return mapping, true
+		}
+	}
+
+	return attributeErrorMapping{}, false
+}
+
+func containsErrorMessage(message string, target string) bool {
+	return message == target ||
+		strings.HasPrefix(message, target+": ") ||
+		strings.Contains(message, ": "+target+": ") ||
+		strings.HasSuffix(message, ": "+target)
+}
+
+// attributePathFromField converts a field of the api payload to the deepest matching attribute path of the schema.
+// List indexes are kept for list attributes, the path stops at set attributes since their elements cannot be addressed by index.
+func attributePathFromField(ctx context.Context, schema schemaTyper, field string) (path.Path, bool) {
+	if schema == nil {
+		return path.Empty(), false
+	}
+
+	attributePath := path.Empty()
+	for _, step := range splitField(field) {
+		parentType, diags := schema.TypeAtPath(ctx, attributePath)
+		if diags.HasError() {
+			break
+		}
+
+		next, ok := nextPath(attributePath, parentType, step)
+		if !ok {
+			break
+		}
+		attributePath = next
+	}
+
+	return attributePath, len(attributePath.Steps()) > 0
+}
+
+func nextPath(parent path.Path, parentType attr.Type, step string) (path.Path, bool) {
+	switch t := parentType.(type) {
+	case types.ObjectType:
+		name := toSnakeCase(step)
+		if _, ok := t.AttrTypes[name]; !ok {
+			return parent, false
+		}
+		return parent.AtName(name), true
+	case types.ListType:
+		index, err := strconv.Atoi(step)
+		if err != nil || index < 0 {
+			return parent, false
+		}
+		return parent.AtListIndex(index), true
+	case types.MapType:
+		return parent.AtMapKey(step), true
+	default:
+		return parent, false
+	}
+}
+
+// splitField splits a field path in its steps. Dotted paths (`ports[1].external_port`, `ports.1.external_port`)
+// and JSON pointers (`/ports/1/external_port`) are supported.
+func splitField(field string) []string {
+	field = strings.TrimPrefix(field, "#")
+	field = fieldIndexRegexp.ReplaceAllString(field, ".$1")
+	separator := "."
+	if strings.HasPrefix(field, "/") {
+		separator = "/"
+	}
+
+	steps := make([]string, 0)
+	for _, step := range strings.Split(field, separator) {
+		if step == "" || step == "$" || step == "body" {
+			continue
+		}
+		steps = append(steps, step)
+	}
+
+	return steps
+}
+
+func toSnakeCase(name string) string {
+	return strings.ToLower(camelCaseRegexp.ReplaceAllString(name, "${1}_${2}"))
+}
+
+func lastStepName(p path.Path) string {
+	steps := p.Steps()
+	for i := len(steps) - 1; i >= 0; i-- {
+		if name, ok := steps[i].(path.PathStepAttributeName); ok {
+			return string(name)
+		}
+	}
+
+	return ""
+}
+
+func withHint(detail string, hint string) string {
+	if hint == "" {
+		return detail
+	}
+
+	return fmt.Sprintf("%s\n\nHint: %s", detail, hint)
+}
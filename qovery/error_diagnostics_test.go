@@ -0,0 +1,179 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	domainapierrors "github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
+)
+
+func resourceSchema(r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func TestAttributePathFromField(t *testing.T) {
+	t.Parallel()
+
+	schema := resourceSchema(containerResource{})
+
+	testCases := []struct {
+		TestName     string
+		Field        string
+		ExpectedPath path.Path
+		ExpectedOK   bool
+	}{
+		{
+			TestName:     "root_attribute",
+			Field:        "image_name",
+			ExpectedPath: path.Root("image_name"),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "camel_case_attribute",
+			Field:        "imageName",
+			ExpectedPath: path.Root("image_name"),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "list_element_attribute",
+			Field:        "ports[1].external_port",
+			ExpectedPath: path.Root("ports").AtListIndex(1).AtName("external_port"),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "json_pointer",
+			Field:        "/ports/0/internal_port",
+			ExpectedPath: path.Root("ports").AtListIndex(0).AtName("internal_port"),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "set_attribute_stops_at_set",
+			Field:        "storage[0].size",
+			ExpectedPath: path.Root("storage"),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "unknown_nested_attribute_stops_at_parent",
+			Field:        "ports[1].unknown",
+			ExpectedPath: path.Root("ports").AtListIndex(1),
+			ExpectedOK:   true,
+		},
+		{
+			TestName:     "unknown_attribute",
+			Field:        "unknown",
+			ExpectedPath: path.Empty(),
+			ExpectedOK:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			attributePath, ok := attributePathFromField(context.Background(), schema, tc.Field)
+			assert.Equal(t, tc.ExpectedOK, ok)
+			assert.True(t, tc.ExpectedPath.Equal(attributePath), "expected %s, got %s", tc.ExpectedPath, attributePath)
+		})
+	}
+}
+
+func TestAddErrorDiagnostics_DomainError(t *testing.T) {
+	t.Parallel()
+
+	err := pkgerrors.Wrap(
+		pkgerrors.Wrap(pkgerrors.New("expected exactly 5 fields"), job.ErrInvalidJobScheduleCronScheduleParam.Error()),
+		job.ErrInvalidJobScheduleCronParam.Error(),
+	)
+
+	var diags diag.Diagnostics
+	addErrorDiagnostics(context.Background(), &diags, resourceSchema(jobResource{}), "Error on job create", err, jobErrorMappings)
+
+	require.Len(t, diags, 1)
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.True(t, path.Root("schedule").AtName("cronjob").AtName("schedule").Equal(withPath.Path()))
+	assert.Equal(t, "Error on job create", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "expected exactly 5 fields")
+	assert.Contains(t, diags[0].Detail(), "Hint:")
+}
+
+func TestAddErrorDiagnostics_APIFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	apiErr := domainapierrors.NewUpdateAPIError(
+		domainapierrors.APIResourceContainer,
+		"some-id",
+		&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"field":"ports[1].external_port","message":"port 80 is already used"},{"field":"unknown","message":"boom"}]}`)),
+		},
+		pkgerrors.New("400 Bad Request"),
+	)
+	err := pkgerrors.Wrap(apiErr, "failed to update container")
+
+	var diags diag.Diagnostics
+	addErrorDiagnostics(context.Background(), &diags, resourceSchema(containerResource{}), "Error on container update", err, containerErrorMappings)
+
+	require.Len(t, diags, 2)
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.True(t, path.Root("ports").AtListIndex(1).AtName("external_port").Equal(withPath.Path()))
+	assert.Contains(t, diags[0].Detail(), "port 80 is already used")
+	assert.Contains(t, diags[0].Detail(), attributeHints["external_port"])
+	_, ok = diags[1].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+	assert.Equal(t, "unknown: boom", diags[1].Detail())
+}
+
+func TestAddErrorDiagnostics_GenericError(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	addErrorDiagnostics(context.Background(), &diags, resourceSchema(containerResource{}), "Error on container create", pkgerrors.New("boom"), containerErrorMappings)
+
+	require.Len(t, diags, 1)
+	_, ok := diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+	assert.Equal(t, "boom", diags[0].Detail())
+}
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	apiErr := apierrors.NewUpdateError(
+		apierrors.APIResourceCluster,
+		"some-id",
+		&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"field":"imageName","message":"unknown image"}]}`)),
+		},
+		pkgerrors.New("400 Bad Request"),
+	)
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostics(context.Background(), &diags, resourceSchema(containerResource{}), apiErr)
+
+	require.Len(t, diags, 1)
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.True(t, path.Root("image_name").Equal(withPath.Path()))
+	assert.Equal(t, apiErr.Summary(), diags[0].Summary())
+}
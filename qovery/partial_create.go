@@ -0,0 +1,36 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+// savePartiallyCreatedState saves into the state the resource held by err when it is a *common.PartialCreateError,
+// i.e. when the resource was created but one of the later steps of its creation failed.
+// As Create returns an error, Terraform marks the resource as tainted: the next apply replaces it instead of trying
+// to create it again, which would conflict with the existing one.
+func savePartiallyCreatedState[T any, S any](ctx context.Context, resp *resource.CreateResponse, err error, resourceName string, toState func(created *T) S) {
+	var partial *common.PartialCreateError[T]
+	if !errors.As(err, &partial) {
+		return
+	}
+
+	state := toState(partial.Resource)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("saved partially created %s", resourceName), map[string]any{"error": partial.Err.Error()})
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("Partially created %s", resourceName),
+		fmt.Sprintf("The %s has been created but its creation did not complete. It has been saved into the state and will be replaced on the next apply, unless it is untainted once the error is fixed.", resourceName),
+	)
+}
@@ -0,0 +1,72 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+)
+
+type testPartialResource struct {
+	ID string
+}
+
+type testPartialState struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// newTestCreateResponse builds a CreateResponse whose state is null, as it is before a resource is created.
+func newTestCreateResponse() *resource.CreateResponse {
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	return &resource.CreateResponse{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(objType, nil),
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	}
+}
+
+func TestSavePartiallyCreatedState(t *testing.T) {
+	t.Parallel()
+
+	toState := func(created *testPartialResource) testPartialState {
+		return testPartialState{Id: types.StringValue(created.ID)}
+	}
+
+	t.Run("error without partially created resource leaves the state null", func(t *testing.T) {
+		t.Parallel()
+		resp := newTestCreateResponse()
+		savePartiallyCreatedState(context.Background(), resp, errors.New("boom"), "test", toState)
+		assert.True(t, resp.State.Raw.IsNull())
+		assert.Empty(t, resp.Diagnostics)
+	})
+
+	t.Run("partially created resource is saved into the state", func(t *testing.T) {
+		t.Parallel()
+		resp := newTestCreateResponse()
+		err := common.NewPartialCreateError(&testPartialResource{ID: "some-id"}, errors.New("boom"))
+		savePartiallyCreatedState(context.Background(), resp, err, "test", toState)
+
+		var state testPartialState
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+		assert.Equal(t, "some-id", state.Id.ValueString())
+		assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+		assert.False(t, resp.Diagnostics.HasError())
+	})
+}
@@ -0,0 +1,104 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// savePartiallyUpdatedState saves into the state the resource held by err when it is a *common.PartialUpdateError,
+// i.e. when a variable or secret diff of the update was only partially applied. toState receives the report of the
+// keys applied before the failure, nil if there is none, so that the next plan only retries the changes that were
+// not applied.
+func savePartiallyUpdatedState[T any, S any](ctx context.Context, resp *resource.UpdateResponse, err error, resourceName string, toState func(updated *T, report *variable.DiffApplyError) S) {
+	var partial *common.PartialUpdateError[T]
+	if !errors.As(err, &partial) {
+		return
+	}
+
+	var report *variable.DiffApplyError
+	_ = errors.As(partial.Err, &report)
+
+	state := toState(partial.Resource, report)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("saved partially updated %s", resourceName), map[string]any{"error": partial.Err.Error(), "applied_keys": appliedKeys(report)})
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("Partially updated %s", resourceName),
+		fmt.Sprintf("The %s has been partially updated. The changes applied before the failure have been saved into the state, the other ones will be retried on the next apply.", resourceName),
+	)
+}
+
+// appliedKeys returns the keys applied by a partially applied update, with their kind, for logging purposes.
+func appliedKeys(report *variable.DiffApplyError) []string {
+	if report == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(report.Applied))
+	for _, a := range report.Applied {
+		keys = append(keys, a.String())
+	}
+	return keys
+}
+
+// appliedSecrets returns the secrets of plan whose key was applied by a partially applied diff, and the secrets of
+// state for the other keys. The value of a secret cannot be read from the API: the value of a key that failed, or was
+// left unapplied, must remain the one known before the update. applied must only hold the keys of the diff of the
+// given set, as the same key can be both a secret and an alias or override. It works on the sets of secrets, aliases,
+// overrides and secret files.
+func appliedSecrets(ctx context.Context, plan types.Set, state types.Set, applied []string) types.Set {
+	if plan.IsUnknown() {
+		return state
+	}
+
+	appliedKeys := make(map[string]struct{}, len(applied))
+	for _, key := range applied {
+		appliedKeys[key] = struct{}{}
+	}
+
+	stateByKey := make(map[string]attr.Value, len(state.Elements()))
+	for _, element := range state.Elements() {
+		stateByKey[secretElementKey(element)] = element
+	}
+
+	elements := make([]attr.Value, 0, len(plan.Elements()))
+	for _, element := range plan.Elements() {
+		key := secretElementKey(element)
+		if _, ok := appliedKeys[key]; ok {
+			elements = append(elements, element)
+		} else if stateElement, ok := stateByKey[key]; ok {
+			elements = append(elements, stateElement)
+		}
+		delete(stateByKey, key)
+	}
+	// The secrets removed from the plan whose deletion failed, or was left unapplied, still exist.
+	for key, element := range stateByKey {
+		if _, ok := appliedKeys[key]; !ok {
+			elements = append(elements, element)
+		}
+	}
+
+	if len(elements) == 0 && plan.IsNull() {
+		return plan
+	}
+
+	return types.SetValueMust(plan.ElementType(ctx), elements)
+}
+
+// secretElementKey returns the key of an element of a set of secrets or secret files.
+func secretElementKey(element attr.Value) string {
+	return element.(types.Object).Attributes()["key"].(types.String).ValueString()
+}
@@ -0,0 +1,157 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// newTestUpdateResponse builds an UpdateResponse whose state holds the planned id, as it is before a resource is updated.
+func newTestUpdateResponse() *resource.UpdateResponse {
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	return &resource.UpdateResponse{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(objType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "planned-id")}),
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	}
+}
+
+func TestSavePartiallyUpdatedState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error without partially updated resource leaves the state untouched", func(t *testing.T) {
+		t.Parallel()
+		resp := newTestUpdateResponse()
+		savePartiallyUpdatedState(context.Background(), resp, errors.New("boom"), "test", func(updated *testPartialResource, report *variable.DiffApplyError) testPartialState {
+			panic("the state must not be converted")
+		})
+
+		var state testPartialState
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+		assert.Equal(t, "planned-id", state.Id.ValueString())
+		assert.Empty(t, resp.Diagnostics)
+	})
+
+	t.Run("partially updated resource is saved into the state with the applied keys", func(t *testing.T) {
+		t.Parallel()
+		resp := newTestUpdateResponse()
+		report := &variable.DiffApplyError{
+			Applied: []variable.DiffAppliedKey{{Kind: variable.DiffKindValue, Key: "APPLIED"}},
+			Failed:  []variable.DiffApplyFailure{{Key: "FAILED", Err: errors.New("boom")}},
+		}
+		err := common.NewPartialUpdateError(&testPartialResource{ID: "updated-id"}, report)
+
+		var gotReport *variable.DiffApplyError
+		savePartiallyUpdatedState(context.Background(), resp, err, "test", func(updated *testPartialResource, report *variable.DiffApplyError) testPartialState {
+			gotReport = report
+			return testPartialState{Id: types.StringValue(updated.ID)}
+		})
+
+		var state testPartialState
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+		assert.Equal(t, "updated-id", state.Id.ValueString())
+		assert.Same(t, report, gotReport)
+		assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+		assert.False(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestAppliedSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newSecrets := func(secrets ...Secret) types.Set {
+		return SecretList(secrets).toTerraformSet(ctx)
+	}
+	newSecret := func(key string, value string) Secret {
+		return Secret{Id: types.StringUnknown(), Key: types.StringValue(key), Value: types.StringValue(value), Description: types.StringNull()}
+	}
+	valuesByKey := func(set types.Set) map[string]string {
+		values := make(map[string]string)
+		for _, s := range ToSecretList(set) {
+			values[s.Key.ValueString()] = s.Value.ValueString()
+		}
+		return values
+	}
+
+	testCases := []struct {
+		name     string
+		plan     types.Set
+		state    types.Set
+		applied  []string
+		expected map[string]string
+	}{
+		{
+			name:     "applied keys take the value of the plan",
+			plan:     newSecrets(newSecret("UPDATED", "new"), newSecret("CREATED", "created")),
+			state:    newSecrets(newSecret("UPDATED", "old")),
+			applied:  []string{"UPDATED", "CREATED"},
+			expected: map[string]string{"UPDATED": "new", "CREATED": "created"},
+		},
+		{
+			name:     "failed update keeps the value of the state",
+			plan:     newSecrets(newSecret("UPDATED", "new"), newSecret("FAILED", "new")),
+			state:    newSecrets(newSecret("UPDATED", "old"), newSecret("FAILED", "old")),
+			applied:  []string{"UPDATED"},
+			expected: map[string]string{"UPDATED": "new", "FAILED": "old"},
+		},
+		{
+			name:     "failed creation is not saved",
+			plan:     newSecrets(newSecret("FAILED", "created")),
+			state:    newSecrets(),
+			applied:  nil,
+			expected: map[string]string{},
+		},
+		{
+			name:     "failed deletion keeps the secret of the state",
+			plan:     newSecrets(),
+			state:    newSecrets(newSecret("DELETED", "old"), newSecret("FAILED", "old")),
+			applied:  []string{"DELETED"},
+			expected: map[string]string{"FAILED": "old"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, valuesByKey(appliedSecrets(ctx, tc.plan, tc.state, tc.applied)))
+		})
+	}
+
+	t.Run("null plan without remaining secret stays null", func(t *testing.T) {
+		t.Parallel()
+		plan := types.SetNull(types.ObjectType{AttrTypes: secretAttrTypes})
+		result := appliedSecrets(ctx, plan, newSecrets(newSecret("DELETED", "old")), []string{"DELETED"})
+		assert.True(t, result.IsNull())
+	})
+
+	t.Run("same key in several diffs is filtered by the keys of each diff", func(t *testing.T) {
+		t.Parallel()
+		report := &variable.DiffApplyError{Applied: []variable.DiffAppliedKey{{Kind: variable.DiffKindValue, Key: "KEY"}}}
+		plan := newSecrets(newSecret("KEY", "new"))
+		state := newSecrets(newSecret("KEY", "old"))
+
+		assert.Equal(t, map[string]string{"KEY": "new"}, valuesByKey(appliedSecrets(ctx, plan, state, report.AppliedKeys(variable.DiffKindValue))))
+		assert.Equal(t, map[string]string{"KEY": "old"}, valuesByKey(appliedSecrets(ctx, plan, state, report.AppliedKeys(variable.DiffKindOverride))))
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
@@ -21,79 +20,87 @@ func int32Ptr(i int32) *int32 { return &i }
 
 func boolPtr(b bool) *bool { return &b }
 
-func TestConvertResponseToApplicationPorts(t *testing.T) {
+func TestConvertDomainPortsToApplicationPorts(t *testing.T) {
 	t.Parallel()
 
-	apiPort := qovery.ServicePort{
-		Id:                 "id-api",
+	httpProtocol := port.ProtocolHTTP
+	tcpProtocol := port.ProtocolTCP
+
+	apiID := "aaaaaaaa-0000-0000-0000-000000000001"
+	sqlID := "bbbbbbbb-0000-0000-0000-000000000002"
+	goneID := "cccccccc-0000-0000-0000-000000000003"
+	newID := "dddddddd-0000-0000-0000-000000000004"
+
+	apiPort := port.Port{
+		ID:                 uuid.MustParse(apiID),
 		Name:               strPtr("api"),
 		InternalPort:       4000,
 		ExternalPort:       int32Ptr(443),
-		Protocol:           qovery.PORTPROTOCOLENUM_HTTP,
+		Protocol:           &httpProtocol,
 		PubliclyAccessible: true,
-		IsDefault:          boolPtr(true),
+		IsDefault:          true,
 	}
-	sqlPort := qovery.ServicePort{
-		Id:                 "id-sql",
+	sqlPort := port.Port{
+		ID:                 uuid.MustParse(sqlID),
 		Name:               strPtr("sql"),
 		InternalPort:       15432,
 		ExternalPort:       int32Ptr(15432),
-		Protocol:           qovery.PORTPROTOCOLENUM_TCP,
+		Protocol:           &tcpProtocol,
 		PubliclyAccessible: false,
-		IsDefault:          boolPtr(false),
+		IsDefault:          false,
 	}
 
 	testCases := []struct {
 		TestName     string
 		InitialState []ApplicationPort
-		APIPorts     []qovery.ServicePort
+		DomainPorts  port.Ports
 		ExpectedIDs  []string
 	}{
 		{
 			TestName:     "empty_state_sorts_by_internal_port",
 			InitialState: nil,
-			APIPorts:     []qovery.ServicePort{sqlPort, apiPort},
-			ExpectedIDs:  []string{"id-api", "id-sql"}, // 4000 < 15432
+			DomainPorts:  port.Ports{sqlPort, apiPort},
+			ExpectedIDs:  []string{apiID, sqlID}, // 4000 < 15432
 		},
 		{
 			TestName: "state_with_ids_preserves_order",
 			InitialState: []ApplicationPort{
-				{Id: FromString("id-sql"), Name: FromString("sql")},
-				{Id: FromString("id-api"), Name: FromString("api")},
+				{Id: FromString(sqlID), Name: FromString("sql")},
+				{Id: FromString(apiID), Name: FromString("api")},
 			},
-			APIPorts:    []qovery.ServicePort{apiPort, sqlPort},
-			ExpectedIDs: []string{"id-sql", "id-api"},
+			DomainPorts: port.Ports{apiPort, sqlPort},
+			ExpectedIDs: []string{sqlID, apiID},
 		},
 		{
 			TestName: "port_renamed_matches_by_id",
 			InitialState: []ApplicationPort{
-				{Id: FromString("id-api"), Name: FromString("old-name")},
-				{Id: FromString("id-sql"), Name: FromString("sql")},
+				{Id: FromString(apiID), Name: FromString("old-name")},
+				{Id: FromString(sqlID), Name: FromString("sql")},
 			},
-			APIPorts:    []qovery.ServicePort{apiPort, sqlPort},
-			ExpectedIDs: []string{"id-api", "id-sql"},
+			DomainPorts: port.Ports{apiPort, sqlPort},
+			ExpectedIDs: []string{apiID, sqlID},
 		},
 		{
 			TestName: "new_port_appended_after_matched",
 			InitialState: []ApplicationPort{
-				{Id: FromString("id-sql"), Name: FromString("sql")},
+				{Id: FromString(sqlID), Name: FromString("sql")},
 			},
-			APIPorts:    []qovery.ServicePort{apiPort, sqlPort},
-			ExpectedIDs: []string{"id-sql", "id-api"},
+			DomainPorts: port.Ports{apiPort, sqlPort},
+			ExpectedIDs: []string{sqlID, apiID},
 		},
 		{
 			TestName: "deleted_port_not_in_result",
 			InitialState: []ApplicationPort{
-				{Id: FromString("id-sql"), Name: FromString("sql")},
-				{Id: FromString("id-gone"), Name: FromString("gone")},
+				{Id: FromString(sqlID), Name: FromString("sql")},
+				{Id: FromString(goneID), Name: FromString("gone")},
 			},
-			APIPorts:    []qovery.ServicePort{sqlPort},
-			ExpectedIDs: []string{"id-sql"},
+			DomainPorts: port.Ports{sqlPort},
+			ExpectedIDs: []string{sqlID},
 		},
 		{
 			TestName:     "nil_ports_nil_state_returns_nil",
 			InitialState: nil,
-			APIPorts:     []qovery.ServicePort{},
+			DomainPorts:  port.Ports{},
 			ExpectedIDs:  nil,
 		},
 		{
@@ -102,29 +109,29 @@ func TestConvertResponseToApplicationPorts(t *testing.T) {
 				{Id: FromString(""), Name: FromString("api")},
 				{Id: FromString(""), Name: FromString("sql")},
 			},
-			APIPorts:    []qovery.ServicePort{sqlPort, apiPort},
-			ExpectedIDs: []string{"id-api", "id-sql"},
+			DomainPorts: port.Ports{sqlPort, apiPort},
+			ExpectedIDs: []string{apiID, sqlID},
 		},
 		{
 			TestName: "console_changed_first_port_preserves_index",
 			InitialState: []ApplicationPort{
-				{Id: FromString("id-gone"), Name: FromString("api")},
-				{Id: FromString("id-sql"), Name: FromString("sql")},
+				{Id: FromString(goneID), Name: FromString("api")},
+				{Id: FromString(sqlID), Name: FromString("sql")},
 			},
-			APIPorts: []qovery.ServicePort{
+			DomainPorts: port.Ports{
 				sqlPort,
 				{
-					Id:                 "id-new",
+					ID:                 uuid.MustParse(newID),
 					Name:               strPtr("p4200"),
 					InternalPort:       4200,
 					ExternalPort:       int32Ptr(443),
-					Protocol:           qovery.PORTPROTOCOLENUM_HTTP,
+					Protocol:           &httpProtocol,
 					PubliclyAccessible: true,
-					IsDefault:          boolPtr(true),
+					IsDefault:          true,
 				},
 			},
 			// p4200 fills gap at index 0 (where api was), sql stays at index 1
-			ExpectedIDs: []string{"id-new", "id-sql"},
+			ExpectedIDs: []string{newID, sqlID},
 		},
 	}
 
@@ -132,7 +139,7 @@ func TestConvertResponseToApplicationPorts(t *testing.T) {
 		tc := tc
 		t.Run(tc.TestName, func(t *testing.T) {
 			t.Parallel()
-			result := convertResponseToApplicationPorts(tc.InitialState, tc.APIPorts)
+			result := convertDomainPortsToApplicationPorts(tc.InitialState, tc.DomainPorts)
 			if tc.ExpectedIDs == nil {
 				assert.Nil(t, result)
 				return
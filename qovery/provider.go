@@ -15,11 +15,14 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/annotations_group"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apitoken"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdCredentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/argoCdDestinationClusterMapping"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/customrole"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
@@ -33,6 +36,8 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/terraformservice"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/qoveryapi"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/telemetry"
 )
 
 const APITokenEnvName = "QOVERY_API_TOKEN"
@@ -75,9 +80,18 @@ type qProvider struct {
 	// eksAnywhereVsphereCredentialsService is an instance of a credentials.EksAnywhereVsphereService that handles the domain logic.
 	eksAnywhereVsphereCredentialsService credentials.EksAnywhereVsphereService
 
+	// clusterService is an instance of a cluster.Service that handles the domain logic.
+	clusterService cluster.Service
+
 	// projectService is an instance of a project.Service that handles the domain logic.
 	projectService project.Service
 
+	// applicationService is an instance of an application.Service that handles the domain logic.
+	applicationService application.Service
+
+	// databaseService is an instance of a database.Service that handles the domain logic.
+	databaseService database.Service
+
 	// containerService is an instance of a container.Service that handles the domain logic.
 	containerService container.Service
 
@@ -139,7 +153,35 @@ type qProvider struct {
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	Token types.String `tfsdk:"token"`
+	Token   types.String         `tfsdk:"token"`
+	Tracing *providerTracingData `tfsdk:"tracing"`
+}
+
+// providerTracingData holds the optional tracing configuration of the provider.
+type providerTracingData struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	OTLPEndpoint types.String `tfsdk:"otlp_endpoint"`
+	FilePath     types.String `tfsdk:"file_path"`
+}
+
+// toTelemetryConfig merges the tracing block with the tracing environment variables, the block taking precedence.
+func (d providerData) toTelemetryConfig(version string) telemetry.Config {
+	cfg := telemetry.ConfigFromEnv()
+	cfg.Version = version
+	if d.Tracing == nil {
+		return cfg
+	}
+
+	if !d.Tracing.Enabled.IsNull() && !d.Tracing.Enabled.IsUnknown() {
+		cfg.Enabled = d.Tracing.Enabled.ValueBool()
+	}
+	if !d.Tracing.OTLPEndpoint.IsNull() && !d.Tracing.OTLPEndpoint.IsUnknown() {
+		cfg.OTLPEndpoint = d.Tracing.OTLPEndpoint.ValueString()
+	}
+	if !d.Tracing.FilePath.IsNull() && !d.Tracing.FilePath.IsUnknown() {
+		cfg.FilePath = d.Tracing.FilePath.ValueString()
+	}
+	return cfg
 }
 
 func (p *qProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -190,6 +232,15 @@ func (p *qProvider) Configure(ctx context.Context, req provider.ConfigureRequest
 		host = "https://api.qovery.com"
 	}
 
+	// Tracing must be set up before the API clients so that their HTTP calls are traced.
+	if err := telemetry.Setup(ctx, data.toTelemetryConfig(p.version)); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to initialize tracing",
+			err.Error(),
+		)
+		return
+	}
+
 	// Initialize qovery client
 	domainServices, err := services.New(services.WithQoveryRepository(token, p.version, host))
 	if err != nil {
@@ -203,15 +254,19 @@ func (p *qProvider) Configure(ctx context.Context, req provider.ConfigureRequest
 	// Create a new Qovery client and set it to the provider client
 	p.configured = true
 	p.client = client.New(token, p.version, host)
-	p.advancedSettingsService = advanced_settings.NewServiceAdvancedSettingsService(p.client.GetConfig())
-	p.clusterAdvancedSettingsService = advanced_settings.NewClusterAdvancedSettingsService(p.client.GetConfig())
+	advancedSettingsDefaultsCache := qoveryapi.NewAdvancedSettingsDefaultsCache(p.client.GetConfig())
+	p.advancedSettingsService = advanced_settings.NewServiceAdvancedSettingsServiceWithCache(p.client.GetConfig(), advancedSettingsDefaultsCache)
+	p.clusterAdvancedSettingsService = advanced_settings.NewClusterAdvancedSettingsServiceWithCache(p.client.GetConfig(), advancedSettingsDefaultsCache)
 	p.organizationService = domainServices.Organization
 	p.awsCredentialsService = domainServices.CredentialsAws
 	p.scalewayCredentialsService = domainServices.CredentialsScaleway
 	p.gcpCredentialsService = domainServices.CredentialsGcp
 	p.azureCredentialsService = domainServices.CredentialsAzure
 	p.eksAnywhereVsphereCredentialsService = domainServices.CredentialsEksAnywhereVsphere
+	p.clusterService = domainServices.Cluster
 	p.projectService = domainServices.Project
+	p.applicationService = domainServices.Application
+	p.databaseService = domainServices.Database
 	p.containerService = domainServices.Container
 	p.jobService = domainServices.Job
 	p.containerRegistryService = domainServices.ContainerRegistry
@@ -240,6 +295,14 @@ func (p *qProvider) Resources(_ context.Context) []func() resource.Resource {
 		newAwsCredentialsResource,
 		newClusterResource,
 		newClusterDNSProviderResource,
+		newClusterKubeconfigAttachmentResource,
+		newClusterRoutingTableResource,
+		newClusterRouteResource,
+		newAwsClusterResource,
+		newGcpClusterResource,
+		newScalewayClusterResource,
+		newAzureClusterResource,
+		newEksAnywhereClusterResource,
 		newDatabaseResource,
 		newEnvironmentResource,
 		newOrganizationResource,
@@ -268,9 +331,12 @@ func (p *qProvider) Resources(_ context.Context) []func() resource.Resource {
 
 func (p *qProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newAdvancedSettingsDefaultsDataSource,
 		newApplicationDataSource,
 		newAwsCredentialsDataSource,
 		newClusterDataSource,
+		newClusterInstanceTypesDataSource,
+		newClusterKubernetesVersionsDataSource,
 		newContainerDataSource,
 		newContainerRegistryDataSource,
 		newJobDataSource,
@@ -311,6 +377,31 @@ func (p *qProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pr
 				Optional:  true,
 				Sensitive: true,
 			},
+			"tracing": schema.SingleNestedAttribute{
+				Description: "OpenTelemetry tracing of provider operations and Qovery API calls. " +
+					"Tracing can also be enabled with the QOVERY_TRACING_ENABLED and QOVERY_TRACING_FILE environment variables.",
+				MarkdownDescription: "OpenTelemetry tracing of provider operations and Qovery API calls. " +
+					"Tracing can also be enabled with the `QOVERY_TRACING_ENABLED` and `QOVERY_TRACING_FILE` environment variables.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Export spans to an OTLP/HTTP collector. " +
+							"The collector is configured with the standard OTEL_EXPORTER_OTLP_* environment variables unless otlp_endpoint is set.",
+						MarkdownDescription: "Export spans to an OTLP/HTTP collector. " +
+							"The collector is configured with the standard `OTEL_EXPORTER_OTLP_*` environment variables unless `otlp_endpoint` is set.",
+						Optional: true,
+					},
+					"otlp_endpoint": schema.StringAttribute{
+						Description:         "URL of the OTLP/HTTP collector (e.g. http://localhost:4318).",
+						MarkdownDescription: "URL of the OTLP/HTTP collector (e.g. `http://localhost:4318`).",
+						Optional:            true,
+					},
+					"file_path": schema.StringAttribute{
+						Description: "Path of a file where spans are appended as JSON.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
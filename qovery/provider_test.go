@@ -58,7 +58,6 @@ type testEnvironment struct {
 }
 
 var (
-	apiClient         = client.New(os.Getenv(qovery.APITokenEnvName), "test", getTestQoveryHost())
 	qoveryServices, _ = services.New(services.WithQoveryRepository(os.Getenv(qovery.APITokenEnvName), "test", getTestQoveryHost()))
 	qoveryAPIClient   = client.NewQoveryAPIClient(os.Getenv(qovery.APITokenEnvName), "test", getTestQoveryHost())
 )
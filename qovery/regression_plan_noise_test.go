@@ -181,6 +181,22 @@ var flickerAllowlist = map[string]string{
 	"qovery_terraform_service.external_secrets.id":                            "TODO: add UseStateForUnknown (set-element id; cosmetic flicker only)",
 	"qovery_terraform_service.external_secret_files.id":                       "TODO: add UseStateForUnknown (set-element id; cosmetic flicker only)",
 
+	"qovery_aws_cluster.features.existing_vpc.documentdb_subnets_zone_a_ids":      "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.documentdb_subnets_zone_b_ids":      "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.documentdb_subnets_zone_c_ids":      "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.eks_create_nodes_in_private_subnet": "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.elasticache_subnets_zone_a_ids":     "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.elasticache_subnets_zone_b_ids":     "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.elasticache_subnets_zone_c_ids":     "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.rds_subnets_zone_a_ids":             "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.rds_subnets_zone_b_ids":             "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.features.existing_vpc.rds_subnets_zone_c_ids":             "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_aws_cluster.secret_manager_accesses.id":                               "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_gcp_cluster.secret_manager_accesses.id":                               "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_scaleway_cluster.secret_manager_accesses.id":                          "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_azure_cluster.secret_manager_accesses.id":                             "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+	"qovery_eks_anywhere_cluster.secret_manager_accesses.id":                      "same attribute as qovery_cluster, the cloud-specific cluster resources share its schema",
+
 	// Organization member lifecycle attributes are legitimately volatile: they track
 	// invitation state that transitions out-of-band (the invitee accepts or the invite
 	// expires outside Terraform), so Read must surface the new values and they cannot
@@ -188,6 +204,8 @@ var flickerAllowlist = map[string]string{
 	"qovery_organization_member.id":                "member id changes across the lifecycle: it is the invitation id while pending, becomes the user id on acceptance, and changes again when a pending invitation's role is updated (delete+re-invite); pinning it would cause 'inconsistent result after apply'",
 	"qovery_organization_member.invitation_status": "invitation status transitions out-of-band (PENDING -> ACCEPTED/EXPIRED when the invitee acts); Read must reflect the new value",
 	"qovery_organization_member.user_id":           "user id is null while the invitation is pending and is populated out-of-band on acceptance; Read must reflect it",
+
+	"qovery_cluster_kubeconfig_attachment.kubeconfig_sha256": "planned by ModifyPlan from the configured kubeconfig, which is never unknown when the kubeconfig is known; Read reflects the kubeconfig known by Qovery to detect drift",
 }
 
 type attributeStatus struct {
@@ -21,11 +21,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -70,8 +71,19 @@ var (
 	applicationGitRepositoryBranchDefault   = "main or master (depending on repository)"
 )
 
+// applicationErrorMappings attaches the application domain errors to the attribute they originate from.
+// Port errors come first as the port and application name errors share the same message.
+var applicationErrorMappings = []attributeErrorMapping{
+	{err: port.ErrInvalidExternalPortParam, path: path.Root("ports"), hint: attributeHints["external_port"]},
+	{err: port.ErrInvalidInternalPortParam, path: path.Root("ports"), hint: attributeHints["internal_port"]},
+	{err: port.ErrInvalidPorts, path: path.Root("ports")},
+	{err: application.ErrInvalidGitRepositoryParam, path: path.Root("git_repository").AtName("url")},
+	{err: application.ErrInvalidBuildModeParam, path: path.Root("build_mode")},
+	{err: application.ErrInvalidNameParam, path: path.Root("name")},
+}
+
 type applicationResource struct {
-	client                  *client.Client
+	applicationService      application.Service
 	advancedSettingsService *advanced_settings.ServiceAdvancedSettingsService
 }
 
@@ -98,7 +110,7 @@ func (r *applicationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = provider.client
+	r.applicationService = provider.applicationService
 	r.advancedSettingsService = provider.advancedSettingsService
 }
 
@@ -803,19 +815,22 @@ func (r applicationResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Create new application
-	request, err := plan.toCreateApplicationRequest()
+	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on application create", err, applicationErrorMappings)
 		return
 	}
-	application, apiErr := r.client.CreateApplication(ctx, ToString(plan.EnvironmentId), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := r.applicationService.Create(ctx, plan.EnvironmentId.ValueString(), *request)
+	if err != nil {
+		savePartiallyCreatedState(ctx, resp, err, "application", func(created *application.Application) Application {
+			return convertDomainApplicationToApplication(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on application create", err, applicationErrorMappings)
 		return
 	}
 
 	// Initialize state values
-	state := convertResponseToApplication(ctx, plan, application)
+	state := convertDomainApplicationToApplication(ctx, plan, app)
 	tflog.Trace(ctx, "created application", map[string]any{"application_id": state.Id.ValueString()})
 
 	// Set state
@@ -839,13 +854,13 @@ func (r applicationResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get application from the API
-	application, apiErr := r.client.GetApplication(ctx, state.Id.ValueString(), state.AdvancedSettingsJson.ValueString(), isTriggeredFromImport)
-	if handleReadNotFound(ctx, resp, apiErr) {
+	app, err := r.applicationService.Get(ctx, state.Id.ValueString(), state.AdvancedSettingsJson.ValueString(), isTriggeredFromImport)
+	if handleDomainReadNotFound(ctx, resp, err, "Error on application read") {
 		return
 	}
 
 	// Refresh state values
-	state = convertResponseToApplication(ctx, state, application)
+	state = convertDomainApplicationToApplication(ctx, state, app)
 	tflog.Trace(ctx, "read application", map[string]any{"application_id": state.Id.ValueString()})
 
 	// Set state
@@ -863,19 +878,22 @@ func (r applicationResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Update application in the backend
-	request, err := plan.toUpdateApplicationRequest(state)
+	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on application update", err, applicationErrorMappings)
 		return
 	}
-	application, apiErr := r.client.UpdateApplication(ctx, state.Id.ValueString(), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := r.applicationService.Update(ctx, state.Id.ValueString(), *request)
+	if err != nil {
+		savePartiallyUpdatedState(ctx, resp, err, "application", func(updated *application.Application, report *variable.DiffApplyError) Application {
+			return convertDomainApplicationToApplication(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on application update", err, applicationErrorMappings)
 		return
 	}
 
 	// Update state values
-	state = convertResponseToApplication(ctx, plan, application)
+	state = convertDomainApplicationToApplication(ctx, plan, app)
 	tflog.Trace(ctx, "updated application", map[string]any{"application_id": state.Id.ValueString()})
 
 	// Set state
@@ -892,9 +910,9 @@ func (r applicationResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete application
-	apiErr := r.client.DeleteApplication(ctx, state.Id.ValueString())
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	err := r.applicationService.Delete(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error on application delete", err.Error())
 		return
 	}
 
@@ -915,4 +933,5 @@ func (r applicationResource) ImportState(ctx context.Context, req resource.Impor
 func (r applicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	validateAutoscalingPlan(ctx, req.Plan, req.State, &resp.Diagnostics)
 	warnUnknownAdvancedSettings(ctx, r.advancedSettingsService, domain.APPLICATION, req.Config, &resp.Diagnostics)
+	validateAdvancedSettingsValues(ctx, r.advancedSettingsService, domain.APPLICATION, req.Config, &resp.Diagnostics)
 }
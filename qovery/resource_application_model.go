@@ -4,14 +4,15 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
 	"github.com/qovery/terraform-provider-qovery/client"
-	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentrestriction"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
-	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/qoveryapi"
 )
 
 type Application struct {
@@ -58,6 +59,16 @@ type Application struct {
 	Autoscaling                  types.Object              `tfsdk:"autoscaling"`
 }
 
+// withAppliedSecrets returns the application with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (a Application) withAppliedSecrets(ctx context.Context, state Application, report *variable.DiffApplyError) Application {
+	a.Secrets = appliedSecrets(ctx, a.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	a.SecretVariableAliases = appliedSecrets(ctx, a.SecretVariableAliases, state.SecretVariableAliases, report.AppliedKeys(variable.DiffKindAlias))
+	a.SecretVariableOverrides = appliedSecrets(ctx, a.SecretVariableOverrides, state.SecretVariableOverrides, report.AppliedKeys(variable.DiffKindOverride))
+	a.SecretFiles = appliedSecrets(ctx, a.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return a
+}
+
 func (app Application) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(app.EnvironmentVariables)
 }
@@ -110,281 +121,165 @@ func (app Application) DeploymentRestrictionList(deploymentRestrictionsState *ty
 	return deploymentrestriction.ToDeploymentRestrictionDiff(app.DeploymentRestrictions, deploymentRestrictionsState)
 }
 
-func (app Application) toCreateApplicationRequest() (*client.ApplicationCreateParams, error) {
-	storage := make([]qovery.ServiceStorageRequestStorageInner, 0, len(app.Storage))
-	for _, store := range app.Storage {
-		s, err := store.toCreateRequest()
-		if err != nil {
-			return nil, err
-		}
-		storage = append(storage, *s)
-	}
-
-	ports := make([]qovery.ServicePortRequestPortsInner, 0, len(app.Ports))
-	for _, port := range app.Ports {
-		p, err := port.toCreateRequest()
-		if err != nil {
-			return nil, err
-		}
-		ports = append(ports, *p)
-	}
-
-	var buildMode *qovery.BuildModeEnum
-	if !app.BuildMode.IsNull() && !app.BuildMode.IsUnknown() {
-		bm, err := qovery.NewBuildModeEnumFromValue(ToString(app.BuildMode))
-		if err != nil {
-			return nil, err
-		}
-		buildMode = bm
-	}
-
-	deploymentRestrictions, err := app.DeploymentRestrictionList(nil)
+func (app Application) toUpsertServiceRequest(state *Application) (*application.UpsertServiceRequest, error) {
+	var stateEnvironmentVariables EnvironmentVariableList
+	var stateEnvironmentVariableAliases EnvironmentVariableList
+	var stateEnvironmentVariableOverrides EnvironmentVariableList
+	var stateEnvironmentVariableFiles EnvironmentVariableFileList
+	var stateSecrets SecretList
+	var stateSecretAliases SecretList
+	var stateSecretOverrides SecretList
+	var stateSecretFiles SecretFileList
+	var stateCustomDomains CustomDomainList
+	var stateExternalSecrets ExternalSecretList
+	var stateExternalSecretFiles ExternalSecretFileList
+	var stateDeploymentRestrictions *types.Set
+	var stateStorages []ApplicationStorage
+
+	if state != nil {
+		stateEnvironmentVariables = state.EnvironmentVariableList()
+		stateEnvironmentVariableAliases = state.EnvironmentVariableAliasList()
+		stateEnvironmentVariableOverrides = state.EnvironmentVariableOverrideList()
+		stateEnvironmentVariableFiles = state.EnvironmentVariableFileList()
+		stateSecrets = state.SecretList()
+		stateSecretAliases = state.SecretAliasList()
+		stateSecretOverrides = state.SecretOverrideList()
+		stateSecretFiles = state.SecretFileList()
+		stateCustomDomains = state.CustomDomainsList()
+		stateExternalSecrets = state.ExternalSecretList()
+		stateExternalSecretFiles = state.ExternalSecretFileList()
+		stateDeploymentRestrictions = &state.DeploymentRestrictions
+		stateStorages = state.Storage
+	}
+
+	deploymentRestrictions, err := app.DeploymentRestrictionList(stateDeploymentRestrictions)
 	if err != nil {
 		return nil, err
 	}
 
-	annotations := make([]string, 0, len(app.AnnotationsGroupIds.Elements()))
-	for _, id := range app.AnnotationsGroupIds.Elements() {
-		id := id.(types.String)
-		annotations = append(annotations, id.ValueString())
-	}
-
-	annotationsGroups, err := qoveryapi.NewQoveryServiceAnnotationsGroupRequestFromDomain(annotations)
-	if err != nil {
-		return nil, errors.Wrap(err, container.ErrInvalidUpsertRequest.Error())
-	}
-
-	labels := make([]string, 0, len(app.LabelsGroupIds.Elements()))
-	for _, id := range app.LabelsGroupIds.Elements() {
-		id := id.(types.String)
-		labels = append(labels, id.ValueString())
-	}
-
-	labelsGroups, err := qoveryapi.NewQoveryServiceLabelsGroupRequestFromDomain(labels)
-	if err != nil {
-		return nil, errors.Wrap(err, container.ErrInvalidUpsertRequest.Error())
-	}
-
-	gitRepository, err := app.GitRepository.toCreateRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	autoscalingPolicy, err := toQoveryAutoscalingRequest(app.Autoscaling)
-	if err != nil {
-		return nil, err
-	}
-
-	return &client.ApplicationCreateParams{
-		ApplicationRequest: qovery.ApplicationRequest{
-			Name:                   ToString(app.Name),
-			IconUri:                ToStringPointer(app.IconUri),
-			BuildMode:              buildMode,
-			DockerfilePath:         ToNullableString(app.DockerfilePath),
-			Cpu:                    ToInt32Pointer(app.CPU),
-			Memory:                 ToInt32Pointer(app.Memory),
-			EphemeralStorageInGib:  ToInt32Pointer(app.EphemeralStorage),
-			MinRunningInstances:    ToInt32Pointer(app.MinRunningInstances),
-			MaxRunningInstances:    ToInt32Pointer(app.MaxRunningInstances),
-			AutoPreview:            ToBoolPointer(app.AutoPreview),
-			GitRepository:          gitRepository,
-			Storage:                storage,
-			Ports:                  ports,
-			Entrypoint:             ToStringPointer(app.Entrypoint),
-			Arguments:              ToStringArray(app.Arguments),
-			Healthchecks:           app.Healthchecks.toHealthchecksRequest(),
-			AutoDeploy:             *qovery.NewNullableBool(ToBoolPointer(app.AutoDeploy)),
-			AnnotationsGroups:      annotationsGroups,
-			LabelsGroups:           labelsGroups,
-			DockerTargetBuildStage: ToNullableString(app.DockerTargetBuildStage),
-			Autoscaling:            autoscalingPolicy,
-		},
-		EnvironmentVariablesDiff:         app.EnvironmentVariableList().diff(nil),
-		EnvironmentVariableAliasesDiff:   app.EnvironmentVariableAliasList().diff(nil),
-		EnvironmentVariableOverridesDiff: app.EnvironmentVariableOverrideList().diff(nil),
-		EnvironmentVariableFilesDiff:     app.EnvironmentVariableFileList().diff(nil),
-		SecretsDiff:                      app.SecretList().diff(nil),
-		SecretAliasesDiff:                app.SecretAliasList().diff(nil),
-		SecretOverridesDiff:              app.SecretOverrideList().diff(nil),
-		SecretFilesDiff:                  app.SecretFileList().diff(nil),
-		CustomDomainsDiff:                app.CustomDomainsList().diff(nil),
-		DeploymentRestrictionsDiff:       *deploymentRestrictions,
-		ExternalSecretsDiff:              app.ExternalSecretList().diffRequest(nil),
-		ExternalSecretFilesDiff:          app.ExternalSecretFileList().diffRequest(nil),
-		ApplicationDeploymentStageID:     ToString(app.DeploymentStageId),
-		ApplicationIsSkipped:             ToBool(app.IsSkipped),
-		AdvancedSettingsJson:             ToString(app.AdvancedSettingsJson),
+	return &application.UpsertServiceRequest{
+		ApplicationUpsertRequest:     app.toUpsertRepositoryRequest(stateStorages, app.CustomDomainsList().diff(stateCustomDomains)),
+		EnvironmentVariables:         app.EnvironmentVariableList().diffRequest(stateEnvironmentVariables),
+		EnvironmentVariableAliases:   app.EnvironmentVariableAliasList().diffRequest(stateEnvironmentVariableAliases),
+		EnvironmentVariableOverrides: app.EnvironmentVariableOverrideList().diffRequest(stateEnvironmentVariableOverrides),
+		EnvironmentVariableFiles:     app.EnvironmentVariableFileList().diffRequest(stateEnvironmentVariableFiles),
+		Secrets:                      app.SecretList().diffRequest(stateSecrets),
+		SecretAliases:                app.SecretAliasList().diffRequest(stateSecretAliases),
+		SecretOverrides:              app.SecretOverrideList().diffRequest(stateSecretOverrides),
+		SecretFiles:                  app.SecretFileList().diffRequest(stateSecretFiles),
+		ExternalSecrets:              app.ExternalSecretList().diffRequest(stateExternalSecrets),
+		ExternalSecretFiles:          app.ExternalSecretFileList().diffRequest(stateExternalSecretFiles),
+		DeploymentRestrictionsDiff:   *deploymentRestrictions,
 	}, nil
 }
 
-func (app Application) toUpdateApplicationRequest(state Application) (*client.ApplicationUpdateParams, error) {
-	// Create a hashmap containing current terraform state ApplicationStorage by MountPoint
-	// MountPoint is unique with an application
-	stateApplicationStoragesByMountPoint := make(map[string]ApplicationStorage)
-	for _, existingStorage := range state.Storage {
-		stateApplicationStoragesByMountPoint[existingStorage.MountPoint.String()] = existingStorage
-	}
-
-	applicationStorageRequest := make([]qovery.ServiceStorageRequestStorageInner, 0, len(app.Storage))
-	for _, storage := range app.Storage {
-		// The storage id can be:
-		// - nil if a new storage is declared in the application resource
-		// - set if it already exists
-		var storageId string
-		value, exists := stateApplicationStoragesByMountPoint[storage.MountPoint.String()]
-		if exists {
-			storageId = ToString(value.Id)
-		}
-
-		s, err := storage.toUpdateRequest(storageId)
-		if err != nil {
-			return nil, err
-		}
-		applicationStorageRequest = append(applicationStorageRequest, *s)
+func (app Application) toUpsertRepositoryRequest(stateStorages []ApplicationStorage, customDomainsDiff client.CustomDomainsDiff) application.UpsertRepositoryRequest {
+	// The mount point is unique within an application: it identifies the storages already existing in the state.
+	stateStorageIDsByMountPoint := make(map[string]*string, len(stateStorages))
+	for _, existingStorage := range stateStorages {
+		stateStorageIDsByMountPoint[existingStorage.MountPoint.String()] = ToStringPointer(existingStorage.Id)
 	}
 
-	ports := make([]qovery.ServicePort, 0, len(app.Ports))
-	for _, port := range app.Ports {
-		p, err := port.toUpdateRequest()
-		if err != nil {
-			return nil, err
-		}
-		ports = append(ports, *p)
-	}
-
-	var buildMode *qovery.BuildModeEnum
-	if !app.BuildMode.IsNull() && !app.BuildMode.IsUnknown() {
-		bm, err := qovery.NewBuildModeEnumFromValue(ToString(app.BuildMode))
-		if err != nil {
-			return nil, err
-		}
-		buildMode = bm
+	storages := make([]storage.UpsertRequest, 0, len(app.Storage))
+	for _, store := range app.Storage {
+		storages = append(storages, store.toUpsertRequest(stateStorageIDsByMountPoint[store.MountPoint.String()]))
 	}
 
-	deploymentRestrictions, err := app.DeploymentRestrictionList(&state.DeploymentRestrictions)
-	if err != nil {
-		return nil, err
+	ports := make([]port.UpsertRequest, 0, len(app.Ports))
+	for _, prt := range app.Ports {
+		ports = append(ports, prt.toUpsertRequest())
 	}
 
-	annotations := make([]string, 0, len(app.AnnotationsGroupIds.Elements()))
+	annotationsGroupIds := make([]string, 0, len(app.AnnotationsGroupIds.Elements()))
 	for _, id := range app.AnnotationsGroupIds.Elements() {
 		id := id.(types.String)
-		annotations = append(annotations, id.ValueString())
+		annotationsGroupIds = append(annotationsGroupIds, id.ValueString())
 	}
 
-	annotationsGroups, err := qoveryapi.NewQoveryServiceAnnotationsGroupRequestFromDomain(annotations)
-	if err != nil {
-		return nil, errors.Wrap(err, container.ErrInvalidUpsertRequest.Error())
-	}
-
-	labels := make([]string, 0, len(app.LabelsGroupIds.Elements()))
+	labelsGroupIds := make([]string, 0, len(app.LabelsGroupIds.Elements()))
 	for _, id := range app.LabelsGroupIds.Elements() {
 		id := id.(types.String)
-		labels = append(labels, id.ValueString())
-	}
-
-	labelsGroups, err := qoveryapi.NewQoveryServiceLabelsGroupRequestFromDomain(labels)
-	if err != nil {
-		return nil, errors.Wrap(err, container.ErrInvalidUpsertRequest.Error())
+		labelsGroupIds = append(labelsGroupIds, id.ValueString())
 	}
 
-	gitRepository, err := app.GitRepository.toUpdateRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	autoscalingPolicy, err := toQoveryAutoscalingRequest(app.Autoscaling)
-	if err != nil {
-		return nil, err
+	var gitRepository application.GitRepositoryRequest
+	if app.GitRepository != nil {
+		gitRepository = app.GitRepository.toUpsertRequest()
 	}
 
-	applicationEditRequest := qovery.ApplicationEditRequest{
-		Name:                   ToStringPointer(app.Name),
+	return application.UpsertRepositoryRequest{
+		Name:                   ToString(app.Name),
 		IconUri:                ToStringPointer(app.IconUri),
-		BuildMode:              buildMode,
-		DockerfilePath:         ToNullableString(app.DockerfilePath),
-		Cpu:                    ToInt32Pointer(app.CPU),
+		GitRepository:          gitRepository,
+		BuildMode:              ToStringPointer(app.BuildMode),
+		DockerfilePath:         ToStringPointer(app.DockerfilePath),
+		DockerTargetBuildStage: ToStringPointer(app.DockerTargetBuildStage),
+		CPU:                    ToInt32Pointer(app.CPU),
 		Memory:                 ToInt32Pointer(app.Memory),
-		EphemeralStorageInGib:  ToInt32Pointer(app.EphemeralStorage),
+		EphemeralStorage:       ToInt32Pointer(app.EphemeralStorage),
 		MinRunningInstances:    ToInt32Pointer(app.MinRunningInstances),
 		MaxRunningInstances:    ToInt32Pointer(app.MaxRunningInstances),
 		AutoPreview:            ToBoolPointer(app.AutoPreview),
-		GitRepository:          gitRepository,
-		Storage:                applicationStorageRequest,
-		Ports:                  ports,
 		Entrypoint:             ToStringPointer(app.Entrypoint),
 		Arguments:              ToStringArray(app.Arguments),
+		Storages:               storages,
+		Ports:                  ports,
+		DeploymentStageID:      ToString(app.DeploymentStageId),
+		IsSkipped:              ToBool(app.IsSkipped),
 		Healthchecks:           app.Healthchecks.toHealthchecksRequest(),
+		AdvancedSettingsJson:   ToString(app.AdvancedSettingsJson),
+		CustomDomains:          customDomainsDiff,
 		AutoDeploy:             *qovery.NewNullableBool(ToBoolPointer(app.AutoDeploy)),
-		AnnotationsGroups:      annotationsGroups,
-		LabelsGroups:           labelsGroups,
-		DockerTargetBuildStage: ToNullableString(app.DockerTargetBuildStage),
-		Autoscaling:            autoscalingPolicy,
+		AnnotationsGroupIds:    annotationsGroupIds,
+		LabelsGroupIds:         labelsGroupIds,
+		Autoscaling:            toQoveryAutoscaling(app.Autoscaling),
 	}
-	return &client.ApplicationUpdateParams{
-		ApplicationEditRequest:           applicationEditRequest,
-		EnvironmentVariablesDiff:         app.EnvironmentVariableList().diff(state.EnvironmentVariableList()),
-		EnvironmentVariableAliasesDiff:   app.EnvironmentVariableAliasList().diff(state.EnvironmentVariableAliasList()),
-		EnvironmentVariableOverridesDiff: app.EnvironmentVariableOverrideList().diff(state.EnvironmentVariableOverrideList()),
-		EnvironmentVariableFilesDiff:     app.EnvironmentVariableFileList().diff(state.EnvironmentVariableFileList()),
-		SecretsDiff:                      app.SecretList().diff(state.SecretList()),
-		SecretAliasesDiff:                app.SecretAliasList().diff(state.SecretAliasList()),
-		SecretOverridesDiff:              app.SecretOverrideList().diff(state.SecretOverrideList()),
-		SecretFilesDiff:                  app.SecretFileList().diff(state.SecretFileList()),
-		CustomDomainsDiff:                app.CustomDomainsList().diff(state.CustomDomainsList()),
-		DeploymentRestrictionsDiff:       *deploymentRestrictions,
-		ExternalSecretsDiff:              app.ExternalSecretList().diffRequest(state.ExternalSecretList()),
-		ExternalSecretFilesDiff:          app.ExternalSecretFileList().diffRequest(state.ExternalSecretFileList()),
-		ApplicationDeploymentStageID:     ToString(app.DeploymentStageId),
-		ApplicationIsSkipped:             ToBool(app.IsSkipped),
-		AdvancedSettingsJson:             ToString(app.AdvancedSettingsJson),
-	}, nil
 }
 
-func convertResponseToApplication(ctx context.Context, state Application, app *client.ApplicationResponse) Application {
-	healthchecks := convertHealthchecksResponseToDomain(app.ApplicationResponse.Healthchecks)
+func convertDomainApplicationToApplication(ctx context.Context, state Application, app *application.Application) Application {
+	healthchecks := convertHealthchecksResponseToDomain(app.Healthchecks)
 	return Application{
-		Id:                           FromString(app.ApplicationResponse.Id),
-		EnvironmentId:                FromString(app.ApplicationResponse.Environment.Id),
-		Name:                         FromString(app.ApplicationResponse.Name),
-		IconUri:                      FromString(app.ApplicationResponse.IconUri),
-		BuildMode:                    fromClientEnumPointer(app.ApplicationResponse.BuildMode),
-		DockerfilePath:               FromNullableString(app.ApplicationResponse.DockerfilePath),
-		CPU:                          FromInt32Pointer(app.ApplicationResponse.Cpu),
-		Memory:                       FromInt32Pointer(app.ApplicationResponse.Memory),
-		EphemeralStorage:             FromInt32Pointer(app.ApplicationResponse.EphemeralStorageInGib),
-		MinRunningInstances:          FromInt32Pointer(app.ApplicationResponse.MinRunningInstances),
-		MaxRunningInstances:          FromInt32Pointer(app.ApplicationResponse.MaxRunningInstances),
-		AutoPreview:                  FromBoolPointer(app.ApplicationResponse.AutoPreview),
-		GitRepository:                convertResponseToApplicationGitRepository(app.ApplicationResponse.GitRepository),
-		Storage:                      convertResponseToApplicationStorage(state.Storage, app.ApplicationResponse.Storage),
-		Ports:                        convertResponseToApplicationPorts(state.Ports, app.ApplicationResponse.Ports),
-		BuiltInEnvironmentVariables:  fromEnvironmentVariableList(app.ApplicationEnvironmentVariables, qovery.APIVARIABLESCOPEENUM_BUILT_IN, "BUILT_IN").toTerraformList(ctx),
-		EnvironmentVariables:         fromEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariables, app.ApplicationEnvironmentVariables, qovery.APIVARIABLESCOPEENUM_APPLICATION, "VALUE").toTerraformSet(ctx),
-		EnvironmentVariableAliases:   fromEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariableAliases, app.ApplicationEnvironmentVariableAliases, qovery.APIVARIABLESCOPEENUM_APPLICATION, "ALIAS").toTerraformSet(ctx),
-		EnvironmentVariableOverrides: fromEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariableOverrides, app.ApplicationEnvironmentVariableOverrides, qovery.APIVARIABLESCOPEENUM_APPLICATION, "OVERRIDE").toTerraformSet(ctx),
-		Secrets:                      fromSecretList(state.Secrets, app.ApplicationSecrets, qovery.APIVARIABLESCOPEENUM_APPLICATION, "VALUE").toTerraformSet(ctx),
-		SecretVariableAliases:        fromSecretList(state.SecretVariableAliases, app.ApplicationSecretAliases, qovery.APIVARIABLESCOPEENUM_APPLICATION, "ALIAS").toTerraformSet(ctx),
-		SecretVariableOverrides:      fromSecretList(state.SecretVariableOverrides, app.ApplicationSecretOverrides, qovery.APIVARIABLESCOPEENUM_APPLICATION, "OVERRIDE").toTerraformSet(ctx),
-		EnvironmentVariableFiles:     fromEnvironmentVariableFileList(ctx, state.EnvironmentVariableFiles, app.ApplicationEnvironmentVariableFiles, qovery.APIVARIABLESCOPEENUM_APPLICATION, "FILE").toTerraformSet(ctx),
-		SecretFiles:                  fromSecretFileList(state.SecretFiles, app.ApplicationSecretFiles, qovery.APIVARIABLESCOPEENUM_APPLICATION, "FILE").toTerraformSet(ctx),
-		CustomDomains:                fromCustomDomainList(state.CustomDomains, app.ApplicationCustomDomains).toTerraformSet(ctx),
-		InternalHost:                 FromString(app.ApplicationInternalHost),
-		ExternalHost:                 FromStringPointer(app.ApplicationExternalHost),
-		Entrypoint:                   FromStringPointer(app.ApplicationResponse.Entrypoint),
-		Arguments:                    FromStringArray(app.ApplicationResponse.Arguments),
-		DeploymentStageId:            FromString(app.ApplicationDeploymentStageID),
-		IsSkipped:                    FromBool(app.ApplicationIsSkipped),
+		Id:                           FromString(app.ID.String()),
+		EnvironmentId:                FromString(app.EnvironmentID.String()),
+		Name:                         FromString(app.Name),
+		IconUri:                      FromString(app.IconUri),
+		BuildMode:                    FromStringPointer(app.BuildMode),
+		DockerfilePath:               FromStringPointer(app.DockerfilePath),
+		CPU:                          FromInt32Pointer(app.CPU),
+		Memory:                       FromInt32Pointer(app.Memory),
+		EphemeralStorage:             FromInt32Pointer(app.EphemeralStorage),
+		MinRunningInstances:          FromInt32Pointer(app.MinRunningInstances),
+		MaxRunningInstances:          FromInt32Pointer(app.MaxRunningInstances),
+		AutoPreview:                  FromBoolPointer(app.AutoPreview),
+		GitRepository:                convertDomainGitRepositoryToApplicationGitRepository(app.GitRepository),
+		Storage:                      convertDomainStoragesToApplicationStorage(state.Storage, app.Storages),
+		Ports:                        convertDomainPortsToApplicationPorts(state.Ports, app.Ports),
+		BuiltInEnvironmentVariables:  convertDomainVariablesToEnvironmentVariableList(app.BuiltInEnvironmentVariables).toTerraformList(ctx),
+		EnvironmentVariables:         convertDomainVariablesToEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariables, app.EnvironmentVariables, variable.ScopeApplication, "VALUE").toTerraformSet(ctx),
+		EnvironmentVariableAliases:   convertDomainVariablesToEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariableAliases, app.EnvironmentVariables, variable.ScopeApplication, "ALIAS").toTerraformSet(ctx),
+		EnvironmentVariableOverrides: convertDomainVariablesToEnvironmentVariableListWithNullableInitialState(ctx, state.EnvironmentVariableOverrides, app.EnvironmentVariables, variable.ScopeApplication, "OVERRIDE").toTerraformSet(ctx),
+		Secrets:                      convertDomainSecretsToSecretList(state.Secrets, app.Secrets, variable.ScopeApplication, "VALUE").toTerraformSet(ctx),
+		SecretVariableAliases:        convertDomainSecretsToSecretList(state.SecretVariableAliases, app.Secrets, variable.ScopeApplication, "ALIAS").toTerraformSet(ctx),
+		SecretVariableOverrides:      convertDomainSecretsToSecretList(state.SecretVariableOverrides, app.Secrets, variable.ScopeApplication, "OVERRIDE").toTerraformSet(ctx),
+		EnvironmentVariableFiles:     convertDomainVariablesToEnvironmentVariableFileListWithNullableInitialState(ctx, state.EnvironmentVariableFiles, app.EnvironmentVariables, variable.ScopeApplication).toTerraformSet(ctx),
+		SecretFiles:                  convertDomainSecretsToSecretFileList(state.SecretFiles, app.Secrets, variable.ScopeApplication).toTerraformSet(ctx),
+		CustomDomains:                fromCustomDomainList(state.CustomDomains, app.CustomDomains).toTerraformSet(ctx),
+		InternalHost:                 FromString(ptrStringValue(app.InternalHost)),
+		ExternalHost:                 FromStringPointer(app.ExternalHost),
+		Entrypoint:                   FromStringPointer(app.Entrypoint),
+		Arguments:                    FromStringArray(app.Arguments),
+		DeploymentStageId:            FromString(app.DeploymentStageID),
+		IsSkipped:                    FromBool(app.IsSkipped),
 		Healthchecks:                 &healthchecks,
 		AdvancedSettingsJson:         FromString(app.AdvancedSettingsJson),
-		AutoDeploy:                   FromBoolPointer(app.ApplicationResponse.AutoDeploy),
-		DeploymentRestrictions:       FromDeploymentRestrictionList(state.DeploymentRestrictions, app.ApplicationDeploymentRestrictions),
-		AnnotationsGroupIds:          fromAnnotationsGroupResponseList(ctx, state.AnnotationsGroupIds, app.ApplicationResponse.AnnotationsGroups),
-		LabelsGroupIds:               fromLabelsGroupResponseList(ctx, state.LabelsGroupIds, app.ApplicationResponse.LabelsGroups),
-		DockerTargetBuildStage:       FromNullableString(app.ApplicationResponse.DockerTargetBuildStage),
-		Autoscaling:                  fromAutoscalingResponse(app.ApplicationResponse.Autoscaling),
-		ExternalSecrets:              convertDomainExternalSecretsToExternalSecretList(app.ApplicationExternalSecrets, state.ExternalSecrets, variable.ScopeApplication).toTerraformSet(ctx),
-		ExternalSecretFiles:          convertDomainExternalSecretFilesToExternalSecretFileList(app.ApplicationExternalSecretFiles, state.ExternalSecretFiles, variable.ScopeApplication).toTerraformSet(ctx),
+		AutoDeploy:                   FromBoolPointer(app.AutoDeploy),
+		DeploymentRestrictions:       FromDeploymentRestrictionList(state.DeploymentRestrictions, app.DeploymentRestrictions),
+		AnnotationsGroupIds:          fromAnnotationsGroupList(ctx, state.AnnotationsGroupIds, app.AnnotationsGroupIds),
+		LabelsGroupIds:               fromLabelsGroupList(ctx, state.LabelsGroupIds, app.LabelsGroupIds),
+		DockerTargetBuildStage:       FromStringPointer(app.DockerTargetBuildStage),
+		Autoscaling:                  fromAutoscaling(app.Autoscaling),
+		ExternalSecrets:              convertDomainExternalSecretsToExternalSecretList(app.ExternalSecrets, state.ExternalSecrets, variable.ScopeApplication).toTerraformSet(ctx),
+		ExternalSecretFiles:          convertDomainExternalSecretFilesToExternalSecretFileList(app.ExternalSecretFiles, state.ExternalSecretFiles, variable.ScopeApplication).toTerraformSet(ctx),
 	}
 }
 
@@ -395,31 +290,16 @@ type ApplicationGitRepository struct {
 	GitTokenId types.String `tfsdk:"git_token_id"`
 }
 
-func (repo ApplicationGitRepository) toCreateRequest() (qovery.ApplicationGitRepositoryRequest, error) {
-	url := ToString(repo.URL)
-	provider, err := detectGitProviderFromURL(url)
-	if err != nil {
-		return qovery.ApplicationGitRepositoryRequest{}, err
-	}
-
-	return qovery.ApplicationGitRepositoryRequest{
-		Url:        url,
+func (repo ApplicationGitRepository) toUpsertRequest() application.GitRepositoryRequest {
+	return application.GitRepositoryRequest{
+		Url:        ToString(repo.URL),
 		RootPath:   ToStringPointer(repo.RootPath),
 		Branch:     ToStringPointer(repo.Branch),
-		GitTokenId: *qovery.NewNullableString(ToStringPointer(repo.GitTokenId)),
-		Provider:   provider,
-	}, nil
-}
-
-func (repo ApplicationGitRepository) toUpdateRequest() (*qovery.ApplicationGitRepositoryRequest, error) {
-	req, err := repo.toCreateRequest()
-	if err != nil {
-		return nil, err
+		GitTokenId: ToStringPointer(repo.GitTokenId),
 	}
-	return &req, nil
 }
 
-func convertResponseToApplicationGitRepository(gitRepository *qovery.ApplicationGitRepository) *ApplicationGitRepository {
+func convertDomainGitRepositoryToApplicationGitRepository(gitRepository *git_repository.GitRepository) *ApplicationGitRepository {
 	if gitRepository == nil {
 		return nil
 	}
@@ -428,7 +308,7 @@ func convertResponseToApplicationGitRepository(gitRepository *qovery.Application
 		URL:        FromString(gitRepository.Url),
 		RootPath:   FromStringPointer(gitRepository.RootPath),
 		Branch:     FromStringPointer(gitRepository.Branch),
-		GitTokenId: FromStringPointer(gitRepository.GitTokenId.Get()),
+		GitTokenId: FromStringPointer(gitRepository.GitTokenId),
 	}
 }
 
@@ -439,48 +319,30 @@ type ApplicationStorage struct {
 	MountPoint types.String `tfsdk:"mount_point"`
 }
 
-func (store ApplicationStorage) toCreateRequest() (*qovery.ServiceStorageRequestStorageInner, error) {
-	storageType, err := qovery.NewStorageTypeEnumFromValue(ToString(store.Type))
-	if err != nil {
-		return nil, err
-	}
-
-	return &qovery.ServiceStorageRequestStorageInner{
-		Type:       *storageType,
+func (store ApplicationStorage) toUpsertRequest(id *string) storage.UpsertRequest {
+	return storage.UpsertRequest{
+		ID:         id,
+		Type:       ToString(store.Type),
 		Size:       ToInt32(store.Size),
 		MountPoint: ToString(store.MountPoint),
-	}, nil
+	}
 }
 
-func (store ApplicationStorage) toUpdateRequest(id string) (*qovery.ServiceStorageRequestStorageInner, error) {
-	storageType, err := qovery.NewStorageTypeEnumFromValue(ToString(store.Type))
-	if err != nil {
-		return nil, err
+func convertDomainStoragesToApplicationStorage(initialState []ApplicationStorage, storages storage.Storages) []ApplicationStorage {
+	if len(storages) == 0 && initialState == nil {
+		return nil
 	}
 
-	return &qovery.ServiceStorageRequestStorageInner{
-		Id:         &id,
-		Type:       *storageType,
-		Size:       ToInt32(store.Size),
-		MountPoint: ToString(store.MountPoint),
-	}, nil
-}
-
-func convertResponseToApplicationStorage(initialState []ApplicationStorage, storage []qovery.ServiceStorageStorageInner) []ApplicationStorage {
-	list := make([]ApplicationStorage, 0, len(storage))
-	for _, s := range storage {
+	list := make([]ApplicationStorage, 0, len(storages))
+	for _, s := range storages {
 		list = append(list, ApplicationStorage{
-			Id:         FromString(s.Id),
-			Type:       fromClientEnum(s.Type),
+			Id:         FromString(s.ID.String()),
+			Type:       FromString(s.Type.String()),
 			Size:       FromInt32(s.Size),
 			MountPoint: FromString(s.MountPoint),
 		})
 	}
 
-	if len(storage) == 0 && initialState == nil {
-		return nil
-	}
-
 	return list
 }
 
@@ -494,52 +356,36 @@ type ApplicationPort struct {
 	IsDefault          types.Bool   `tfsdk:"is_default"`
 }
 
-func (port ApplicationPort) toCreateRequest() (*qovery.ServicePortRequestPortsInner, error) {
-	protocol, err := qovery.NewPortProtocolEnumFromValue(ToString(port.Protocol))
-	if err != nil {
-		return nil, err
+func (p ApplicationPort) toUpsertRequest() port.UpsertRequest {
+	return port.UpsertRequest{
+		Id:                 ToStringPointer(p.Id),
+		Name:               ToStringPointer(p.Name),
+		Protocol:           ToStringPointer(p.Protocol),
+		InternalPort:       ToInt32(p.InternalPort),
+		ExternalPort:       ToInt32Pointer(p.ExternalPort),
+		PubliclyAccessible: ToBool(p.PubliclyAccessible),
+		IsDefault:          ToBool(p.IsDefault),
 	}
-
-	return &qovery.ServicePortRequestPortsInner{
-		Name:               ToStringPointer(port.Name),
-		InternalPort:       ToInt32(port.InternalPort),
-		ExternalPort:       ToInt32Pointer(port.ExternalPort),
-		Protocol:           protocol,
-		PubliclyAccessible: ToBool(port.PubliclyAccessible),
-		IsDefault:          ToBoolPointer(port.IsDefault),
-	}, nil
 }
 
-func (port ApplicationPort) toUpdateRequest() (*qovery.ServicePort, error) {
-	protocol, err := qovery.NewPortProtocolEnumFromValue(ToString(port.Protocol))
-	if err != nil {
-		return nil, err
+func convertDomainPortToApplicationPort(p port.Port) ApplicationPort {
+	var protocol *string
+	if p.Protocol != nil {
+		protocol = new(p.Protocol.String())
 	}
 
-	return &qovery.ServicePort{
-		Id:                 ToString(port.Id),
-		Name:               ToStringPointer(port.Name),
-		InternalPort:       ToInt32(port.InternalPort),
-		ExternalPort:       ToInt32Pointer(port.ExternalPort),
-		Protocol:           *protocol,
-		PubliclyAccessible: ToBool(port.PubliclyAccessible),
-		IsDefault:          ToBoolPointer(port.IsDefault),
-	}, nil
-}
-
-func servicePortToApplicationPort(p qovery.ServicePort) ApplicationPort {
 	return ApplicationPort{
-		Id:                 FromString(p.Id),
+		Id:                 FromString(p.ID.String()),
 		Name:               FromStringPointer(p.Name),
 		InternalPort:       FromInt32(p.InternalPort),
 		ExternalPort:       FromInt32Pointer(p.ExternalPort),
-		Protocol:           fromClientEnum(p.Protocol),
+		Protocol:           FromStringPointer(protocol),
 		PubliclyAccessible: FromBool(p.PubliclyAccessible),
-		IsDefault:          FromBoolPointer(p.IsDefault),
+		IsDefault:          FromBool(p.IsDefault),
 	}
 }
 
-func convertResponseToApplicationPorts(initialState []ApplicationPort, ports []qovery.ServicePort) []ApplicationPort {
+func convertDomainPortsToApplicationPorts(initialState []ApplicationPort, ports port.Ports) []ApplicationPort {
 	if len(ports) == 0 && initialState == nil {
 		return nil
 	}
@@ -552,11 +398,11 @@ func convertResponseToApplicationPorts(initialState []ApplicationPort, ports []q
 	}
 
 	return reorderPortsPreservingState(
-		ports,
-		func(p qovery.ServicePort) portIdentity {
-			return portIdentity{p.Id, ptrStringValue(p.Name), p.InternalPort}
+		[]port.Port(ports),
+		func(p port.Port) portIdentity {
+			return portIdentity{p.ID.String(), ptrStringValue(p.Name), p.InternalPort}
 		},
-		servicePortToApplicationPort,
+		convertDomainPortToApplicationPort,
 		stateIDs, stateNames,
 	)
 }
@@ -12,8 +12,9 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/qovery"
 )
 
@@ -1417,9 +1418,9 @@ func testAccQoveryApplicationExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("application.id not found")
 		}
 
-		_, apiErr := apiClient.GetApplication(context.TODO(), rs.Primary.ID, "{}", false)
-		if apiErr != nil {
-			return apiErr
+		_, err := qoveryServices.Application.Get(context.TODO(), rs.Primary.ID, "{}", false)
+		if err != nil {
+			return err
 		}
 		return nil
 	}
@@ -1436,12 +1437,12 @@ func testAccQoveryApplicationDestroy(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("application.id not found")
 		}
 
-		_, apiErr := apiClient.GetApplication(context.TODO(), rs.Primary.ID, "{}", false)
-		if apiErr == nil {
+		_, err := qoveryServices.Application.Get(context.TODO(), rs.Primary.ID, "{}", false)
+		if err == nil {
 			return fmt.Errorf("found application but expected it to be deleted")
 		}
-		if !apierrors.IsNotFound(apiErr) {
-			return fmt.Errorf("unexpected error checking for deleted application: %s", apiErr.Summary())
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted application: %s", err.Error())
 		}
 		return nil
 	}
@@ -27,8 +27,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -39,6 +39,7 @@ var (
 	_ resource.ResourceWithImportState    = clusterResource{}
 	_ resource.ResourceWithValidateConfig = clusterResource{}
 	_ resource.ResourceWithModifyPlan     = clusterResource{}
+	_ resource.ResourceWithMoveState      = clusterResource{}
 )
 
 var (
@@ -79,9 +80,35 @@ var (
 	clusterKubernetesModeDefault = string(qovery.KUBERNETESENUM_MANAGED)
 )
 
+// clusterErrorMappings attaches the cluster domain errors to the attribute they originate from.
+var clusterErrorMappings = []attributeErrorMapping{
+	{err: cluster.ErrInvalidCloudProviderParam, path: path.Root("cloud_provider")},
+	{err: cluster.ErrInvalidKubernetesModeParam, path: path.Root("kubernetes_mode")},
+	{err: cluster.ErrInvalidStateParam, path: path.Root("state")},
+	{err: cluster.ErrInvalidRoute, path: path.Root("routing_table")},
+	{err: cluster.ErrInvalidRouteDestinationParam, path: path.Root("routing_table")},
+	{err: cluster.ErrInvalidRouteTargetParam, path: path.Root("routing_table")},
+	{err: cluster.ErrDuplicateRouteDestination, path: path.Root("routing_table")},
+	{err: cluster.ErrPartiallyManagedKubeconfigRequired, path: path.Root("kubeconfig")},
+	{err: cluster.ErrPartiallyManagedKedaNotSupported, path: path.Root("keda")},
+	{err: cluster.ErrPartiallyManagedFeaturesNotSupported, path: path.Root("features")},
+	{err: cluster.ErrPartiallyManagedInfrastructureChartsRequired, path: path.Root("infrastructure_charts_parameters")},
+	{err: cluster.ErrPartiallyManagedIPAddressPoolsRequired, path: path.Root("infrastructure_charts_parameters").AtName("metal_lb_parameters").AtName("ip_address_pools")},
+	{err: cluster.ErrInfrastructureChartsOnlyForPartiallyManaged, path: path.Root("infrastructure_charts_parameters")},
+	{err: cluster.ErrKarpenterRequired, path: path.Root("features").AtName("karpenter")},
+	{err: cluster.ErrKarpenterMigrationNotSupported, path: path.Root("features").AtName("karpenter")},
+	{err: cluster.ErrInvalidKubernetesVersion, path: path.Root("kubernetes_version")},
+	{err: cluster.ErrKubernetesVersionDowngrade, path: path.Root("kubernetes_version")},
+	{err: cluster.ErrKubernetesVersionSkipMinor, path: path.Root("kubernetes_version")},
+	{err: cluster.ErrKubernetesVersionUpgradeRequiresDeployedCluster, path: path.Root("kubernetes_version")},
+	{err: cluster.ErrUnexpectedKubernetesVersion, path: path.Root("kubernetes_version")},
+}
+
+// clusterResource implements qovery_cluster, and the cloud-specific cluster resources when variant is set.
 type clusterResource struct {
-	client                         *client.Client
+	clusterService                 cluster.Service
 	clusterAdvancedSettingsService *advanced_settings.ClusterAdvancedSettingsService
+	variant                        *clusterVariant
 }
 
 func newClusterResource() resource.Resource {
@@ -89,6 +116,10 @@ func newClusterResource() resource.Resource {
 }
 
 func (r clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.variant != nil {
+		resp.TypeName = req.ProviderTypeName + "_" + r.variant.name
+		return
+	}
 	resp.TypeName = req.ProviderTypeName + "_cluster"
 }
 
@@ -107,26 +138,63 @@ func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = provider.client
+	r.clusterService = provider.clusterService
 	r.clusterAdvancedSettingsService = provider.clusterAdvancedSettingsService
 }
 
 // ModifyPlan warns at plan time about advanced_settings_json keys that are not recognized
-// cluster advanced settings, instead of letting them silently no-op.
+// cluster advanced settings, instead of letting them silently no-op, and about the values
+// that may not match the type, range or enum values of their setting.
+// It also refuses the kubernetes_version changes that the upgrade would reject at apply time, and warns on creation
+// about a kubernetes_version other than the default version with which the cluster is created.
 func (r clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	warnUnknownClusterAdvancedSettings(ctx, r.clusterAdvancedSettingsService, req.Config, &resp.Diagnostics)
+	validateClusterAdvancedSettingsValues(ctx, r.clusterAdvancedSettingsService, req.Config, &resp.Diagnostics)
+
+	// Nothing to upgrade on destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		var plan Cluster
+		resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(validateClusterKubernetesVersionCreation(plan.CloudProvider, plan.KubernetesVersion)...)
+		return
+	}
+
+	var plan, state Cluster
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateClusterKubernetesVersionUpgrade(state.KubernetesVersion, plan.KubernetesVersion, plan.State)...)
 }
 
 func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	if r.variant != nil {
+		resp.Schema = r.variant.schema()
+		return
+	}
+	resp.Schema = clusterSchema()
+}
+
+// clusterSchema returns the schema of qovery_cluster, from which the schemas of the cloud-specific cluster resources are derived.
+func clusterSchema() schema.Schema {
 	// TODO (framework-migration): test if Default is OK when modifying the attribute, otherwise we'll need to use a modifier
-	resp.Schema = schema.Schema{
+	return schema.Schema{
 		Description: "Provides a Qovery cluster resource. This can be used to create and manage Qovery cluster.",
 		MarkdownDescription: "Provides a Qovery cluster resource. This is used to create and manage Kubernetes clusters on your chosen cloud provider through Qovery.\n\n" +
 			"Qovery supports clusters on **AWS** (EKS), **GCP** (GKE), **Scaleway** (Kapsule), and **Azure** (AKS). " +
 			"Each cloud provider requires its own credentials resource (e.g., `qovery_aws_credentials`). " +
 			"For AWS clusters, you can optionally enable **Karpenter** for automatic node provisioning or deploy on an **existing VPC**. " +
 			"For GCP clusters, you can use **Autopilot** mode or deploy on an **existing VPC**. " +
-			"AWS also supports **PARTIALLY_MANAGED** mode for EKS Anywhere on-premise clusters.",
+			"AWS also supports **PARTIALLY_MANAGED** mode for EKS Anywhere on-premise clusters.\n\n" +
+			"The cloud-specific resources `qovery_aws_cluster`, `qovery_gcp_cluster`, `qovery_scaleway_cluster`, `qovery_azure_cluster` and `qovery_eks_anywhere_cluster` only expose the attributes supported by their cloud provider. An existing `qovery_cluster` can be migrated to them without being recreated, with a `moved` block (Terraform 1.8 or later). `SELF_MANAGED` clusters are only managed with this resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:         "Id of the cluster.",
@@ -212,7 +280,16 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"instance_type": schema.StringAttribute{
 				Description:         "Instance type of the cluster. I.e: For Aws `t3a.xlarge`, for Scaleway `DEV-L`, and not set for Karpenter-enabled clusters",
-				MarkdownDescription: "Instance type for the cluster nodes. The available values depend on the cloud provider:\n\n  - **AWS**: EC2 instance types (e.g., `t3a.xlarge`, `m5.large`). Not required when Karpenter is enabled.\n  - **GCP**: Machine types or `AUTO_PILOT` for GKE Autopilot mode.\n  - **Scaleway**: Node types (e.g., `DEV1-L`, `GP1-S`).\n  - **Azure**: VM sizes (e.g., `Standard_B2s_v2`, `Standard_D4s_v3`).",
+				MarkdownDescription: "Instance type for the cluster nodes. The available values depend on the cloud provider:\n\n  - **AWS**: EC2 instance types (e.g., `t3a.xlarge`, `m5.large`). Not required when Karpenter is enabled.\n  - **GCP**: Machine types or `AUTO_PILOT` for GKE Autopilot mode.\n  - **Scaleway**: Node types (e.g., `DEV1-L`, `GP1-S`).\n  - **Azure**: VM sizes (e.g., `Standard_B2s_v2`, `Standard_D4s_v3`).\n\nThe instance types that are not available in the region of the cluster are rejected at plan time. Use the `qovery_cluster_instance_types` data source to list them.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kubernetes_version": schema.StringAttribute{
+				Description:         "Kubernetes version of the cluster. When changed, the cluster is upgraded to this version.",
+				MarkdownDescription: "Kubernetes minor version of the cluster (e.g., `1.32`). New clusters are created with the default version of Qovery, the `latest_version` of the `qovery_cluster_kubernetes_versions` data source, and any other version is reported as a warning at plan time on creation, the apply failing once the cluster is created if it cannot be upgraded to it; when set to the next minor version of the cluster, the cluster is upgraded and the apply waits until it is `DEPLOYED` again.\n\nDowngrades and upgrades skipping a minor version are refused at plan time, and an upgrade requires the `state` of the cluster to be `DEPLOYED`. Use the `qovery_cluster_kubernetes_versions` data source to list the supported versions.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -678,6 +755,227 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 											},
 										},
 									},
+									"gpu_override": schema.SingleNestedAttribute{
+										Description:         "Defines the Qovery GPU node pool",
+										MarkdownDescription: "Configuration of the Qovery **GPU** node pool, which runs the services requesting GPUs (e.g., machine learning jobs). The node pool is only created when this block is defined.",
+										Optional:            true,
+										Computed:            false,
+										Attributes: map[string]schema.Attribute{
+											"requirements": schema.ListNestedAttribute{
+												Description:         "List of requirements for the GPU node pool",
+												MarkdownDescription: "List of node selection requirements for the GPU node pool, e.g. the GPU instance families (`g5`, `g6`, `p4d`). When omitted, the defaults of the Qovery API apply.",
+												Optional:            true,
+												Computed:            false,
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"key": schema.StringAttribute{
+															Description:         "The key of the requirement (e.g., InstanceFamily, InstanceSize, Arch)",
+															MarkdownDescription: "The requirement key: `InstanceFamily`, `InstanceSize` or `Arch`.",
+															Required:            true,
+															Computed:            false,
+															Validators: []validator.String{
+																validators.NewStringEnumValidator([]string{"InstanceFamily", "InstanceSize", "Arch"}),
+															},
+														},
+														"operator": schema.StringAttribute{
+															Description:         "The operator for the requirement (e.g., In)",
+															MarkdownDescription: "The operator for the requirement. Currently only `In` is supported.",
+															Required:            true,
+															Computed:            false,
+															Validators: []validator.String{
+																validators.NewStringEnumValidator([]string{"In"}),
+															},
+														},
+														"values": schema.ListAttribute{
+															Description:         "List of values for the requirement",
+															MarkdownDescription: "List of allowed values for the requirement (e.g., `[\"g5\", \"g6\"]` for `InstanceFamily`).",
+															Required:            true,
+															Computed:            false,
+															ElementType:         types.StringType,
+														},
+													},
+												},
+											},
+											"consolidation": schema.SingleNestedAttribute{
+												Description:         "Specifies the period to consolidate GPU nodes",
+												MarkdownDescription: "Node consolidation schedule for the GPU node pool. By default, no consolidation occurs on GPU nodes.",
+												Optional:            true,
+												Computed:            false,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Whether the consolidation schedule is active.",
+														MarkdownDescription: "Whether the consolidation schedule defined here is active.",
+														Required:            true,
+														Computed:            false,
+													},
+													"days": schema.ListAttribute{
+														Description:         "Days of the week when consolidation runs.",
+														MarkdownDescription: "List of days of the week when consolidation should run (e.g., `[\"SATURDAY\", \"SUNDAY\"]`).",
+														Required:            true,
+														Computed:            false,
+														ElementType:         types.StringType,
+													},
+													"start_time": schema.StringAttribute{
+														Description:         "Start time for the consolidation window in ISO-8601 time format.",
+														MarkdownDescription: "Start time for the consolidation window. Must follow the ISO-8601 time format: `PThh:mm` (e.g., `PT02:00`).",
+														Required:            true,
+														Computed:            false,
+													},
+													"duration": schema.StringAttribute{
+														Description:         "Duration of the consolidation window in ISO-8601 duration format.",
+														MarkdownDescription: "Duration of the consolidation window. Must follow the ISO-8601 duration format: `PThhHmmM` (e.g., `PT04H00M`).",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"limits": schema.SingleNestedAttribute{
+												Description:         "Specifies the limits to apply on the GPU node pool",
+												MarkdownDescription: "Resource limits for the GPU node pool. Use this to cap the total resources, GPUs included, Karpenter can provision for GPU workloads.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Enabled the limit",
+														MarkdownDescription: "Whether to enforce resource limits on the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_cpu_in_vcpu": schema.Int64Attribute{
+														Description:         "Maximum number of vCPU cores for the GPU node pool.",
+														MarkdownDescription: "Maximum total vCPU cores that Karpenter can provision for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_memory_in_gibibytes": schema.Int64Attribute{
+														Description:         "Maximum memory in GiB for the GPU node pool.",
+														MarkdownDescription: "Maximum total memory in GiB that Karpenter can provision for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_gpu": schema.Int64Attribute{
+														Description:         "Maximum number of GPUs for the GPU node pool.",
+														MarkdownDescription: "Maximum total number of GPUs that Karpenter can provision for the GPU node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"spot_enabled": schema.BoolAttribute{
+												Description:         "Enable spot instances for the GPU node pool",
+												MarkdownDescription: "Whether to use EC2 Spot instances for the GPU node pool. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            true,
+												PlanModifiers: []planmodifier.Bool{
+													boolplanmodifier.UseStateForUnknown(),
+												},
+											},
+											"disk_size_in_gib": schema.Int64Attribute{
+												Description:         "Disk size in GiB for the GPU nodes.",
+												MarkdownDescription: "Root disk size in GiB for the GPU nodes. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            true,
+												PlanModifiers: []planmodifier.Int64{
+													int64planmodifier.UseStateForUnknown(),
+												},
+											},
+											"disk_iops": schema.Int64Attribute{
+												Description:         "Disk IOPS for the GPU nodes.",
+												MarkdownDescription: "Root disk IOPS (operations per second) for the GPU nodes. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            true,
+												PlanModifiers: []planmodifier.Int64{
+													int64planmodifier.UseStateForUnknown(),
+												},
+											},
+											"disk_throughput": schema.Int64Attribute{
+												Description:         "Disk throughput in MB/s for the GPU nodes.",
+												MarkdownDescription: "Root disk throughput in MB/s for the GPU nodes. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            true,
+												PlanModifiers: []planmodifier.Int64{
+													int64planmodifier.UseStateForUnknown(),
+												},
+											},
+											"consolidate_after": schema.StringAttribute{
+												Description:         "Time to wait before consolidating empty or underutilized GPU nodes.",
+												MarkdownDescription: "Time to wait before consolidating empty or underutilized GPU nodes (e.g., `1m`, `10m`, `1h`). Maximum: `24h`. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            false,
+											},
+										},
+									},
+									"cronjob_override": schema.SingleNestedAttribute{
+										Description:         "Defines some overridden options for Qovery cronjob node pool",
+										MarkdownDescription: "Override options for the Qovery **cronjob** node pool, which runs the cron jobs. Use this to configure consolidation windows, resource limits and the delay before empty nodes are consolidated.",
+										Optional:            true,
+										Computed:            false,
+										Attributes: map[string]schema.Attribute{
+											"consolidation": schema.SingleNestedAttribute{
+												Description:         "Specifies the period to consolidate cronjob nodes",
+												MarkdownDescription: "Node consolidation schedule for the cronjob node pool.",
+												Optional:            true,
+												Computed:            false,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Whether the consolidation schedule is active.",
+														MarkdownDescription: "Whether the consolidation schedule defined here is active.",
+														Required:            true,
+														Computed:            false,
+													},
+													"days": schema.ListAttribute{
+														Description:         "Days of the week when consolidation runs.",
+														MarkdownDescription: "List of days of the week when consolidation should run (e.g., `[\"SATURDAY\", \"SUNDAY\"]`).",
+														Required:            true,
+														Computed:            false,
+														ElementType:         types.StringType,
+													},
+													"start_time": schema.StringAttribute{
+														Description:         "Start time for the consolidation window in ISO-8601 time format.",
+														MarkdownDescription: "Start time for the consolidation window. Must follow the ISO-8601 time format: `PThh:mm` (e.g., `PT02:00`).",
+														Required:            true,
+														Computed:            false,
+													},
+													"duration": schema.StringAttribute{
+														Description:         "Duration of the consolidation window in ISO-8601 duration format.",
+														MarkdownDescription: "Duration of the consolidation window. Must follow the ISO-8601 duration format: `PThhHmmM` (e.g., `PT04H00M`).",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"limits": schema.SingleNestedAttribute{
+												Description:         "Specifies the limits to apply on the cronjob node pool",
+												MarkdownDescription: "Resource limits for the cronjob node pool. Use this to cap the total resources Karpenter can provision for cron jobs.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Description:         "Enabled the limit",
+														MarkdownDescription: "Whether to enforce resource limits on the cronjob node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_cpu_in_vcpu": schema.Int64Attribute{
+														Description:         "Maximum number of vCPU cores for the cronjob node pool.",
+														MarkdownDescription: "Maximum total vCPU cores that Karpenter can provision for the cronjob node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+													"max_memory_in_gibibytes": schema.Int64Attribute{
+														Description:         "Maximum memory in GiB for the cronjob node pool.",
+														MarkdownDescription: "Maximum total memory in GiB that Karpenter can provision for the cronjob node pool.",
+														Required:            true,
+														Computed:            false,
+													},
+												},
+											},
+											"consolidate_after": schema.StringAttribute{
+												Description:         "Time to wait before consolidating empty or underutilized cronjob nodes.",
+												MarkdownDescription: "Time to wait before consolidating empty or underutilized cronjob nodes (e.g., `1m`, `10m`, `1h`). Maximum: `24h`. When omitted, the default of the Qovery API applies.",
+												Optional:            true,
+												Computed:            false,
+											},
+										},
+									},
 								},
 							},
 						},
@@ -717,7 +1015,7 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"routing_table": schema.SetNestedAttribute{
 				Description:         "List of routes of the cluster.",
-				MarkdownDescription: "Custom routing table entries for the cluster VPC. Use this to define network routes for traffic between the cluster and other networks (e.g., VPN, peering connections).",
+				MarkdownDescription: "Custom routing table entries for the cluster VPC. Use this to define network routes for traffic between the cluster and other networks (e.g., VPN, peering connections). Route destinations must be unique: overlapping destinations, e.g. `10.0.0.0/8` and `10.1.0.0/16`, are reported as a warning at plan time, the traffic being routed by the most specific route. Leave it unset to manage the routes with `qovery_cluster_routing_table` or `qovery_cluster_route` instead.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Set{
@@ -1010,13 +1308,13 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 									Required:    true,
 								},
 								"project_id": schema.StringAttribute{
-									Description: "GCP project ID. Required when type is GCP_SECRET_MANAGER.",
+									Description: "GCP project ID. Required when type is GCP_SECRET_MANAGER, must not be set otherwise.",
 									Optional:    true,
 								},
 							},
 						},
 						"authentication": schema.SingleNestedAttribute{
-							Description: "Authentication configuration for the secret manager.",
+							Description: "Authentication configuration for the secret manager. The AWS and GCP authentication types can only be used with an endpoint of the same cloud provider.",
 							Required:    true,
 							Attributes: map[string]schema.Attribute{
 								"type": schema.StringAttribute{
@@ -1027,24 +1325,24 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 									},
 								},
 								"role_arn": schema.StringAttribute{
-									Description: "IAM role ARN. Required when type is AWS_ROLE_ARN.",
+									Description: "IAM role ARN. Required when type is AWS_ROLE_ARN, must not be set otherwise.",
 									Optional:    true,
 								},
 								"region": schema.StringAttribute{
-									Description: "AWS region. Required when type is AWS_STATIC_CREDENTIALS.",
+									Description: "AWS region. Required when type is AWS_STATIC_CREDENTIALS, must not be set otherwise.",
 									Optional:    true,
 								},
 								"access_key": schema.StringAttribute{
-									Description: "AWS access key ID. Required when type is AWS_STATIC_CREDENTIALS.",
+									Description: "AWS access key ID. Required when type is AWS_STATIC_CREDENTIALS, must not be set otherwise.",
 									Optional:    true,
 								},
 								"secret_key": schema.StringAttribute{
-									Description: "AWS secret access key. Required when type is AWS_STATIC_CREDENTIALS.",
+									Description: "AWS secret access key. Required when type is AWS_STATIC_CREDENTIALS, must not be set otherwise.",
 									Optional:    true,
 									Sensitive:   true,
 								},
 								"json_credentials": schema.StringAttribute{
-									Description: "GCP service account JSON credentials. Required when type is GCP_JSON_CREDENTIALS.",
+									Description: "Base64-encoded JSON key of a GCP service account, e.g. `base64encode(file(\"key.json\"))`. Required when type is GCP_JSON_CREDENTIALS, must not be set otherwise.",
 									Optional:    true,
 									Sensitive:   true,
 								},
@@ -1053,6 +1351,7 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionResourceAttribute("cluster"),
 		},
 	}
 }
@@ -1061,7 +1360,7 @@ func (r clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var plan Cluster
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1069,32 +1368,26 @@ func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create new cluster
 	request, err := plan.toUpsertClusterRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster create", err, clusterErrorMappings)
 		return
 	}
-	cluster, apiErr := r.client.CreateCluster(ctx, plan.OrganizationId.ValueString(), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	c, err := r.clusterService.Create(ctx, plan.OrganizationId.ValueString(), *request)
+	if err != nil {
+		savePartiallyCreatedState(ctx, resp, err, "cluster", func(created *cluster.Cluster) any {
+			return r.stateValue(ctx, convertDomainClusterToCluster(ctx, created, plan))
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster create", err, clusterErrorMappings)
 		return
 	}
 
-	// For PARTIALLY_MANAGED clusters, set the kubeconfig
-	if plan.KubernetesMode.ValueString() == "PARTIALLY_MANAGED" && !plan.Kubeconfig.IsNull() && plan.Kubeconfig.ValueString() != "" {
-		apiErr = r.client.SetClusterKubeconfig(ctx, plan.OrganizationId.ValueString(), cluster.ClusterResponse.Id, plan.Kubeconfig.ValueString())
-		if apiErr != nil {
-			resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
-			return
-		}
-	}
-
 	// Initialize state values
-	state := convertResponseToCluster(ctx, cluster, plan)
+	state := convertDomainClusterToCluster(ctx, c, plan)
 
 	// For PARTIALLY_MANAGED clusters, fetch the kubeconfig from API to ensure state matches
-	if plan.KubernetesMode.ValueString() == "PARTIALLY_MANAGED" {
-		kubeconfig, apiErr := r.client.GetClusterKubeconfig(ctx, plan.OrganizationId.ValueString(), cluster.ClusterResponse.Id)
-		if apiErr != nil {
-			tflog.Warn(ctx, "failed to fetch kubeconfig after create", map[string]any{"cluster_id": state.Id.ValueString(), "error": apiErr.Detail()})
+	if c.IsPartiallyManaged() {
+		kubeconfig, err := r.clusterService.GetKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "failed to fetch kubeconfig after create", map[string]any{"cluster_id": state.Id.ValueString(), "error": err.Error()})
 		} else {
 			state.Kubeconfig = types.StringValue(kubeconfig)
 		}
@@ -1103,14 +1396,14 @@ func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created cluster", map[string]any{"cluster_id": state.Id.ValueString()})
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, state)...)
 }
 
 // Read qovery cluster resource
 func (r clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
 	var state Cluster
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1123,19 +1416,25 @@ func (r clusterResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get cluster from the API
-	cluster, apiErr := r.client.GetCluster(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), state.AdvancedSettingsJson.ValueString(), isTriggeredFromImport)
-	if handleReadNotFound(ctx, resp, apiErr) {
+	c, err := r.clusterService.Get(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), state.AdvancedSettingsJson.ValueString(), isTriggeredFromImport)
+	if handleDomainReadNotFound(ctx, resp, err, "Error on cluster read") {
 		return
 	}
+	if r.variant != nil {
+		if err := r.variant.checkCluster(c.CloudProvider.String(), clusterKubernetesModeOf(c)); err != nil {
+			resp.Diagnostics.AddError("Error on cluster read", err.Error())
+			return
+		}
+	}
 
-	state = convertResponseToCluster(ctx, cluster, state)
+	state = convertDomainClusterToCluster(ctx, c, state)
 
 	// For PARTIALLY_MANAGED clusters, fetch the kubeconfig
-	if cluster.ClusterResponse.Kubernetes != nil && *cluster.ClusterResponse.Kubernetes == qovery.KUBERNETESENUM_PARTIALLY_MANAGED {
-		kubeconfig, apiErr := r.client.GetClusterKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
-		if apiErr != nil {
+	if c.IsPartiallyManaged() {
+		kubeconfig, err := r.clusterService.GetKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+		if err != nil {
 			// Log warning but don't fail - kubeconfig might not be set yet
-			tflog.Warn(ctx, "failed to fetch kubeconfig for PARTIALLY_MANAGED cluster", map[string]any{"cluster_id": state.Id.ValueString(), "error": apiErr.Detail()})
+			tflog.Warn(ctx, "failed to fetch kubeconfig for PARTIALLY_MANAGED cluster", map[string]any{"cluster_id": state.Id.ValueString(), "error": err.Error()})
 		} else {
 			state.Kubeconfig = types.StringValue(kubeconfig)
 		}
@@ -1144,15 +1443,16 @@ func (r clusterResource) Read(ctx context.Context, req resource.ReadRequest, res
 	tflog.Trace(ctx, "read cluster", map[string]any{"cluster_id": state.Id.ValueString()})
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, state)...)
 }
 
 // Update qovery cluster resource
 func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan and current state
-	var plan, state Cluster
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Get plan, config and current state
+	var plan, config, state Cluster
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.Config, &config)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1160,35 +1460,28 @@ func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update cluster in the backend
 	request, err := plan.toUpsertClusterRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster update", err, clusterErrorMappings)
 		return
 	}
-	cluster, apiErr := r.client.UpdateCluster(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	c, err := r.clusterService.Update(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), *request)
+	if err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster update", err, clusterErrorMappings)
 		return
 	}
 
-	// For PARTIALLY_MANAGED clusters, update the kubeconfig if changed
-	if plan.KubernetesMode.ValueString() == "PARTIALLY_MANAGED" && !plan.Kubeconfig.IsNull() && plan.Kubeconfig.ValueString() != "" {
-		// Only update if kubeconfig has changed
-		if state.Kubeconfig.IsNull() || plan.Kubeconfig.ValueString() != state.Kubeconfig.ValueString() {
-			apiErr = r.client.SetClusterKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), plan.Kubeconfig.ValueString())
-			if apiErr != nil {
-				resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
-				return
-			}
-		}
-	}
-
 	// Update state values
-	state = convertResponseToCluster(ctx, cluster, plan)
+	state = convertDomainClusterToCluster(ctx, c, plan)
+	// An unconfigured routing_table keeps its planned value: the routes may be managed with
+	// qovery_cluster_routing_table or qovery_cluster_route, and are refreshed on the next read.
+	if config.RoutingTables.IsNull() && !plan.RoutingTables.IsUnknown() && !c.IsPartiallyManaged() {
+		state.RoutingTables = plan.RoutingTables
+	}
 
 	// For PARTIALLY_MANAGED clusters, fetch the kubeconfig from API to ensure state matches
-	if plan.KubernetesMode.ValueString() == "PARTIALLY_MANAGED" {
-		kubeconfig, apiErr := r.client.GetClusterKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
-		if apiErr != nil {
-			tflog.Warn(ctx, "failed to fetch kubeconfig after update", map[string]any{"cluster_id": state.Id.ValueString(), "error": apiErr.Detail()})
+	if c.IsPartiallyManaged() {
+		kubeconfig, err := r.clusterService.GetKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "failed to fetch kubeconfig after update", map[string]any{"cluster_id": state.Id.ValueString(), "error": err.Error()})
 		} else {
 			state.Kubeconfig = types.StringValue(kubeconfig)
 		}
@@ -1197,22 +1490,25 @@ func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated cluster", map[string]any{"cluster_id": state.Id.ValueString()})
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, state)...)
 }
 
 // Delete qovery cluster resource
 func (r clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Get current state
 	var state Cluster
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if checkDeletionProtection(&resp.Diagnostics, state.DeletionProtection, "cluster", state.Id.ValueString()) {
+		return
+	}
+
 	// Delete cluster
-	apiErr := r.client.DeleteCluster(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	if err := r.clusterService.Delete(ctx, state.OrganizationId.ValueString(), state.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error on cluster delete", err.Error())
 		return
 	}
 
@@ -1241,13 +1537,87 @@ func (r clusterResource) ImportState(ctx context.Context, req resource.ImportSta
 // ValidateConfig performs plan-time cross-attribute validation for the cluster resource.
 func (r clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config Cluster
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(r.getModel(ctx, req.Config, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(validateNatGatewaysConfig(config.CloudProvider, config.Features)...)
 	resp.Diagnostics.Append(validateGkeKmsKeyConfig(config.CloudProvider, config.Features)...)
+	resp.Diagnostics.Append(validateClusterInstanceTypeConfig(config.CloudProvider, config.Region, config.InstanceType)...)
+	resp.Diagnostics.Append(validateClusterKubernetesVersionConfig(config.KubernetesVersion)...)
+	resp.Diagnostics.Append(validateClusterRoutesConfig(path.Root("routing_table"), config.RoutingTables)...)
+	resp.Diagnostics.Append(validateSecretManagerAccessesConfig(config.SecretManagerAccesses)...)
+}
+
+// validateClusterKubernetesVersionConfig validates that kubernetes_version is a kubernetes version such as `1.32`.
+func validateClusterKubernetesVersionConfig(kubernetesVersion types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if kubernetesVersion.IsNull() || kubernetesVersion.IsUnknown() {
+		return diags
+	}
+
+	if _, err := cluster.NewKubernetesVersionFromString(kubernetesVersion.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("kubernetes_version"), "Invalid kubernetes_version", err.Error())
+	}
+
+	return diags
+}
+
+// validateClusterKubernetesVersionCreation warns when a new cluster is not given the default kubernetes version of its
+// cloud provider, as the Qovery API creates the clusters with it and a cluster cannot be downgraded afterwards.
+// It is not an error, as the supported versions are a snapshot that may lag behind the API: the version is checked
+// against the one the cluster is created with once the cluster exists.
+func validateClusterKubernetesVersionCreation(cloudProvider types.String, planVersion types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range []types.String{cloudProvider, planVersion} {
+		if v.IsNull() || v.IsUnknown() {
+			return diags
+		}
+	}
+
+	if err := cluster.ValidateKubernetesVersionCreation(cluster.CloudProvider(cloudProvider.ValueString()), planVersion.ValueString()); err != nil {
+		diags.AddAttributeWarning(
+			path.Root("kubernetes_version"),
+			"Possibly invalid kubernetes_version",
+			fmt.Sprintf("%s. The apply fails once the cluster is created if it cannot be upgraded to this version. Omit kubernetes_version on creation, or use the latest_version of the qovery_cluster_kubernetes_versions data source.", err.Error()),
+		)
+	}
+
+	return diags
+}
+
+// validateClusterKubernetesVersionUpgrade refuses at plan time the kubernetes_version changes that the upgrade would
+// reject: downgrades, upgrades skipping a minor version, and upgrades of a cluster whose desired state is not DEPLOYED.
+// Unknown values, e.g. a version coming from another resource, are left to the apply.
+func validateClusterKubernetesVersionUpgrade(stateVersion types.String, planVersion types.String, planState types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range []types.String{stateVersion, planVersion} {
+		if v.IsNull() || v.IsUnknown() {
+			return diags
+		}
+	}
+	if cluster.IsSameKubernetesVersion(stateVersion.ValueString(), planVersion.ValueString()) {
+		return diags
+	}
+
+	if err := cluster.ValidateKubernetesVersionUpgrade(stateVersion.ValueString(), planVersion.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("kubernetes_version"), "Invalid kubernetes_version upgrade", err.Error())
+		return diags
+	}
+
+	if !planState.IsUnknown() && planState.ValueString() != cluster.StateDeployed.String() {
+		diags.AddAttributeError(
+			path.Root("kubernetes_version"),
+			"Invalid kubernetes_version upgrade",
+			fmt.Sprintf("%s: the state of the cluster is planned to be %s.", cluster.ErrKubernetesVersionUpgradeRequiresDeployedCluster, planState.ValueString()),
+		)
+	}
+
+	return diags
 }
 
 // validateGkeKmsKeyConfig validates that gke_kms_key is only set on GCP clusters.
@@ -3,6 +3,7 @@ package qovery
 import (
 	"context"
 	"fmt"
+	"net/mail"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -102,8 +103,8 @@ func (r clusterDNSProviderResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 			"cloudflare": schema.SingleNestedAttribute{
-				Description:         "Cloudflare DNS provider configuration. Required when provider_type is CLOUDFLARE.",
-				MarkdownDescription: "Cloudflare DNS provider configuration. Required when `provider_type` is `CLOUDFLARE`.",
+				Description:         "Cloudflare DNS provider configuration. Required when provider_type is CLOUDFLARE, must not be set otherwise.",
+				MarkdownDescription: "Cloudflare DNS provider configuration. Required when `provider_type` is `CLOUDFLARE`, must not be set otherwise.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"email": schema.StringAttribute{
@@ -127,8 +128,8 @@ func (r clusterDNSProviderResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"route53": schema.SingleNestedAttribute{
-				Description:         "Route53 DNS provider configuration. Required when provider_type is ROUTE53.",
-				MarkdownDescription: "Route53 DNS provider configuration. Required when `provider_type` is `ROUTE53`.",
+				Description:         "Route53 DNS provider configuration. Required when provider_type is ROUTE53, must not be set otherwise.",
+				MarkdownDescription: "Route53 DNS provider configuration. Required when `provider_type` is `ROUTE53`, must not be set otherwise.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"credentials": schema.SingleNestedAttribute{
@@ -183,10 +184,49 @@ func (r clusterDNSProviderResource) ValidateConfig(ctx context.Context, req reso
 	if config.ProviderType.IsUnknown() || config.ProviderType.IsNull() {
 		return
 	}
+	providerType := config.ProviderType.ValueString()
+
+	// Exactly the configuration block of the provider type must be set.
+	blocks := []struct {
+		name         string
+		providerType string
+		set          bool
+	}{
+		{name: "cloudflare", providerType: clusterDNSProviderTypeCloudflare, set: config.Cloudflare != nil},
+		{name: "route53", providerType: clusterDNSProviderTypeRoute53, set: config.Route53 != nil},
+	}
+	for _, block := range blocks {
+		switch {
+		case block.providerType == providerType && !block.set:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block.name),
+				"Missing required attribute",
+				fmt.Sprintf("%s must be set when provider_type is %s.", block.name, providerType),
+			)
+		case block.providerType != providerType && block.set:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block.name),
+				"Conflicting attribute",
+				fmt.Sprintf("%s must not be set when provider_type is %s.", block.name, providerType),
+			)
+		}
+	}
 
-	switch config.ProviderType.ValueString() {
+	switch providerType {
 	case clusterDNSProviderTypeCloudflare:
-		if config.Cloudflare != nil && (config.Cloudflare.APIToken.IsNull() || config.Cloudflare.APIToken.ValueString() == "") {
+		if config.Cloudflare == nil {
+			return
+		}
+		if !config.Cloudflare.Email.IsUnknown() {
+			if _, err := mail.ParseAddress(config.Cloudflare.Email.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("cloudflare").AtName("email"),
+					"Invalid attribute value",
+					fmt.Sprintf("cloudflare.email must be an email address: %s.", err),
+				)
+			}
+		}
+		if !config.Cloudflare.APIToken.IsUnknown() && config.Cloudflare.APIToken.ValueString() == "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("cloudflare").AtName("api_token"),
 				"Missing required attribute",
@@ -195,7 +235,7 @@ func (r clusterDNSProviderResource) ValidateConfig(ctx context.Context, req reso
 		}
 	case clusterDNSProviderTypeRoute53:
 		if config.Route53 != nil && config.Route53.Credentials != nil {
-			if config.Route53.Credentials.AWSSecretAccessKey.IsNull() || config.Route53.Credentials.AWSSecretAccessKey.ValueString() == "" {
+			if !config.Route53.Credentials.AWSSecretAccessKey.IsUnknown() && config.Route53.Credentials.AWSSecretAccessKey.ValueString() == "" {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("route53").AtName("credentials").AtName("aws_secret_access_key"),
 					"Missing required attribute",
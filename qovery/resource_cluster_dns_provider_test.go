@@ -7,7 +7,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,3 +45,122 @@ func TestClusterDNSProviderResourceIsRegistered(t *testing.T) {
 
 	assert.True(t, found)
 }
+
+func TestClusterDNSProviderValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := clusterDNSProviderResource{}
+	var s resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &s)
+	require.False(t, s.Diagnostics.HasError(), s.Diagnostics)
+
+	attributeTypes := s.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes
+	cloudflareType := attributeTypes["cloudflare"].(tftypes.Object)
+	route53Type := attributeTypes["route53"].(tftypes.Object)
+	cloudflare := func(email string, apiToken tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(cloudflareType, map[string]tftypes.Value{
+			"email":     tftypes.NewValue(tftypes.String, email),
+			"api_token": apiToken,
+			"proxied":   tftypes.NewValue(tftypes.Bool, nil),
+		})
+	}
+	route53 := tftypes.NewValue(route53Type, map[string]tftypes.Value{
+		"credentials": tftypes.NewValue(route53Type.AttributeTypes["credentials"], map[string]tftypes.Value{
+			"type":                  tftypes.NewValue(tftypes.String, clusterDNSProviderCredentialsStatic),
+			"aws_access_key_id":     tftypes.NewValue(tftypes.String, "access-key"),
+			"aws_secret_access_key": tftypes.NewValue(tftypes.String, "secret-key"),
+		}),
+		"aws_region":     tftypes.NewValue(tftypes.String, "eu-west-3"),
+		"hosted_zone_id": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := []struct {
+		name         string
+		providerType string
+		cloudflare   tftypes.Value
+		route53      tftypes.Value
+		expectedPath *path.Path
+	}{
+		{
+			name:         "qovery",
+			providerType: clusterDNSProviderTypeQovery,
+			cloudflare:   tftypes.NewValue(cloudflareType, nil),
+			route53:      tftypes.NewValue(route53Type, nil),
+		},
+		{
+			name:         "cloudflare",
+			providerType: clusterDNSProviderTypeCloudflare,
+			cloudflare:   cloudflare("admin@example.com", tftypes.NewValue(tftypes.String, "token")),
+			route53:      tftypes.NewValue(route53Type, nil),
+		},
+		{
+			name:         "cloudflare_unknown_api_token",
+			providerType: clusterDNSProviderTypeCloudflare,
+			cloudflare:   cloudflare("admin@example.com", tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			route53:      tftypes.NewValue(route53Type, nil),
+		},
+		{
+			name:         "route53",
+			providerType: clusterDNSProviderTypeRoute53,
+			cloudflare:   tftypes.NewValue(cloudflareType, nil),
+			route53:      route53,
+		},
+		{
+			name:         "qovery_with_route53",
+			providerType: clusterDNSProviderTypeQovery,
+			cloudflare:   tftypes.NewValue(cloudflareType, nil),
+			route53:      route53,
+			expectedPath: new(path.Root("route53")),
+		},
+		{
+			name:         "cloudflare_without_cloudflare",
+			providerType: clusterDNSProviderTypeCloudflare,
+			cloudflare:   tftypes.NewValue(cloudflareType, nil),
+			route53:      tftypes.NewValue(route53Type, nil),
+			expectedPath: new(path.Root("cloudflare")),
+		},
+		{
+			name:         "cloudflare_invalid_email",
+			providerType: clusterDNSProviderTypeCloudflare,
+			cloudflare:   cloudflare("admin", tftypes.NewValue(tftypes.String, "token")),
+			route53:      tftypes.NewValue(route53Type, nil),
+			expectedPath: new(path.Root("cloudflare").AtName("email")),
+		},
+		{
+			name:         "cloudflare_missing_api_token",
+			providerType: clusterDNSProviderTypeCloudflare,
+			cloudflare:   cloudflare("admin@example.com", tftypes.NewValue(tftypes.String, nil)),
+			route53:      tftypes.NewValue(route53Type, nil),
+			expectedPath: new(path.Root("cloudflare").AtName("api_token")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tftypes.NewValue(s.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, nil),
+				"cluster_id":    tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+				"provider_type": tftypes.NewValue(tftypes.String, tc.providerType),
+				"domain":        tftypes.NewValue(tftypes.String, "example.com"),
+				"cloudflare":    tc.cloudflare,
+				"route53":       tc.route53,
+			})
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: config},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if tc.expectedPath == nil {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Equal(t, tc.expectedPath.String(), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+		})
+	}
+}
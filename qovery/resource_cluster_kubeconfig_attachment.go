@@ -0,0 +1,255 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = &clusterKubeconfigAttachmentResource{}
+	_ resource.ResourceWithImportState    = clusterKubeconfigAttachmentResource{}
+	_ resource.ResourceWithValidateConfig = clusterKubeconfigAttachmentResource{}
+	_ resource.ResourceWithModifyPlan     = clusterKubeconfigAttachmentResource{}
+)
+
+type clusterKubeconfigAttachmentResource struct {
+	clusterService cluster.Service
+}
+
+func newClusterKubeconfigAttachmentResource() resource.Resource {
+	return &clusterKubeconfigAttachmentResource{}
+}
+
+func (r clusterKubeconfigAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_kubeconfig_attachment"
+}
+
+func (r *clusterKubeconfigAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clusterService = provider.clusterService
+}
+
+func (r clusterKubeconfigAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Qovery cluster kubeconfig attachment resource. This can be used to set the kubeconfig Qovery uses to connect to a self-managed cluster.",
+		MarkdownDescription: "Provides a Qovery cluster kubeconfig attachment resource. This can be used to set the kubeconfig Qovery uses to connect to a self-managed cluster.\n\n" +
+			"The kubeconfig is validated before being sent: every context must reference a cluster with an `https` server URL and a user with an authentication method (token, client certificate and key, username and password, `exec` or `auth-provider`). " +
+			"Its SHA-256 checksum is tracked in `kubeconfig_sha256`, so a kubeconfig changed outside of Terraform is set again on the next apply. " +
+			"Use `kubeconfig_wo` (Terraform 1.11 or later) to keep the kubeconfig out of the state.\n\n" +
+			"Do not use this resource together with the `kubeconfig` attribute of `qovery_cluster` on the same cluster. " +
+			"Qovery has no API to remove the kubeconfig of a cluster: destroying this resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the cluster kubeconfig attachment. This is the cluster id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Id of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "Id of the cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"kubeconfig": schema.StringAttribute{
+				Description:         "Kubeconfig YAML content of the cluster. It is stored in the Terraform state. Exactly one of kubeconfig and kubeconfig_wo must be set.",
+				MarkdownDescription: "Kubeconfig YAML content of the cluster. It is stored in the Terraform state. Exactly one of `kubeconfig` and `kubeconfig_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("kubeconfig_wo")),
+				},
+			},
+			"kubeconfig_wo": schema.StringAttribute{
+				Description:         "Write-only kubeconfig YAML content of the cluster. It is never stored in the Terraform state. Exactly one of kubeconfig and kubeconfig_wo must be set.",
+				MarkdownDescription: "Write-only kubeconfig YAML content of the cluster. It is never stored in the Terraform state. Exactly one of `kubeconfig` and `kubeconfig_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"kubeconfig_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the kubeconfig known by Qovery, ignoring its formatting.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the structure of the kubeconfig at plan time, without connecting to the cluster.
+func (r clusterKubeconfigAttachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeconfigPath := path.Root("kubeconfig")
+	if !config.KubeconfigWo.IsNull() {
+		kubeconfigPath = path.Root("kubeconfig_wo")
+	}
+
+	kubeconfig := config.configuredKubeconfig()
+	if kubeconfig.IsNull() || kubeconfig.IsUnknown() {
+		return
+	}
+
+	if err := cluster.ValidateKubeconfig(kubeconfig.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(kubeconfigPath, "Invalid kubeconfig", err.Error())
+	}
+}
+
+// ModifyPlan plans the checksum of the configured kubeconfig, so that an update is planned whenever it differs from the kubeconfig known by Qovery.
+func (r clusterKubeconfigAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("kubeconfig_sha256"), config.kubeconfigSha256())...)
+}
+
+// Create qovery cluster kubeconfig attachment resource
+func (r clusterKubeconfigAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan and config, write-only attributes are only available in the config
+	var plan, config ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the kubeconfig of the cluster
+	if err := r.clusterService.SetKubeconfig(ctx, plan.OrganizationId.ValueString(), plan.ClusterId.ValueString(), config.configuredKubeconfig().ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error on cluster kubeconfig attachment create", err.Error())
+		return
+	}
+
+	state := plan
+	state.Id = plan.ClusterId
+	state.KubeconfigWo = types.StringNull()
+	state.KubeconfigSha256 = config.kubeconfigSha256()
+	tflog.Trace(ctx, "created cluster kubeconfig attachment", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery cluster kubeconfig attachment resource
+func (r clusterKubeconfigAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the kubeconfig known by Qovery, only its checksum is kept
+	kubeconfig, err := r.clusterService.GetKubeconfig(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+	if handleDomainReadNotFound(ctx, resp, err, "Error on cluster kubeconfig attachment read") {
+		return
+	}
+
+	state.ClusterId = state.Id
+	state.KubeconfigSha256 = types.StringValue(cluster.KubeconfigSHA256(kubeconfig))
+	tflog.Trace(ctx, "read cluster kubeconfig attachment", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update qovery cluster kubeconfig attachment resource
+func (r clusterKubeconfigAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and config, write-only attributes are only available in the config
+	var plan, config ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the kubeconfig of the cluster
+	if err := r.clusterService.SetKubeconfig(ctx, plan.OrganizationId.ValueString(), plan.Id.ValueString(), config.configuredKubeconfig().ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error on cluster kubeconfig attachment update", err.Error())
+		return
+	}
+
+	state := plan
+	state.KubeconfigWo = types.StringNull()
+	state.KubeconfigSha256 = config.kubeconfigSha256()
+	tflog.Trace(ctx, "updated cluster kubeconfig attachment", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete qovery cluster kubeconfig attachment resource
+func (r clusterKubeconfigAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ClusterKubeconfigAttachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Qovery has no API to remove the kubeconfig of a cluster, the resource is only removed from the state
+	tflog.Trace(ctx, "removed cluster kubeconfig attachment from Terraform state", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery cluster kubeconfig attachment resource using its organization id and cluster id
+func (r clusterKubeconfigAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id,cluster_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+}
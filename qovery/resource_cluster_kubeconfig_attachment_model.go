@@ -0,0 +1,35 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// ClusterKubeconfigAttachment represents the Terraform model for the cluster kubeconfig attachment resource.
+type ClusterKubeconfigAttachment struct {
+	Id               types.String `tfsdk:"id"`
+	OrganizationId   types.String `tfsdk:"organization_id"`
+	ClusterId        types.String `tfsdk:"cluster_id"`
+	Kubeconfig       types.String `tfsdk:"kubeconfig"`
+	KubeconfigWo     types.String `tfsdk:"kubeconfig_wo"`
+	KubeconfigSha256 types.String `tfsdk:"kubeconfig_sha256"`
+}
+
+// configuredKubeconfig returns the kubeconfig set with either kubeconfig or kubeconfig_wo.
+// The returned value is unknown when the kubeconfig is not known yet, and null when none is set.
+func (a ClusterKubeconfigAttachment) configuredKubeconfig() types.String {
+	if !a.KubeconfigWo.IsNull() {
+		return a.KubeconfigWo
+	}
+	return a.Kubeconfig
+}
+
+// kubeconfigSha256 returns the checksum of the configured kubeconfig, or an unknown value if it is not known yet.
+func (a ClusterKubeconfigAttachment) kubeconfigSha256() types.String {
+	kubeconfig := a.configuredKubeconfig()
+	if kubeconfig.IsNull() || kubeconfig.IsUnknown() {
+		return types.StringUnknown()
+	}
+	return types.StringValue(cluster.KubeconfigSHA256(kubeconfig.ValueString()))
+}
@@ -0,0 +1,170 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+const testAttachedKubeconfig = `clusters:
+  - name: my-cluster
+    cluster:
+      server: https://10.0.0.1:6443
+contexts:
+  - name: my-context
+    context:
+      cluster: my-cluster
+      user: my-user
+users:
+  - name: my-user
+    user:
+      token: my-token
+`
+
+// newTestClusterKubeconfigAttachmentValue returns the terraform value of a qovery_cluster_kubeconfig_attachment with the given kubeconfigs.
+func newTestClusterKubeconfigAttachmentValue(t *testing.T, s resource.SchemaResponse, kubeconfig tftypes.Value, kubeconfigWo tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	return tftypes.NewValue(s.Schema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, nil),
+		"organization_id":   tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+		"cluster_id":        tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000002"),
+		"kubeconfig":        kubeconfig,
+		"kubeconfig_wo":     kubeconfigWo,
+		"kubeconfig_sha256": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+}
+
+func TestClusterKubeconfigAttachment_ValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := clusterKubeconfigAttachmentResource{}
+	var s resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &s)
+	require.False(t, s.Diagnostics.HasError(), s.Diagnostics)
+
+	testCases := []struct {
+		name          string
+		kubeconfig    tftypes.Value
+		kubeconfigWo  tftypes.Value
+		expectedError *path.Path
+	}{
+		{
+			name:         "valid_kubeconfig",
+			kubeconfig:   tftypes.NewValue(tftypes.String, testAttachedKubeconfig),
+			kubeconfigWo: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:         "unknown_kubeconfig_wo",
+			kubeconfig:   tftypes.NewValue(tftypes.String, nil),
+			kubeconfigWo: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		{
+			name:          "invalid_kubeconfig",
+			kubeconfig:    tftypes.NewValue(tftypes.String, "apiVersion: v1"),
+			kubeconfigWo:  tftypes.NewValue(tftypes.String, nil),
+			expectedError: new(path.Root("kubeconfig")),
+		},
+		{
+			name:          "invalid_kubeconfig_wo",
+			kubeconfig:    tftypes.NewValue(tftypes.String, nil),
+			kubeconfigWo:  tftypes.NewValue(tftypes.String, "apiVersion: v1"),
+			expectedError: new(path.Root("kubeconfig_wo")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: newTestClusterKubeconfigAttachmentValue(t, s, tc.kubeconfig, tc.kubeconfigWo)},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if tc.expectedError == nil {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), cluster.ErrInvalidKubeconfig.Error())
+			assert.Equal(t, tc.expectedError.String(), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+		})
+	}
+}
+
+func TestClusterKubeconfigAttachment_ModifyPlan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := clusterKubeconfigAttachmentResource{}
+	var s resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &s)
+	require.False(t, s.Diagnostics.HasError(), s.Diagnostics)
+
+	testCases := []struct {
+		name             string
+		kubeconfig       tftypes.Value
+		kubeconfigWo     tftypes.Value
+		expectedChecksum types.String
+	}{
+		{
+			name:             "kubeconfig",
+			kubeconfig:       tftypes.NewValue(tftypes.String, testAttachedKubeconfig),
+			kubeconfigWo:     tftypes.NewValue(tftypes.String, nil),
+			expectedChecksum: types.StringValue(cluster.KubeconfigSHA256(testAttachedKubeconfig)),
+		},
+		{
+			name:             "kubeconfig_wo",
+			kubeconfig:       tftypes.NewValue(tftypes.String, nil),
+			kubeconfigWo:     tftypes.NewValue(tftypes.String, testAttachedKubeconfig),
+			expectedChecksum: types.StringValue(cluster.KubeconfigSHA256(testAttachedKubeconfig)),
+		},
+		{
+			name:             "unknown_kubeconfig",
+			kubeconfig:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			kubeconfigWo:     tftypes.NewValue(tftypes.String, nil),
+			expectedChecksum: types.StringUnknown(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newTestClusterKubeconfigAttachmentValue(t, s, tc.kubeconfig, tc.kubeconfigWo)
+			// Write-only attributes are always null in the plan.
+			plan := newTestClusterKubeconfigAttachmentValue(t, s, tc.kubeconfig, tftypes.NewValue(tftypes.String, nil))
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s.Schema, Raw: plan},
+				State:  tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var checksum types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("kubeconfig_sha256"), &checksum)...)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tc.expectedChecksum, checksum)
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
 const (
@@ -51,6 +51,7 @@ type Cluster struct {
 	Region                         types.String `tfsdk:"region"`
 	Description                    types.String `tfsdk:"description"`
 	KubernetesMode                 types.String `tfsdk:"kubernetes_mode"`
+	KubernetesVersion              types.String `tfsdk:"kubernetes_version"`
 	InstanceType                   types.String `tfsdk:"instance_type"`
 	DiskSize                       types.Int64  `tfsdk:"disk_size"`
 	MinRunningNodes                types.Int64  `tfsdk:"min_running_nodes"`
@@ -66,6 +67,7 @@ type Cluster struct {
 	InfrastructureChartsParameters types.Object `tfsdk:"infrastructure_charts_parameters"`
 	LabelsGroupIds                 types.Set    `tfsdk:"labels_group_ids"`
 	SecretManagerAccesses          types.Set    `tfsdk:"secret_manager_accesses"`
+	DeletionProtection             types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (c Cluster) hasFeaturesDiff(state *Cluster) bool {
@@ -106,12 +108,12 @@ func (c Cluster) hasKedaDiff(state *Cluster) bool {
 }
 
 func (c Cluster) hasRoutingTableDiff(state *Cluster) bool {
-	clusterRoutes := toClusterRouteList(c.RoutingTables).toUpsertRequest().Routes
+	clusterRoutes := toClusterRouteList(c.RoutingTables).toDomainRoutingTable()
 	if state == nil {
 		return len(clusterRoutes) > 0
 	}
 
-	stateRoutes := toClusterRouteList(state.RoutingTables).toUpsertRequest().Routes
+	stateRoutes := toClusterRouteList(state.RoutingTables).toDomainRoutingTable()
 	if len(clusterRoutes) != len(stateRoutes) {
 		return true
 	}
@@ -179,160 +181,35 @@ func (c Cluster) hasClusterSpecDiff(state *Cluster) bool {
 		!c.LabelsGroupIds.Equal(state.LabelsGroupIds)
 }
 
-func (c Cluster) toUpsertClusterRequest(state *Cluster) (*client.ClusterUpsertParams, error) {
-	cloudProvider, err := qovery.NewCloudProviderEnumFromValue(ToString(c.CloudProvider))
-	if err != nil {
-		return nil, err
-	}
-	cloudVendor, err := qovery.NewCloudVendorEnumFromValue(ToString(c.CloudProvider))
-	if err != nil {
-		return nil, err
-	}
-
-	kubernetesMode, err := qovery.NewKubernetesEnumFromValue(ToString(c.KubernetesMode))
-	if err != nil {
-		return nil, err
-	}
-
-	routingTable := toClusterRouteList(c.RoutingTables)
-
-	// Handle PARTIALLY_MANAGED (EKS Anywhere) mode validations
-	isPartiallyManaged := kubernetesMode != nil && *kubernetesMode == qovery.KUBERNETESENUM_PARTIALLY_MANAGED
+func (c Cluster) toUpsertClusterRequest(state *Cluster) (*cluster.UpsertServiceRequest, error) {
+	isPartiallyManaged := ToString(c.KubernetesMode) == cluster.KubernetesModePartiallyManaged.String()
 
 	// Convert infrastructure charts parameters
 	var infraChartsParams *qovery.ClusterInfrastructureChartsParameters
 	if !c.InfrastructureChartsParameters.IsNull() && !c.InfrastructureChartsParameters.IsUnknown() {
+		var err error
 		infraChartsParams, err = toQoveryInfrastructureChartsParameters(c.InfrastructureChartsParameters)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to parse infrastructure_charts_parameters")
 		}
 	}
 
-	// Validation for PARTIALLY_MANAGED mode
-	if isPartiallyManaged {
-		// kubeconfig is required for PARTIALLY_MANAGED
-		if c.Kubeconfig.IsNull() || c.Kubeconfig.IsUnknown() || c.Kubeconfig.ValueString() == "" {
-			return nil, errors.New("kubeconfig is required when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
-		}
-
-		// keda is not applicable for PARTIALLY_MANAGED: convertResponseToCluster forces it
-		// to null, so a config-set value would yield "inconsistent result after apply".
-		if toQoveryClusterKeda(c.Keda) != nil {
-			return nil, errors.New("keda is not supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
-		}
-
-		// infrastructure_charts_parameters is required for PARTIALLY_MANAGED
-		if infraChartsParams == nil {
-			return nil, errors.New("infrastructure_charts_parameters is required when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
-		}
-
-		// Validate that metal_lb_parameters.ip_address_pools is not empty
-		if infraChartsParams.MetalLbParameters == nil || len(infraChartsParams.MetalLbParameters.IpAddressPools) == 0 {
-			return nil, errors.New("infrastructure_charts_parameters.metal_lb_parameters.ip_address_pools is required and must not be empty for PARTIALLY_MANAGED mode")
-		}
-
-		// Features are not allowed for PARTIALLY_MANAGED mode
-		if !c.Features.IsNull() && !c.Features.IsUnknown() {
-			featuresAttrs := c.Features.Attributes()
-			// Check if any feature is actually set (not just defaults)
-			hasNonDefaultFeatures := false
-
-			if vpcSubnet, ok := featuresAttrs[featureKeyVpcSubnet]; ok {
-				if !vpcSubnet.IsNull() && !vpcSubnet.IsUnknown() {
-					vpcSubnetStr := vpcSubnet.(types.String).ValueString()
-					if vpcSubnetStr != "" && vpcSubnetStr != clusterFeatureVpcSubnetDefault {
-						hasNonDefaultFeatures = true
-					}
-				}
-			}
-			if staticIP, ok := featuresAttrs[featureKeyStaticIP]; ok {
-				if !staticIP.IsNull() && !staticIP.IsUnknown() && staticIP.(types.Bool).ValueBool() {
-					hasNonDefaultFeatures = true
-				}
-			}
-			if existingVpc, ok := featuresAttrs[featureKeyExistingVpc]; ok {
-				if !existingVpc.IsNull() && !existingVpc.IsUnknown() {
-					// Check if existing_vpc has actual content (aws_vpc_eks_id is required)
-					existingVpcObj := existingVpc.(types.Object)
-					if !existingVpcObj.IsNull() && len(existingVpcObj.Attributes()) > 0 {
-						if vpcId, hasVpcId := existingVpcObj.Attributes()["aws_vpc_eks_id"]; hasVpcId {
-							if !vpcId.IsNull() && !vpcId.IsUnknown() {
-								hasNonDefaultFeatures = true
-							}
-						}
-					}
-				}
-			}
-			if karpenter, ok := featuresAttrs[featureKeyKarpenter]; ok {
-				if !karpenter.IsNull() && !karpenter.IsUnknown() {
-					// Check if karpenter has actual content (spot_enabled is required)
-					karpenterObj := karpenter.(types.Object)
-					if !karpenterObj.IsNull() && len(karpenterObj.Attributes()) > 0 {
-						if spotEnabled, hasSpot := karpenterObj.Attributes()["spot_enabled"]; hasSpot {
-							if !spotEnabled.IsNull() && !spotEnabled.IsUnknown() {
-								hasNonDefaultFeatures = true
-							}
-						}
-					}
-				}
-			}
-			if gkeKmsKey, ok := featuresAttrs[featureKeyGkeKmsKey]; ok {
-				if !gkeKmsKey.IsNull() && !gkeKmsKey.IsUnknown() && gkeKmsKey.(types.String).ValueString() != "" {
-					hasNonDefaultFeatures = true
-				}
-			}
-
-			if hasNonDefaultFeatures {
-				return nil, errors.New("features (vpc_subnet, static_ip, existing_vpc, karpenter, gke_kms_key) are not supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
-			}
-		}
-	} else if infraChartsParams != nil {
-		// infrastructure_charts_parameters should not be set for non-PARTIALLY_MANAGED modes
-		return nil, errors.New("infrastructure_charts_parameters is only supported when kubernetes_mode is PARTIALLY_MANAGED (EKS Anywhere)")
-	}
-
 	features, err := toQoveryClusterFeatures(c.Features, ToString(c.KubernetesMode), ToString(c.CloudProvider))
 	if err != nil {
 		return nil, err
 	}
 
-	// For PARTIALLY_MANAGED mode, clear features to avoid sending them to API
-	if isPartiallyManaged {
+	// For PARTIALLY_MANAGED mode, features holding only schema defaults are not sent to the API.
+	// Any other feature is kept so that the domain validation rejects it.
+	if isPartiallyManaged && !c.hasNonDefaultFeatures() {
 		features = nil
 	}
 
-	karpenterEnabled := false
-	for _, f := range features {
-		if f.Id != nil && *f.Id == featureIdKarpenter {
-			if state != nil && !IsKarpenterAlreadyInstalled(state) {
-				return nil, errors.New("It is not possible to migrate to Karpenter using terraform")
-			}
-			karpenterEnabled = true
-			break
-		}
-	}
-
-	// Validation: Require Karpenter for new EKS clusters
-	if state == nil { // This is a new cluster creation
-		isAWS := ToString(c.CloudProvider) == "AWS"
-		isManaged := kubernetesMode != nil && *kubernetesMode == qovery.KUBERNETESENUM_MANAGED
-
-		if isAWS && isManaged {
-			if !karpenterEnabled {
-				return nil, errors.New("Karpenter is required for new EKS (AWS MANAGED) clusters. Please configure the Karpenter feature in the cluster configuration")
-			}
-		}
-	}
-
-	var clusterCloudProviderRequest *qovery.ClusterCloudProviderInfoRequest
+	var cloudProviderCredentials *cluster.CloudProviderCredentials
 	if state == nil || c.CredentialsId != state.CredentialsId {
-		clusterCloudProviderRequest = &qovery.ClusterCloudProviderInfoRequest{
-			CloudProvider: cloudProvider,
-			Region:        ToStringPointer(c.Region),
-			Credentials: &qovery.ClusterCloudProviderInfoCredentials{
-				Id:   ToStringPointer(c.CredentialsId),
-				Name: ToStringPointer(c.Name),
-			},
+		cloudProviderCredentials = &cluster.CloudProviderCredentials{
+			ID:   ToString(c.CredentialsId),
+			Name: ToString(c.Name),
 		}
 	}
 
@@ -341,169 +218,207 @@ func (c Cluster) toUpsertClusterRequest(state *Cluster) (*client.ClusterUpsertPa
 		return nil, errors.Wrap(err, "failed to parse secret_manager_accesses")
 	}
 
-	forceUpdate := c.hasFeaturesDiff(state) || c.hasRoutingTableDiff(state) || c.hasInfraChartsParamsDiff(state) || c.hasClusterSpecDiff(state) || c.hasSecretManagerAccessesDiff(state) || c.hasKedaDiff(state)
+	var labelsGroupIds []string
+	if !c.LabelsGroupIds.IsNull() && !c.LabelsGroupIds.IsUnknown() {
+		labelsGroupIds = ToStringArrayFromSet(c.LabelsGroupIds)
+	}
 
-	desiredState, err := qovery.NewClusterStateEnumFromValue(ToString(c.State))
-	if err != nil {
-		return nil, err
+	var kubeconfig *string
+	if !c.Kubeconfig.IsNull() && !c.Kubeconfig.IsUnknown() {
+		kubeconfig = ToStringPointer(c.Kubeconfig)
 	}
 
-	// When Karpenter is enabled, these fields are managed by Karpenter — don't send them to the API.
-	var instanceType *string
-	var diskSize *int32
-	var minRunningNodes *int32
-	var maxRunningNodes *int32
-	if !karpenterEnabled {
-		instanceType = ToStringPointer(c.InstanceType)
-		diskSize = ToInt64Pointer(c.DiskSize)
-		minRunningNodes = ToInt32Pointer(c.MinRunningNodes)
-		maxRunningNodes = ToInt32Pointer(c.MaxRunningNodes)
+	var kubernetesVersion *string
+	if !c.KubernetesVersion.IsNull() && !c.KubernetesVersion.IsUnknown() {
+		kubernetesVersion = ToStringPointer(c.KubernetesVersion)
 	}
 
-	var labelsGroups []qovery.ClusterLabelsGroup
-	if !c.LabelsGroupIds.IsNull() && !c.LabelsGroupIds.IsUnknown() {
-		labelsGroups = make([]qovery.ClusterLabelsGroup, 0, len(c.LabelsGroupIds.Elements()))
-		for _, id := range c.LabelsGroupIds.Elements() {
-			idStr := id.(types.String).ValueString()
-			labelsGroups = append(labelsGroups, qovery.ClusterLabelsGroup{Id: &idStr})
-		}
+	// The routing table is only pushed when it changes, so that the routes managed with
+	// qovery_cluster_routing_table or qovery_cluster_route are not overwritten by stale ones.
+	var routingTable cluster.RoutingTable
+	if c.hasRoutingTableDiff(state) {
+		routingTable = toClusterRouteList(c.RoutingTables).toDomainRoutingTable()
 	}
 
-	return &client.ClusterUpsertParams{
-		ClusterCloudProviderRequest: clusterCloudProviderRequest,
-		ClusterRequest: qovery.ClusterRequest{
+	return &cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: cluster.UpsertRepositoryRequest{
 			Name:                           ToString(c.Name),
-			CloudProvider:                  *cloudVendor,
-			CloudProviderCredentials:       clusterCloudProviderRequest,
+			CloudProvider:                  ToString(c.CloudProvider),
 			Region:                         ToString(c.Region),
 			Description:                    ToStringPointer(c.Description),
-			Kubernetes:                     kubernetesMode,
-			InstanceType:                   instanceType,
-			DiskSize:                       diskSize,
-			MinRunningNodes:                minRunningNodes,
-			MaxRunningNodes:                maxRunningNodes,
+			KubernetesMode:                 ToString(c.KubernetesMode),
+			CloudProviderCredentials:       cloudProviderCredentials,
+			InstanceType:                   ToStringPointer(c.InstanceType),
+			DiskSize:                       ToInt64Pointer(c.DiskSize),
+			MinRunningNodes:                ToInt32Pointer(c.MinRunningNodes),
+			MaxRunningNodes:                ToInt32Pointer(c.MaxRunningNodes),
 			Production:                     ToBoolPointer(c.Production),
 			Features:                       features,
 			Keda:                           toQoveryClusterKeda(c.Keda),
 			InfrastructureChartsParameters: infraChartsParams,
-			LabelsGroups:                   labelsGroups,
+			LabelsGroupIds:                 labelsGroupIds,
 			SecretManagerAccesses:          secretManagerAccesses,
+			RoutingTable:                   routingTable,
+			AdvancedSettingsJson:           ToString(c.AdvancedSettingsJson),
 		},
-		ClusterRoutingTable:  routingTable.toUpsertRequest(),
-		AdvancedSettingsJson: ToString(c.AdvancedSettingsJson),
-		ForceUpdate:          forceUpdate,
-		DesiredState:         *desiredState,
+		DesiredState:      cluster.State(ToString(c.State)),
+		ForceUpdate:       c.hasFeaturesDiff(state) || c.hasRoutingTableDiff(state) || c.hasInfraChartsParamsDiff(state) || c.hasClusterSpecDiff(state) || c.hasSecretManagerAccessesDiff(state) || c.hasKedaDiff(state),
+		Kubeconfig:        kubeconfig,
+		KubernetesVersion: kubernetesVersion,
 	}, nil
 }
 
-func IsKarpenterAlreadyInstalled(state *Cluster) bool {
-	if state == nil {
+// hasNonDefaultFeatures reports whether any feature is set to something else than its schema default.
+func (c Cluster) hasNonDefaultFeatures() bool {
+	if c.Features.IsNull() || c.Features.IsUnknown() {
 		return false
 	}
 
-	oldFeatures, _ := toQoveryClusterFeatures(state.Features, ToString(state.KubernetesMode), ToString(state.CloudProvider))
-	for _, f := range oldFeatures {
-		if f.Id != nil && *f.Id == featureIdKarpenter {
+	featuresAttrs := c.Features.Attributes()
+	if vpcSubnet, ok := featuresAttrs[featureKeyVpcSubnet]; ok {
+		if !vpcSubnet.IsNull() && !vpcSubnet.IsUnknown() {
+			vpcSubnetStr := vpcSubnet.(types.String).ValueString()
+			if vpcSubnetStr != "" && vpcSubnetStr != clusterFeatureVpcSubnetDefault {
+				return true
+			}
+		}
+	}
+	if staticIP, ok := featuresAttrs[featureKeyStaticIP]; ok {
+		if !staticIP.IsNull() && !staticIP.IsUnknown() && staticIP.(types.Bool).ValueBool() {
 			return true
 		}
 	}
-	return false
-}
-
-// responseHasKarpenter reports whether the API cluster response has the Karpenter feature enabled.
-func responseHasKarpenter(features []qovery.ClusterFeatureResponse) bool {
-	for _, f := range features {
-		if f.Id != nil && *f.Id == featureIdKarpenter {
+	if existingVpc, ok := featuresAttrs[featureKeyExistingVpc]; ok {
+		if !existingVpc.IsNull() && !existingVpc.IsUnknown() {
+			// Check if existing_vpc has actual content (aws_vpc_eks_id is required)
+			existingVpcObj := existingVpc.(types.Object)
+			if vpcId, hasVpcId := existingVpcObj.Attributes()["aws_vpc_eks_id"]; hasVpcId {
+				if !vpcId.IsNull() && !vpcId.IsUnknown() {
+					return true
+				}
+			}
+		}
+	}
+	if karpenter, ok := featuresAttrs[featureKeyKarpenter]; ok {
+		if !karpenter.IsNull() && !karpenter.IsUnknown() {
+			// Check if karpenter has actual content (spot_enabled is required)
+			karpenterObj := karpenter.(types.Object)
+			if spotEnabled, hasSpot := karpenterObj.Attributes()["spot_enabled"]; hasSpot {
+				if !spotEnabled.IsNull() && !spotEnabled.IsUnknown() {
+					return true
+				}
+			}
+		}
+	}
+	if gkeKmsKey, ok := featuresAttrs[featureKeyGkeKmsKey]; ok {
+		if !gkeKmsKey.IsNull() && !gkeKmsKey.IsUnknown() && gkeKmsKey.(types.String).ValueString() != "" {
 			return true
 		}
 	}
+
 	return false
 }
 
-func convertResponseToCluster(ctx context.Context, res *client.ClusterResponse, initialPlan Cluster) Cluster {
-	routingTable := fromClusterRoutingTable(res.ClusterRoutingTable)
-
-	// Check if cluster is PARTIALLY_MANAGED (EKS Anywhere)
-	isPartiallyManaged := res.ClusterResponse.Kubernetes != nil &&
-		*res.ClusterResponse.Kubernetes == qovery.KUBERNETESENUM_PARTIALLY_MANAGED
-
-	labelsGroupIds := make([]string, 0, len(res.ClusterResponse.LabelsGroups))
-	for _, lg := range res.ClusterResponse.LabelsGroups {
-		if lg.Id != nil {
-			labelsGroupIds = append(labelsGroupIds, *lg.Id)
-		}
+func convertDomainClusterToCluster(ctx context.Context, c *cluster.Cluster, initialPlan Cluster) Cluster {
+	var kubernetesMode types.String
+	if c.KubernetesMode != nil {
+		kubernetesMode = FromString(c.KubernetesMode.String())
+	} else {
+		kubernetesMode = types.StringNull()
 	}
 
-	cluster := Cluster{
-		Id:                             FromString(res.ClusterResponse.Id),
-		CredentialsId:                  FromStringPointer(res.ClusterInfo.Credentials.Id),
-		OrganizationId:                 FromString(res.OrganizationID),
-		Name:                           FromString(res.ClusterResponse.Name),
-		CloudProvider:                  fromClientEnum(res.ClusterResponse.CloudProvider),
-		Region:                         FromString(res.ClusterResponse.Region),
-		Description:                    FromStringPointer(res.ClusterResponse.Description),
-		KubernetesMode:                 fromClientEnumPointer(res.ClusterResponse.Kubernetes),
-		Production:                     FromBoolPointer(res.ClusterResponse.Production),
-		State:                          fromClientEnumPointer(res.ClusterResponse.Status),
-		AdvancedSettingsJson:           FromString(res.AdvancedSettingsJson),
-		InfrastructureChartsParameters: fromQoveryInfrastructureChartsParameters(res.ClusterResponse.InfrastructureChartsParameters),
-		LabelsGroupIds:                 fromLabelsGroupList(ctx, initialPlan.LabelsGroupIds, labelsGroupIds),
-		SecretManagerAccesses:          fromQoverySecretManagerAccesses(ctx, res.ClusterResponse.SecretManagerAccesses, initialPlan.SecretManagerAccesses),
+	var state types.String
+	if c.State != nil {
+		state = FromString(c.State.String())
+	} else {
+		state = types.StringNull()
+	}
+
+	result := Cluster{
+		Id:                             FromString(c.ID.String()),
+		CredentialsId:                  FromStringPointer(c.CredentialsID),
+		OrganizationId:                 FromString(c.OrganizationID.String()),
+		Name:                           FromString(c.Name),
+		CloudProvider:                  FromString(c.CloudProvider.String()),
+		Region:                         FromString(c.Region),
+		Description:                    FromStringPointer(c.Description),
+		KubernetesMode:                 kubernetesMode,
+		KubernetesVersion:              fromClusterKubernetesVersion(c.KubernetesVersion, initialPlan.KubernetesVersion),
+		Production:                     FromBoolPointer(c.Production),
+		State:                          state,
+		AdvancedSettingsJson:           FromString(c.AdvancedSettingsJson),
+		InfrastructureChartsParameters: fromQoveryInfrastructureChartsParameters(c.InfrastructureChartsParameters),
+		LabelsGroupIds:                 fromLabelsGroupList(ctx, initialPlan.LabelsGroupIds, c.LabelsGroupIds),
+		SecretManagerAccesses:          fromQoverySecretManagerAccesses(ctx, c.SecretManagerAccesses, initialPlan.SecretManagerAccesses),
+		DeletionProtection:             deletionProtectionFromState(initialPlan.DeletionProtection),
 	}
 
 	// For PARTIALLY_MANAGED (EKS Anywhere) clusters, these fields are not applicable
 	// Return null values to avoid spurious terraform plan changes
-	if isPartiallyManaged {
-		cluster.InstanceType = types.StringNull()
-		cluster.DiskSize = types.Int64Null()
-		cluster.MinRunningNodes = types.Int64Null()
-		cluster.MaxRunningNodes = types.Int64Null()
-		cluster.Features = types.ObjectNull(createFeaturesAttrTypes())
-		cluster.Keda = types.ObjectNull(createKedaAttrTypes())
-		cluster.RoutingTables = types.SetNull(types.ObjectType{AttrTypes: clusterRouteAttrTypes})
-		cluster.InfrastructureOutputs = types.ObjectNull(clusterInfrastructureOutputsAttrTypes)
+	if c.IsPartiallyManaged() {
+		result.InstanceType = types.StringNull()
+		result.DiskSize = types.Int64Null()
+		result.MinRunningNodes = types.Int64Null()
+		result.MaxRunningNodes = types.Int64Null()
+		result.Features = types.ObjectNull(createFeaturesAttrTypes())
+		result.Keda = types.ObjectNull(createKedaAttrTypes())
+		result.RoutingTables = types.SetNull(types.ObjectType{AttrTypes: clusterRouteAttrTypes})
+		result.InfrastructureOutputs = types.ObjectNull(clusterInfrastructureOutputsAttrTypes)
 		// Preserve kubeconfig from initialPlan - it's fetched separately via API in Read operation
-		cluster.Kubeconfig = initialPlan.Kubeconfig
+		result.Kubeconfig = initialPlan.Kubeconfig
+		return result
+	}
+
+	hasKarpenter := c.HasKarpenter()
+
+	// When Karpenter is enabled the API rewrites instance_type to the literal
+	// "KARPENTER" in the response. Preserve the plan value to avoid an
+	// "inconsistent result after apply" on create and a spurious diff on every
+	// subsequent plan.
+	if hasKarpenter && !initialPlan.InstanceType.IsNull() && !initialPlan.InstanceType.IsUnknown() {
+		result.InstanceType = initialPlan.InstanceType
 	} else {
-		hasKarpenter := responseHasKarpenter(res.ClusterResponse.Features)
-
-		// When Karpenter is enabled the API rewrites instance_type to the literal
-		// "KARPENTER" in the response. Preserve the plan value to avoid an
-		// "inconsistent result after apply" on create and a spurious diff on every
-		// subsequent plan.
-		if hasKarpenter && !initialPlan.InstanceType.IsNull() && !initialPlan.InstanceType.IsUnknown() {
-			cluster.InstanceType = initialPlan.InstanceType
-		} else {
-			cluster.InstanceType = FromStringPointer(res.ClusterResponse.InstanceType)
-		}
-		cluster.DiskSize = FromInt32Pointer(res.ClusterResponse.DiskSize)
-
-		// GCP Autopilot and Karpenter manage node scaling themselves, so min/max_running_nodes
-		// are not set in config and the API returns unstable sentinel values (e.g. MaxInt32 right
-		// after create, a real number on later reads). Preserve the plan values to avoid a spurious
-		// "inconsistent result after apply" on update.
-		isAutoPilot := res.ClusterResponse.InstanceType != nil && *res.ClusterResponse.InstanceType == instanceTypeAutoPilot
-		nodeCountsManaged := isAutoPilot || hasKarpenter
-		if nodeCountsManaged && !initialPlan.MinRunningNodes.IsNull() && !initialPlan.MinRunningNodes.IsUnknown() {
-			cluster.MinRunningNodes = initialPlan.MinRunningNodes
-		} else {
-			cluster.MinRunningNodes = FromInt32Pointer(res.ClusterResponse.MinRunningNodes)
-		}
-		if nodeCountsManaged && !initialPlan.MaxRunningNodes.IsNull() && !initialPlan.MaxRunningNodes.IsUnknown() {
-			cluster.MaxRunningNodes = initialPlan.MaxRunningNodes
-		} else {
-			cluster.MaxRunningNodes = FromInt32Pointer(res.ClusterResponse.MaxRunningNodes)
-		}
+		result.InstanceType = FromStringPointer(c.InstanceType)
+	}
+	result.DiskSize = FromInt32Pointer(c.DiskSize)
 
-		cluster.Features = fromQoveryClusterFeatures(res.ClusterResponse.Features)
-		cluster.Keda = fromQoveryClusterKeda(res.ClusterResponse.Keda)
-		cluster.RoutingTables = routingTable.toTerraformSet(ctx, initialPlan.RoutingTables)
-		cluster.InfrastructureOutputs = fromQoveryClusterOutput(res.ClusterResponse.InfrastructureOutputs, initialPlan.InfrastructureOutputs)
-		// Kubeconfig is not applicable for non-PARTIALLY_MANAGED clusters
-		cluster.Kubeconfig = types.StringNull()
+	// GCP Autopilot and Karpenter manage node scaling themselves, so min/max_running_nodes
+	// are not set in config and the API returns unstable sentinel values (e.g. MaxInt32 right
+	// after create, a real number on later reads). Preserve the plan values to avoid a spurious
+	// "inconsistent result after apply" on update.
+	nodeCountsManaged := c.IsAutoPilot() || hasKarpenter
+	if nodeCountsManaged && !initialPlan.MinRunningNodes.IsNull() && !initialPlan.MinRunningNodes.IsUnknown() {
+		result.MinRunningNodes = initialPlan.MinRunningNodes
+	} else {
+		result.MinRunningNodes = FromInt32Pointer(c.MinRunningNodes)
 	}
+	if nodeCountsManaged && !initialPlan.MaxRunningNodes.IsNull() && !initialPlan.MaxRunningNodes.IsUnknown() {
+		result.MaxRunningNodes = initialPlan.MaxRunningNodes
+	} else {
+		result.MaxRunningNodes = FromInt32Pointer(c.MaxRunningNodes)
+	}
+
+	result.Features = fromQoveryClusterFeatures(c.Features)
+	result.Keda = fromQoveryClusterKeda(c.Keda)
+	result.RoutingTables = fromClusterRoutingTable(c.RoutingTable).toTerraformSet(ctx, initialPlan.RoutingTables)
+	result.InfrastructureOutputs = fromQoveryClusterOutput(c.InfrastructureOutputs, initialPlan.InfrastructureOutputs)
+	// Kubeconfig is not applicable for non-PARTIALLY_MANAGED clusters
+	result.Kubeconfig = types.StringNull()
 
-	return cluster
+	return result
+}
+
+// fromClusterKubernetesVersion returns the kubernetes version of the cluster.
+// The API reports the full version of the cluster (e.g. `1.32.4`), so the planned version is kept when it is the same
+// minor version, to avoid a spurious diff on every plan.
+func fromClusterKubernetesVersion(kubernetesVersion *string, initialPlan types.String) types.String {
+	if kubernetesVersion == nil {
+		return types.StringNull()
+	}
+	if !initialPlan.IsNull() && !initialPlan.IsUnknown() && cluster.IsSameKubernetesVersion(initialPlan.ValueString(), *kubernetesVersion) {
+		return initialPlan
+	}
+	return FromString(*kubernetesVersion)
 }
 
 // createKedaAttrTypes returns the attribute types for the cluster `keda` nested object.
@@ -1113,52 +1028,11 @@ func toQoveryNodePools(obj types.Object) (*qovery.KarpenterNodePool, error) {
 	}
 
 	for _, req := range requirements {
-		key, ok := req["key"].(string)
-		if !ok {
-			return nil, fmt.Errorf("invalid key type")
-		}
-
-		var karpenterKey qovery.KarpenterNodePoolRequirementKey
-		switch key {
-		case "InstanceFamily":
-			karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_INSTANCE_FAMILY
-		case "InstanceSize":
-			karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_INSTANCE_SIZE
-		case "Arch":
-			karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_ARCH
-		default:
-			return nil, fmt.Errorf("unsupported key: %s", key)
-		}
-
-		operator, ok := req["operator"].(string)
-		if !ok {
-			return nil, fmt.Errorf("invalid operator type")
-		}
-
-		var karpenterOperator qovery.KarpenterNodePoolRequirementOperator
-		switch operator {
-		case "In":
-			karpenterOperator = qovery.KARPENTERNODEPOOLREQUIREMENTOPERATOR_IN
-		default:
-			return nil, fmt.Errorf("unsupported operator: %s", operator)
-		}
-
-		values, ok := req["values"].([]string)
-		if !ok {
-			return nil, fmt.Errorf("invalid values type")
-		}
-
-		if len(values) == 0 {
-			return nil, fmt.Errorf("karpenter node pool values must not be empty")
-		}
-
-		requirement := qovery.KarpenterNodePoolRequirement{
-			Key:      karpenterKey,
-			Operator: karpenterOperator,
-			Values:   values,
+		requirement, err := toQoveryNodePoolRequirement(req)
+		if err != nil {
+			return nil, err
 		}
-
-		karpenterNodePool.Requirements = append(karpenterNodePool.Requirements, requirement)
+		karpenterNodePool.Requirements = append(karpenterNodePool.Requirements, *requirement)
 	}
 
 	// Set stable node pool override
@@ -1175,9 +1049,73 @@ func toQoveryNodePools(obj types.Object) (*qovery.KarpenterNodePool, error) {
 	}
 	karpenterNodePool.DefaultOverride = defaultOverride
 
+	// Set GPU node pool override
+	gpuOverride, err := extractGpuNodePoolOverrideFromTypesObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	karpenterNodePool.GpuOverride = gpuOverride
+
+	// Set cronjob node pool override
+	cronjobOverride, err := extractCronjobNodePoolOverrideFromTypesObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	karpenterNodePool.CronjobOverride = cronjobOverride
+
 	return &karpenterNodePool, nil
 }
 
+// toQoveryNodePoolRequirement converts a requirement of a Karpenter node pool to its API value.
+func toQoveryNodePoolRequirement(req map[string]any) (*qovery.KarpenterNodePoolRequirement, error) {
+	key, ok := req["key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid key type")
+	}
+
+	var karpenterKey qovery.KarpenterNodePoolRequirementKey
+	switch key {
+	case "InstanceFamily":
+		karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_INSTANCE_FAMILY
+	case "InstanceSize":
+		karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_INSTANCE_SIZE
+	case "Arch":
+		karpenterKey = qovery.KARPENTERNODEPOOLREQUIREMENTKEY_ARCH
+	default:
+		return nil, fmt.Errorf("unsupported key: %s", key)
+	}
+
+	operator, ok := req["operator"].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid operator type")
+	}
+
+	var karpenterOperator qovery.KarpenterNodePoolRequirementOperator
+	switch operator {
+	case "In":
+		karpenterOperator = qovery.KARPENTERNODEPOOLREQUIREMENTOPERATOR_IN
+	default:
+		return nil, fmt.Errorf("unsupported operator: %s", operator)
+	}
+
+	values, ok := req["values"].([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid values type")
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("karpenter node pool values must not be empty")
+	}
+
+	requirement := qovery.KarpenterNodePoolRequirement{
+		Key:      karpenterKey,
+		Operator: karpenterOperator,
+		Values:   values,
+	}
+
+	return &requirement, nil
+}
+
 func extractRequirementsFromTypesObject(obj types.Object) ([]map[string]any, error) {
 	qoveryNodePools, exists := obj.Attributes()["qovery_node_pools"].(basetypes.ObjectValue)
 	if !exists {
@@ -1235,33 +1173,10 @@ func extractStableNodePoolOverrideFromTypesObject(obj types.Object) (*qovery.Kar
 
 	// The consolidation is allowed to be null
 	if exists && !consolidationAttr.IsNull() {
-		consolidation, ok := consolidationAttr.(basetypes.ObjectValue)
-		if !ok {
-			return nil, fmt.Errorf("consolidation field cannot be parsed to Object")
-		}
-
-		consolidationEnabled := consolidation.Attributes()["enabled"].(basetypes.BoolValue)
-		consolidationDays := consolidation.Attributes()["days"].(basetypes.ListValue)
-		consolidationStartTime := consolidation.Attributes()["start_time"].(basetypes.StringValue)
-		consolidationDuration := consolidation.Attributes()["duration"].(basetypes.StringValue)
-
-		// Converts consolidation days (string) to expected enum type (WeekdayEnum)
-		consolidationWeekDayEnumList := make([]qovery.WeekdayEnum, 0)
-		for _, value := range consolidationDays.Elements() {
-			valueAsString := value.(basetypes.StringValue).ValueString()
-			fromValue, err := qovery.NewWeekdayEnumFromValue(valueAsString)
-			if err != nil {
-				return nil, fmt.Errorf("cannot convert '%s' to WeekdayEnum", valueAsString)
-			}
-			consolidationWeekDayEnumList = append(consolidationWeekDayEnumList, *fromValue)
+		qoveryConsolidation, err := toQoveryNodePoolConsolidation(consolidationAttr)
+		if err != nil {
+			return nil, err
 		}
-
-		qoveryConsolidation := qovery.NewKarpenterNodePoolConsolidation(
-			consolidationEnabled.ValueBool(),
-			consolidationWeekDayEnumList,
-			consolidationStartTime.ValueString(),
-			consolidationDuration.ValueString(),
-		)
 		qoveryStableOverride.Consolidation = qoveryConsolidation
 	}
 
@@ -1270,16 +1185,10 @@ func extractStableNodePoolOverrideFromTypesObject(obj types.Object) (*qovery.Kar
 
 	// The limits are allowed to be null
 	if exists && !limitsAttr.IsNull() {
-		limits, ok := limitsAttr.(basetypes.ObjectValue)
-		if !ok {
-			return nil, fmt.Errorf("limits field cannot be parsed to Object")
+		qoveryLimits, err := toQoveryNodePoolLimits(limitsAttr)
+		if err != nil {
+			return nil, err
 		}
-
-		enabled := limits.Attributes()["enabled"].(basetypes.BoolValue)
-		limitsCpu := limits.Attributes()["max_cpu_in_vcpu"].(basetypes.Int64Value)
-		limitsRam := limits.Attributes()["max_memory_in_gibibytes"].(basetypes.Int64Value)
-
-		qoveryLimits := qovery.NewKarpenterNodePoolLimits(enabled.ValueBool(), int32(limitsCpu.ValueInt64()), int32(limitsRam.ValueInt64()), 0)
 		qoveryStableOverride.Limits = qoveryLimits
 	}
 
@@ -1338,6 +1247,175 @@ func extractDefaultNodePoolOverrideFromTypesObject(obj types.Object) (*qovery.Ka
 	return &qoveryDefaultOverride, nil
 }
 
+func extractGpuNodePoolOverrideFromTypesObject(obj types.Object) (*qovery.KarpenterGpuNodePoolOverride, error) {
+	qoveryNodePools, exists := obj.Attributes()["qovery_node_pools"].(basetypes.ObjectValue)
+	if !exists {
+		return nil, fmt.Errorf("qovery_node_pools field not found")
+	}
+
+	gpuOverrideAttr, exists := qoveryNodePools.Attributes()["gpu_override"]
+	if !exists || gpuOverrideAttr.IsNull() {
+		// It means gpu_override is not defined: the cluster has no GPU node pool
+		return nil, nil
+	}
+
+	gpuOverride, ok := gpuOverrideAttr.(basetypes.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("gpu_override field cannot be parsed to Object")
+	}
+
+	qoveryGpuOverride := qovery.KarpenterGpuNodePoolOverride{}
+
+	// Set requirements
+	if requirementsAttr, exists := gpuOverride.Attributes()["requirements"]; exists && !requirementsAttr.IsNull() {
+		requirementsList, ok := requirementsAttr.(basetypes.ListValue)
+		if !ok {
+			return nil, fmt.Errorf("gpu_override.requirements field is not a list")
+		}
+		for _, reqAttr := range requirementsList.Elements() {
+			reqMap, err := convertObjectToMap(reqAttr)
+			if err != nil {
+				return nil, err
+			}
+			requirement, err := toQoveryNodePoolRequirement(reqMap)
+			if err != nil {
+				return nil, err
+			}
+			qoveryGpuOverride.Requirements = append(qoveryGpuOverride.Requirements, *requirement)
+		}
+	}
+
+	// Set consolidation
+	if consolidationAttr, exists := gpuOverride.Attributes()["consolidation"]; exists && !consolidationAttr.IsNull() {
+		consolidation, err := toQoveryNodePoolConsolidation(consolidationAttr)
+		if err != nil {
+			return nil, err
+		}
+		qoveryGpuOverride.Consolidation = consolidation
+	}
+
+	// Set limits
+	if limitsAttr, exists := gpuOverride.Attributes()["limits"]; exists && !limitsAttr.IsNull() {
+		limits, err := toQoveryNodePoolLimits(limitsAttr)
+		if err != nil {
+			return nil, err
+		}
+		qoveryGpuOverride.Limits = limits
+	}
+
+	if spotEnabled, ok := gpuOverride.Attributes()["spot_enabled"].(basetypes.BoolValue); ok && !spotEnabled.IsNull() && !spotEnabled.IsUnknown() {
+		qoveryGpuOverride.SpotEnabled = new(spotEnabled.ValueBool())
+	}
+	if diskSize, ok := gpuOverride.Attributes()["disk_size_in_gib"].(basetypes.Int64Value); ok && !diskSize.IsNull() && !diskSize.IsUnknown() {
+		qoveryGpuOverride.DiskSizeInGib = new(int32(diskSize.ValueInt64()))
+	}
+	if diskIops, ok := gpuOverride.Attributes()["disk_iops"].(basetypes.Int64Value); ok && !diskIops.IsNull() && !diskIops.IsUnknown() {
+		qoveryGpuOverride.DiskIops = new(int32(diskIops.ValueInt64()))
+	}
+	if diskThroughput, ok := gpuOverride.Attributes()["disk_throughput"].(basetypes.Int64Value); ok && !diskThroughput.IsNull() && !diskThroughput.IsUnknown() {
+		qoveryGpuOverride.DiskThroughput = new(int32(diskThroughput.ValueInt64()))
+	}
+	if consolidateAfter, ok := gpuOverride.Attributes()["consolidate_after"].(basetypes.StringValue); ok && !consolidateAfter.IsNull() && !consolidateAfter.IsUnknown() {
+		qoveryGpuOverride.ConsolidateAfter = new(consolidateAfter.ValueString())
+	}
+
+	return &qoveryGpuOverride, nil
+}
+
+func extractCronjobNodePoolOverrideFromTypesObject(obj types.Object) (*qovery.KarpenterCronjobNodePoolOverride, error) {
+	qoveryNodePools, exists := obj.Attributes()["qovery_node_pools"].(basetypes.ObjectValue)
+	if !exists {
+		return nil, fmt.Errorf("qovery_node_pools field not found")
+	}
+
+	cronjobOverrideAttr, exists := qoveryNodePools.Attributes()["cronjob_override"]
+	if !exists || cronjobOverrideAttr.IsNull() {
+		// It means cronjob_override is not defined: the API defaults apply to the cronjob node pool
+		return nil, nil
+	}
+
+	cronjobOverride, ok := cronjobOverrideAttr.(basetypes.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("cronjob_override field cannot be parsed to Object")
+	}
+
+	qoveryCronjobOverride := qovery.KarpenterCronjobNodePoolOverride{}
+
+	// Set consolidation
+	if consolidationAttr, exists := cronjobOverride.Attributes()["consolidation"]; exists && !consolidationAttr.IsNull() {
+		consolidation, err := toQoveryNodePoolConsolidation(consolidationAttr)
+		if err != nil {
+			return nil, err
+		}
+		qoveryCronjobOverride.Consolidation = consolidation
+	}
+
+	// Set limits
+	if limitsAttr, exists := cronjobOverride.Attributes()["limits"]; exists && !limitsAttr.IsNull() {
+		limits, err := toQoveryNodePoolLimits(limitsAttr)
+		if err != nil {
+			return nil, err
+		}
+		qoveryCronjobOverride.Limits = limits
+	}
+
+	if consolidateAfter, ok := cronjobOverride.Attributes()["consolidate_after"].(basetypes.StringValue); ok && !consolidateAfter.IsNull() && !consolidateAfter.IsUnknown() {
+		qoveryCronjobOverride.ConsolidateAfter = new(consolidateAfter.ValueString())
+	}
+
+	return &qoveryCronjobOverride, nil
+}
+
+// toQoveryNodePoolConsolidation converts the consolidation of a Karpenter node pool to its API value.
+func toQoveryNodePoolConsolidation(consolidationAttr attr.Value) (*qovery.KarpenterNodePoolConsolidation, error) {
+	consolidation, ok := consolidationAttr.(basetypes.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("consolidation field cannot be parsed to Object")
+	}
+
+	consolidationEnabled := consolidation.Attributes()["enabled"].(basetypes.BoolValue)
+	consolidationDays := consolidation.Attributes()["days"].(basetypes.ListValue)
+	consolidationStartTime := consolidation.Attributes()["start_time"].(basetypes.StringValue)
+	consolidationDuration := consolidation.Attributes()["duration"].(basetypes.StringValue)
+
+	// Converts consolidation days (string) to expected enum type (WeekdayEnum)
+	consolidationWeekDayEnumList := make([]qovery.WeekdayEnum, 0)
+	for _, value := range consolidationDays.Elements() {
+		valueAsString := value.(basetypes.StringValue).ValueString()
+		fromValue, err := qovery.NewWeekdayEnumFromValue(valueAsString)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert '%s' to WeekdayEnum", valueAsString)
+		}
+		consolidationWeekDayEnumList = append(consolidationWeekDayEnumList, *fromValue)
+	}
+
+	return qovery.NewKarpenterNodePoolConsolidation(
+		consolidationEnabled.ValueBool(),
+		consolidationWeekDayEnumList,
+		consolidationStartTime.ValueString(),
+		consolidationDuration.ValueString(),
+	), nil
+}
+
+// toQoveryNodePoolLimits converts the limits of a Karpenter node pool to their API value.
+// max_gpu is only defined for the GPU node pool.
+func toQoveryNodePoolLimits(limitsAttr attr.Value) (*qovery.KarpenterNodePoolLimits, error) {
+	limits, ok := limitsAttr.(basetypes.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("limits field cannot be parsed to Object")
+	}
+
+	enabled := limits.Attributes()["enabled"].(basetypes.BoolValue)
+	limitsCpu := limits.Attributes()["max_cpu_in_vcpu"].(basetypes.Int64Value)
+	limitsRam := limits.Attributes()["max_memory_in_gibibytes"].(basetypes.Int64Value)
+	var limitsGpu int64
+	if maxGpu, ok := limits.Attributes()["max_gpu"].(basetypes.Int64Value); ok {
+		limitsGpu = maxGpu.ValueInt64()
+	}
+
+	return qovery.NewKarpenterNodePoolLimits(enabled.ValueBool(), int32(limitsCpu.ValueInt64()), int32(limitsRam.ValueInt64()), int32(limitsGpu)), nil
+}
+
 func convertObjectToMap(obj attr.Value) (map[string]any, error) {
 	reqObject, ok := obj.(basetypes.ObjectValue)
 	if !ok {
@@ -1423,12 +1501,175 @@ func createKarpenterFeatureAttrTypes() map[string]attr.Type {
 					},
 				},
 			},
+			"gpu_override":     types.ObjectType{AttrTypes: createKarpenterGpuOverrideAttrTypes()},
+			"cronjob_override": types.ObjectType{AttrTypes: createKarpenterCronjobOverrideAttrTypes()},
 		},
 	}
 
 	return attrTypes
 }
 
+// createKarpenterGpuOverrideAttrTypes returns the attribute types for the GPU node pool of Karpenter.
+func createKarpenterGpuOverrideAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"requirements": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"key":      types.StringType,
+					"operator": types.StringType,
+					"values":   types.ListType{ElemType: types.StringType},
+				},
+			},
+		},
+		"consolidation": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"enabled":    types.BoolType,
+				"days":       types.ListType{ElemType: types.StringType},
+				"start_time": types.StringType,
+				"duration":   types.StringType,
+			},
+		},
+		"limits": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"enabled":                 types.BoolType,
+				"max_cpu_in_vcpu":         types.Int64Type,
+				"max_memory_in_gibibytes": types.Int64Type,
+				"max_gpu":                 types.Int64Type,
+			},
+		},
+		"spot_enabled":      types.BoolType,
+		"disk_size_in_gib":  types.Int64Type,
+		"disk_iops":         types.Int64Type,
+		"disk_throughput":   types.Int64Type,
+		"consolidate_after": types.StringType,
+	}
+}
+
+// fromQoveryGpuNodePoolOverride returns the GPU node pool of Karpenter, null when the cluster has none.
+func fromQoveryGpuNodePoolOverride(gpuOverride *qovery.KarpenterGpuNodePoolOverride) types.Object {
+	attrTypes := createKarpenterGpuOverrideAttrTypes()
+	if gpuOverride == nil {
+		return types.ObjectNull(attrTypes)
+	}
+
+	requirementsType := attrTypes["requirements"].(types.ListType)
+	requirements := types.ListNull(requirementsType.ElemType)
+	if len(gpuOverride.Requirements) > 0 {
+		requirementType := requirementsType.ElemType.(types.ObjectType)
+		values := make([]attr.Value, 0, len(gpuOverride.Requirements))
+		for _, req := range gpuOverride.Requirements {
+			requirementValues := make([]attr.Value, 0, len(req.Values))
+			for _, v := range req.Values {
+				requirementValues = append(requirementValues, types.StringValue(v))
+			}
+			values = append(values, types.ObjectValueMust(requirementType.AttrTypes, map[string]attr.Value{
+				"key":      types.StringValue(string(req.Key)),
+				"operator": types.StringValue(string(req.Operator)),
+				"values":   types.ListValueMust(types.StringType, requirementValues),
+			}))
+		}
+		requirements = types.ListValueMust(requirementType, values)
+	}
+
+	consolidationType := attrTypes["consolidation"].(types.ObjectType)
+	consolidation := types.ObjectNull(consolidationType.AttrTypes)
+	if gpuOverride.Consolidation != nil {
+		days := make([]attr.Value, 0, len(gpuOverride.Consolidation.Days))
+		for _, day := range gpuOverride.Consolidation.Days {
+			days = append(days, types.StringValue(string(day)))
+		}
+		consolidation = types.ObjectValueMust(consolidationType.AttrTypes, map[string]attr.Value{
+			"enabled":    types.BoolValue(gpuOverride.Consolidation.Enabled),
+			"days":       types.ListValueMust(types.StringType, days),
+			"start_time": types.StringValue(gpuOverride.Consolidation.StartTime),
+			"duration":   types.StringValue(gpuOverride.Consolidation.Duration),
+		})
+	}
+
+	limitsType := attrTypes["limits"].(types.ObjectType)
+	limits := types.ObjectNull(limitsType.AttrTypes)
+	if gpuOverride.Limits != nil {
+		limits = types.ObjectValueMust(limitsType.AttrTypes, map[string]attr.Value{
+			"enabled":                 types.BoolValue(gpuOverride.Limits.Enabled),
+			"max_cpu_in_vcpu":         types.Int64Value(int64(gpuOverride.Limits.MaxCpuInVcpu)),
+			"max_memory_in_gibibytes": types.Int64Value(int64(gpuOverride.Limits.MaxMemoryInGibibytes)),
+			"max_gpu":                 types.Int64Value(int64(gpuOverride.Limits.MaxGpu)),
+		})
+	}
+
+	return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"requirements":      requirements,
+		"consolidation":     consolidation,
+		"limits":            limits,
+		"spot_enabled":      FromBoolPointer(gpuOverride.SpotEnabled),
+		"disk_size_in_gib":  FromInt32Pointer(gpuOverride.DiskSizeInGib),
+		"disk_iops":         FromInt32Pointer(gpuOverride.DiskIops),
+		"disk_throughput":   FromInt32Pointer(gpuOverride.DiskThroughput),
+		"consolidate_after": FromStringPointer(gpuOverride.ConsolidateAfter),
+	})
+}
+
+// createKarpenterCronjobOverrideAttrTypes returns the attribute types for the cronjob node pool of Karpenter.
+func createKarpenterCronjobOverrideAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"consolidation": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"enabled":    types.BoolType,
+				"days":       types.ListType{ElemType: types.StringType},
+				"start_time": types.StringType,
+				"duration":   types.StringType,
+			},
+		},
+		"limits": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"enabled":                 types.BoolType,
+				"max_cpu_in_vcpu":         types.Int64Type,
+				"max_memory_in_gibibytes": types.Int64Type,
+			},
+		},
+		"consolidate_after": types.StringType,
+	}
+}
+
+// fromQoveryCronjobNodePoolOverride returns the cronjob node pool override of Karpenter, null when the cluster has none.
+func fromQoveryCronjobNodePoolOverride(cronjobOverride *qovery.KarpenterCronjobNodePoolOverride) types.Object {
+	attrTypes := createKarpenterCronjobOverrideAttrTypes()
+	if cronjobOverride == nil {
+		return types.ObjectNull(attrTypes)
+	}
+
+	consolidationType := attrTypes["consolidation"].(types.ObjectType)
+	consolidation := types.ObjectNull(consolidationType.AttrTypes)
+	if cronjobOverride.Consolidation != nil {
+		days := make([]attr.Value, 0, len(cronjobOverride.Consolidation.Days))
+		for _, day := range cronjobOverride.Consolidation.Days {
+			days = append(days, types.StringValue(string(day)))
+		}
+		consolidation = types.ObjectValueMust(consolidationType.AttrTypes, map[string]attr.Value{
+			"enabled":    types.BoolValue(cronjobOverride.Consolidation.Enabled),
+			"days":       types.ListValueMust(types.StringType, days),
+			"start_time": types.StringValue(cronjobOverride.Consolidation.StartTime),
+			"duration":   types.StringValue(cronjobOverride.Consolidation.Duration),
+		})
+	}
+
+	limitsType := attrTypes["limits"].(types.ObjectType)
+	limits := types.ObjectNull(limitsType.AttrTypes)
+	if cronjobOverride.Limits != nil {
+		limits = types.ObjectValueMust(limitsType.AttrTypes, map[string]attr.Value{
+			"enabled":                 types.BoolValue(cronjobOverride.Limits.Enabled),
+			"max_cpu_in_vcpu":         types.Int64Value(int64(cronjobOverride.Limits.MaxCpuInVcpu)),
+			"max_memory_in_gibibytes": types.Int64Value(int64(cronjobOverride.Limits.MaxMemoryInGibibytes)),
+		})
+	}
+
+	return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"consolidation":     consolidation,
+		"limits":            limits,
+		"consolidate_after": FromStringPointer(cronjobOverride.ConsolidateAfter),
+	})
+}
+
 // createFeaturesAttrTypes returns the attribute types for the features object
 func createFeaturesAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
@@ -1701,6 +1942,12 @@ func createKarpenterFeatureAttrValue(karpenterParameters *qovery.ClusterFeatureK
 		)
 	}
 
+	// Inject gpu override
+	qoveryNodePoolsAttrVals["gpu_override"] = fromQoveryGpuNodePoolOverride(karpenterParameters.QoveryNodePools.GpuOverride)
+
+	// Inject cronjob override
+	qoveryNodePoolsAttrVals["cronjob_override"] = fromQoveryCronjobNodePoolOverride(karpenterParameters.QoveryNodePools.CronjobOverride)
+
 	// Inject qovery_node_pools
 	attrVals["qovery_node_pools"], diags = types.ObjectValue(map[string]attr.Type{
 		"requirements": types.ListType{ElemType: types.ObjectType{
@@ -1742,6 +1989,8 @@ func createKarpenterFeatureAttrValue(karpenterParameters *qovery.ClusterFeatureK
 				},
 			},
 		},
+		"gpu_override":     types.ObjectType{AttrTypes: createKarpenterGpuOverrideAttrTypes()},
+		"cronjob_override": types.ObjectType{AttrTypes: createKarpenterCronjobOverrideAttrTypes()},
 	}, qoveryNodePoolsAttrVals)
 
 	if diags.HasError() {
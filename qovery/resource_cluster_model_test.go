@@ -8,22 +8,16 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/qovery/qovery-client-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
-func makeTestClusterInfo(credID string) *qovery.ClusterCloudProviderInfo {
-	id := credID
-	return &qovery.ClusterCloudProviderInfo{
-		Credentials: &qovery.ClusterCloudProviderInfoCredentials{Id: &id},
-	}
-}
-
 func TestCluster_toUpsertClusterRequest_KarpenterValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -333,14 +327,21 @@ func TestCluster_toUpsertClusterRequest_KarpenterValidation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result, err := tt.cluster.toUpsertClusterRequest(tt.state)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			// New clusters go through the creation rules, existing ones only through the common ones.
+			if tt.state == nil {
+				err = result.ClusterUpsertRequest.ValidateCreation()
+			} else {
+				err = result.ClusterUpsertRequest.Validate()
+			}
 
 			if tt.expectError {
 				require.Error(t, err, "Expected an error but got none")
 				assert.Contains(t, err.Error(), tt.errorMsg, "Error message should contain expected text")
-				assert.Nil(t, result, "Result should be nil when there's an error")
 			} else {
 				require.NoError(t, err, "Expected no error but got: %v", err)
-				assert.NotNil(t, result, "Result should not be nil when there's no error")
 			}
 		})
 	}
@@ -348,7 +349,7 @@ func TestCluster_toUpsertClusterRequest_KarpenterValidation(t *testing.T) {
 
 func TestCluster_toUpsertClusterRequest_KarpenterValidationWithEmptyFeatures(t *testing.T) {
 	// Test that an empty features object (not null) also fails validation for new AWS EKS clusters
-	cluster := Cluster{
+	c := Cluster{
 		OrganizationId:  types.StringValue("org-123"),
 		CredentialsId:   types.StringValue("cred-123"),
 		Name:            types.StringValue("test-cluster"),
@@ -365,11 +366,12 @@ func TestCluster_toUpsertClusterRequest_KarpenterValidationWithEmptyFeatures(t *
 		),
 	}
 
-	result, err := cluster.toUpsertClusterRequest(nil)
+	result, err := c.toUpsertClusterRequest(nil)
+	require.NoError(t, err)
 
+	err = result.ClusterUpsertRequest.ValidateCreation()
 	require.Error(t, err, "Expected an error for new AWS EKS cluster without Karpenter")
-	assert.Contains(t, err.Error(), "Karpenter is required for new EKS (AWS MANAGED) clusters")
-	assert.Nil(t, result)
+	assert.ErrorIs(t, err, cluster.ErrKarpenterRequired)
 }
 
 // buildGcpExistingVpcFeatureObject creates a Terraform features object with a gcp_existing_vpc block.
@@ -1275,42 +1277,39 @@ func TestCluster_toUpsertClusterRequest_LabelsGroupIds(t *testing.T) {
 			require.NotNil(t, params)
 
 			if tc.expectedIds == nil {
-				assert.Nil(t, params.ClusterRequest.LabelsGroups)
+				assert.Nil(t, params.ClusterUpsertRequest.LabelsGroupIds)
 				return
 			}
 
-			gotIds := make([]string, 0, len(params.ClusterRequest.LabelsGroups))
-			for _, lg := range params.ClusterRequest.LabelsGroups {
-				require.NotNil(t, lg.Id)
-				gotIds = append(gotIds, *lg.Id)
-			}
-			assert.ElementsMatch(t, tc.expectedIds, gotIds)
+			assert.ElementsMatch(t, tc.expectedIds, params.ClusterUpsertRequest.LabelsGroupIds)
 		})
 	}
 }
 
-func TestCluster_convertResponseToCluster_LabelsGroupIds(t *testing.T) {
+func newTestDomainCluster(mutate func(c *cluster.Cluster)) *cluster.Cluster {
+	c := &cluster.Cluster{
+		ID:             uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+		OrganizationID: uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+		CredentialsID:  new("cred-123"),
+		Name:           "c",
+		CloudProvider:  cluster.CloudProviderAWS,
+		Region:         "us-east-1",
+	}
+	if mutate != nil {
+		mutate(c)
+	}
+	return c
+}
+
+func TestCluster_convertDomainClusterToCluster_LabelsGroupIds(t *testing.T) {
 	ctx := context.Background()
 
 	id1 := "11111111-1111-1111-1111-111111111111"
 	id2 := "22222222-2222-2222-2222-222222222222"
 
-	clusterResp := &qovery.Cluster{
-		Id:            "cluster-123",
-		Name:          "c",
-		CloudProvider: qovery.CLOUDVENDORENUM_AWS,
-		Region:        "us-east-1",
-		LabelsGroups: []qovery.ClusterLabelsGroup{
-			{Id: &id1},
-			{Id: &id2},
-		},
-	}
-	res := &client.ClusterResponse{
-		OrganizationID:      "org-123",
-		ClusterResponse:     clusterResp,
-		ClusterInfo:         makeTestClusterInfo("cred-123"),
-		ClusterRoutingTable: &client.ClusterRoutingTable{},
-	}
+	c := newTestDomainCluster(func(c *cluster.Cluster) {
+		c.LabelsGroupIds = []string{id1, id2}
+	})
 
 	t.Run("plan has non-null labels_group_ids -> populated set", func(t *testing.T) {
 		t.Parallel()
@@ -1318,7 +1317,7 @@ func TestCluster_convertResponseToCluster_LabelsGroupIds(t *testing.T) {
 			LabelsGroupIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(id1)}),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		require.False(t, out.LabelsGroupIds.IsNull())
 		elems := out.LabelsGroupIds.Elements()
@@ -1335,47 +1334,13 @@ func TestCluster_convertResponseToCluster_LabelsGroupIds(t *testing.T) {
 			LabelsGroupIds: types.SetNull(types.StringType),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.True(t, out.LabelsGroupIds.IsNull())
 	})
-
-	t.Run("response with nil Id in ClusterLabelsGroup -> entry is skipped", func(t *testing.T) {
-		t.Parallel()
-		// Malformed API response: one entry has a nil Id. It must be silently skipped.
-		nilIdResp := &qovery.Cluster{
-			Id:            "cluster-456",
-			Name:          "c",
-			CloudProvider: qovery.CLOUDVENDORENUM_AWS,
-			Region:        "us-east-1",
-			LabelsGroups: []qovery.ClusterLabelsGroup{
-				{Id: &id1},
-				{Id: nil},
-			},
-		}
-		nilIdRes := &client.ClusterResponse{
-			OrganizationID:      "org-123",
-			ClusterResponse:     nilIdResp,
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
-		initialPlan := Cluster{
-			LabelsGroupIds: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(id1)}),
-		}
-
-		out := convertResponseToCluster(ctx, nilIdRes, initialPlan)
-
-		require.False(t, out.LabelsGroupIds.IsNull())
-		elems := out.LabelsGroupIds.Elements()
-		gotIds := make([]string, 0, len(elems))
-		for _, e := range elems {
-			gotIds = append(gotIds, e.(types.String).ValueString())
-		}
-		assert.ElementsMatch(t, []string{id1}, gotIds)
-	})
 }
 
-func TestCluster_convertResponseToCluster_KarpenterNodeCounts(t *testing.T) {
+func TestCluster_convertDomainClusterToCluster_KarpenterNodeCounts(t *testing.T) {
 	ctx := context.Background()
 
 	karpenterID := featureIdKarpenter
@@ -1383,32 +1348,23 @@ func TestCluster_convertResponseToCluster_KarpenterNodeCounts(t *testing.T) {
 	apiMin := int32(1)
 	apiMax := int32(10)
 
-	newRes := func(features []qovery.ClusterFeatureResponse) *client.ClusterResponse {
-		return &client.ClusterResponse{
-			OrganizationID: "org-123",
-			ClusterResponse: &qovery.Cluster{
-				Id:              "cluster-123",
-				Name:            "c",
-				CloudProvider:   qovery.CLOUDVENDORENUM_AWS,
-				Region:          "us-east-1",
-				MinRunningNodes: &apiMin,
-				MaxRunningNodes: &apiMax,
-				Features:        features,
-			},
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
+	newCluster := func(features []qovery.ClusterFeatureResponse) *cluster.Cluster {
+		return newTestDomainCluster(func(c *cluster.Cluster) {
+			c.MinRunningNodes = &apiMin
+			c.MaxRunningNodes = &apiMax
+			c.Features = features
+		})
 	}
 
 	t.Run("karpenter preserves plan node counts over API sentinel", func(t *testing.T) {
 		t.Parallel()
-		res := newRes([]qovery.ClusterFeatureResponse{{Id: &karpenterID}})
+		c := newCluster([]qovery.ClusterFeatureResponse{{Id: &karpenterID}})
 		initialPlan := Cluster{
 			MinRunningNodes: types.Int64Value(sentinel),
 			MaxRunningNodes: types.Int64Value(sentinel),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, sentinel, out.MinRunningNodes.ValueInt64())
 		assert.Equal(t, sentinel, out.MaxRunningNodes.ValueInt64())
@@ -1416,118 +1372,97 @@ func TestCluster_convertResponseToCluster_KarpenterNodeCounts(t *testing.T) {
 
 	t.Run("non-karpenter uses API node counts", func(t *testing.T) {
 		t.Parallel()
-		res := newRes(nil)
+		c := newCluster(nil)
 		initialPlan := Cluster{
 			MinRunningNodes: types.Int64Value(sentinel),
 			MaxRunningNodes: types.Int64Value(sentinel),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, int64(apiMin), out.MinRunningNodes.ValueInt64())
 		assert.Equal(t, int64(apiMax), out.MaxRunningNodes.ValueInt64())
 	})
 }
 
-func TestCluster_convertResponseToCluster_KarpenterInstanceType(t *testing.T) {
+func TestCluster_convertDomainClusterToCluster_KarpenterInstanceType(t *testing.T) {
 	ctx := context.Background()
 
 	karpenterID := featureIdKarpenter
 	apiEcho := "KARPENTER" // literal returned by the API when Karpenter is enabled
 	planned := "t3a.medium"
 
-	newRes := func(instanceType string, features []qovery.ClusterFeatureResponse) *client.ClusterResponse {
-		return &client.ClusterResponse{
-			OrganizationID: "org-123",
-			ClusterResponse: &qovery.Cluster{
-				Id:            "cluster-123",
-				Name:          "c",
-				CloudProvider: qovery.CLOUDVENDORENUM_AWS,
-				Region:        "us-east-1",
-				InstanceType:  &instanceType,
-				Features:      features,
-			},
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
+	newCluster := func(instanceType string, features []qovery.ClusterFeatureResponse) *cluster.Cluster {
+		return newTestDomainCluster(func(c *cluster.Cluster) {
+			c.InstanceType = &instanceType
+			c.Features = features
+		})
 	}
 
 	t.Run("karpenter preserves plan instance_type over API echo", func(t *testing.T) {
 		t.Parallel()
-		res := newRes(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
+		c := newCluster(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
 		initialPlan := Cluster{
 			InstanceType: types.StringValue(planned),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, planned, out.InstanceType.ValueString())
 	})
 
 	t.Run("karpenter with null plan instance_type uses API echo", func(t *testing.T) {
 		t.Parallel()
-		res := newRes(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
+		c := newCluster(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
 		initialPlan := Cluster{
 			InstanceType: types.StringNull(),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, apiEcho, out.InstanceType.ValueString())
 	})
 
 	t.Run("karpenter with unknown plan instance_type uses API echo", func(t *testing.T) {
 		t.Parallel()
-		res := newRes(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
+		c := newCluster(apiEcho, []qovery.ClusterFeatureResponse{{Id: &karpenterID}})
 		initialPlan := Cluster{
 			InstanceType: types.StringUnknown(),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, apiEcho, out.InstanceType.ValueString())
 	})
 
 	t.Run("non-karpenter uses API instance_type", func(t *testing.T) {
 		t.Parallel()
-		res := newRes("m5.large", nil)
+		c := newCluster("m5.large", nil)
 		initialPlan := Cluster{
 			InstanceType: types.StringValue(planned),
 		}
 
-		out := convertResponseToCluster(ctx, res, initialPlan)
+		out := convertDomainClusterToCluster(ctx, c, initialPlan)
 
 		assert.Equal(t, "m5.large", out.InstanceType.ValueString())
 	})
 }
 
-func TestResponseHasKarpenter(t *testing.T) {
-	t.Parallel()
-
-	karpenterID := featureIdKarpenter
-	otherID := featureIdVpcSubnet
-
-	assert.True(t, responseHasKarpenter([]qovery.ClusterFeatureResponse{{Id: &karpenterID}}))
-	assert.False(t, responseHasKarpenter([]qovery.ClusterFeatureResponse{{Id: &otherID}}))
-	assert.False(t, responseHasKarpenter([]qovery.ClusterFeatureResponse{{Id: nil}}))
-	assert.False(t, responseHasKarpenter(nil))
-}
-
 func TestCluster_toUpsertClusterRequest_DesiredState(t *testing.T) {
 	tests := []struct {
 		name          string
 		state         string
-		expectedState qovery.ClusterStateEnum
+		expectedState cluster.State
 	}{
-		{"READY", "READY", qovery.CLUSTERSTATEENUM_READY},
-		{"STOPPED", "STOPPED", qovery.CLUSTERSTATEENUM_STOPPED},
-		{"DEPLOYED", "DEPLOYED", qovery.CLUSTERSTATEENUM_DEPLOYED},
+		{"READY", "READY", cluster.StateReady},
+		{"STOPPED", "STOPPED", cluster.StateStopped},
+		{"DEPLOYED", "DEPLOYED", cluster.StateDeployed},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			cluster := Cluster{
+			c := Cluster{
 				OrganizationId:  types.StringValue("org-123"),
 				CredentialsId:   types.StringValue("cred-123"),
 				Name:            types.StringValue("test-cluster"),
@@ -1542,44 +1477,34 @@ func TestCluster_toUpsertClusterRequest_DesiredState(t *testing.T) {
 				Features:        types.ObjectNull(createFeaturesAttrTypes()),
 			}
 
-			params, err := cluster.toUpsertClusterRequest(nil)
+			params, err := c.toUpsertClusterRequest(nil)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expectedState, params.DesiredState)
 		})
 	}
 }
 
-func TestCluster_convertResponseToCluster_State(t *testing.T) {
+func TestCluster_convertDomainClusterToCluster_State(t *testing.T) {
 	ctx := context.Background()
 
 	tests := []struct {
 		name          string
-		apiState      qovery.ClusterStateEnum
+		state         cluster.State
 		expectedState string
 	}{
-		{"READY", qovery.CLUSTERSTATEENUM_READY, "READY"},
-		{"STOPPED", qovery.CLUSTERSTATEENUM_STOPPED, "STOPPED"},
-		{"DEPLOYED", qovery.CLUSTERSTATEENUM_DEPLOYED, "DEPLOYED"},
+		{"READY", cluster.StateReady, "READY"},
+		{"STOPPED", cluster.StateStopped, "STOPPED"},
+		{"DEPLOYED", cluster.StateDeployed, "DEPLOYED"},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			state := tc.apiState
-			res := &client.ClusterResponse{
-				OrganizationID: "org-123",
-				ClusterResponse: &qovery.Cluster{
-					Id:            "cluster-123",
-					Name:          "c",
-					CloudProvider: qovery.CLOUDVENDORENUM_AWS,
-					Region:        "us-east-1",
-					Status:        &state,
-				},
-				ClusterInfo:         makeTestClusterInfo("cred-123"),
-				ClusterRoutingTable: &client.ClusterRoutingTable{},
-			}
+			c := newTestDomainCluster(func(c *cluster.Cluster) {
+				c.State = new(tc.state)
+			})
 
-			out := convertResponseToCluster(ctx, res, Cluster{})
+			out := convertDomainClusterToCluster(ctx, c, Cluster{})
 			assert.Equal(t, tc.expectedState, out.State.ValueString())
 		})
 	}
@@ -1727,7 +1652,7 @@ func TestFromQoveryClusterOutput(t *testing.T) {
 	}
 }
 
-func TestCluster_convertResponseToCluster_InfrastructureOutputs(t *testing.T) {
+func TestCluster_convertDomainClusterToCluster_InfrastructureOutputs(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -1748,20 +1673,11 @@ func TestCluster_convertResponseToCluster_InfrastructureOutputs(t *testing.T) {
 		// Reproduces the production scenario: cluster in DEPLOYMENT_ERROR, API returns
 		// infrastructure_outputs: null. Without the fallback, every downstream resource
 		// referencing qovery_cluster.cluster.infrastructure_outputs.* would see null.
-		res := &client.ClusterResponse{
-			OrganizationID: "org-123",
-			ClusterResponse: &qovery.Cluster{
-				Id:                    "cluster-123",
-				Name:                  "c",
-				CloudProvider:         qovery.CLOUDVENDORENUM_AWS,
-				Region:                "us-east-1",
-				InfrastructureOutputs: nil,
-			},
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
+		c := newTestDomainCluster(func(c *cluster.Cluster) {
+			c.InfrastructureOutputs = nil
+		})
 
-		out := convertResponseToCluster(ctx, res, priorState)
+		out := convertDomainClusterToCluster(ctx, c, priorState)
 
 		require.False(t, out.InfrastructureOutputs.IsNull())
 		attrs := out.InfrastructureOutputs.Attributes()
@@ -1772,28 +1688,19 @@ func TestCluster_convertResponseToCluster_InfrastructureOutputs(t *testing.T) {
 
 	t.Run("api returns fresh EKS outputs -> api values win over prior state", func(t *testing.T) {
 		t.Parallel()
-		res := &client.ClusterResponse{
-			OrganizationID: "org-123",
-			ClusterResponse: &qovery.Cluster{
-				Id:            "cluster-123",
-				Name:          "c",
-				CloudProvider: qovery.CLOUDVENDORENUM_AWS,
-				Region:        "us-east-1",
-				InfrastructureOutputs: &qovery.InfrastructureOutputs{
-					EksInfrastructureOutputs: qovery.NewEksInfrastructureOutputs(
-						"EKS",
-						"qovery-zNEW",
-						"arn:aws:eks:us-east-1:123456789012:cluster/qovery-zNEW",
-						"https://oidc.eks.us-east-1.amazonaws.com/id/NEW",
-						"vpc-new",
-					),
-				},
-			},
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
+		c := newTestDomainCluster(func(c *cluster.Cluster) {
+			c.InfrastructureOutputs = &qovery.InfrastructureOutputs{
+				EksInfrastructureOutputs: qovery.NewEksInfrastructureOutputs(
+					"EKS",
+					"qovery-zNEW",
+					"arn:aws:eks:us-east-1:123456789012:cluster/qovery-zNEW",
+					"https://oidc.eks.us-east-1.amazonaws.com/id/NEW",
+					"vpc-new",
+				),
+			}
+		})
 
-		out := convertResponseToCluster(ctx, res, priorState)
+		out := convertDomainClusterToCluster(ctx, c, priorState)
 
 		require.False(t, out.InfrastructureOutputs.IsNull())
 		attrs := out.InfrastructureOutputs.Attributes()
@@ -1807,20 +1714,11 @@ func TestCluster_convertResponseToCluster_InfrastructureOutputs(t *testing.T) {
 		emptyState := Cluster{
 			InfrastructureOutputs: types.ObjectNull(clusterInfrastructureOutputsAttrTypes),
 		}
-		res := &client.ClusterResponse{
-			OrganizationID: "org-123",
-			ClusterResponse: &qovery.Cluster{
-				Id:                    "cluster-123",
-				Name:                  "c",
-				CloudProvider:         qovery.CLOUDVENDORENUM_AWS,
-				Region:                "us-east-1",
-				InfrastructureOutputs: nil,
-			},
-			ClusterInfo:         makeTestClusterInfo("cred-123"),
-			ClusterRoutingTable: &client.ClusterRoutingTable{},
-		}
+		c := newTestDomainCluster(func(c *cluster.Cluster) {
+			c.InfrastructureOutputs = nil
+		})
 
-		out := convertResponseToCluster(ctx, res, emptyState)
+		out := convertDomainClusterToCluster(ctx, c, emptyState)
 
 		require.False(t, out.InfrastructureOutputs.IsNull())
 		attrs := out.InfrastructureOutputs.Attributes()
@@ -2025,6 +1923,57 @@ func TestCluster_toUpsertClusterRequest_SpecChangeForcesDeploy(t *testing.T) {
 	}
 }
 
+// TestCluster_toUpsertClusterRequest_RoutingTable checks that the routing table is only pushed
+// when it changes, so that the routes managed with qovery_cluster_routing_table or
+// qovery_cluster_route are not overwritten.
+func TestCluster_toUpsertClusterRequest_RoutingTable(t *testing.T) {
+	t.Parallel()
+
+	routes, diags := newClusterRouteSet(cluster.RoutingTable{
+		{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-0a1b2c3d"},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	tests := []struct {
+		name   string
+		state  types.Set
+		plan   types.Set
+		wantRT cluster.RoutingTable
+	}{
+		{
+			name:  "unset",
+			state: types.SetNull(types.ObjectType{AttrTypes: clusterRouteAttrTypes}),
+			plan:  types.SetNull(types.ObjectType{AttrTypes: clusterRouteAttrTypes}),
+		},
+		{
+			name:  "unchanged",
+			state: routes,
+			plan:  routes,
+		},
+		{
+			name:   "added",
+			state:  types.SetNull(types.ObjectType{AttrTypes: clusterRouteAttrTypes}),
+			plan:   routes,
+			wantRT: cluster.RoutingTable{{Description: "peering", Destination: "10.1.0.0/16", Target: "pcx-0a1b2c3d"}},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			state := baseScwCluster()
+			state.RoutingTables = tc.state
+			plan := baseScwCluster()
+			plan.RoutingTables = tc.plan
+
+			request, err := plan.toUpsertClusterRequest(&state)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRT, request.ClusterUpsertRequest.RoutingTable)
+		})
+	}
+}
+
 // TestCluster_hasClusterSpecDiff exercises the spec-diff helper directly, including
 // kubernetes_mode and labels_group_ids, and confirms metadata changes are ignored.
 func TestCluster_hasClusterSpecDiff(t *testing.T) {
@@ -2396,3 +2345,96 @@ func TestFromQoveryClusterFeatures_GkeKmsKey_AbsentDefaultsToNull(t *testing.T)
 	require.True(t, ok, "gke_kms_key attribute must always be present in the features object")
 	assert.True(t, gkeKmsKeyAttr.(types.String).IsNull(), "gke_kms_key must be null when the API returns no GKE_KMS_KEY feature")
 }
+
+// TestKarpenterGpuNodePoolOverride_RoundTrip asserts that the GPU node pool returned by the API
+// is converted back to the same API value.
+func TestKarpenterGpuNodePoolOverride_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	newKarpenter := func(gpuOverride types.Object) types.Object {
+		nodePoolsAttrTypes := map[string]attr.Type{"gpu_override": gpuOverride.Type(context.Background())}
+		return types.ObjectValueMust(
+			map[string]attr.Type{"qovery_node_pools": types.ObjectType{AttrTypes: nodePoolsAttrTypes}},
+			map[string]attr.Value{
+				"qovery_node_pools": types.ObjectValueMust(nodePoolsAttrTypes, map[string]attr.Value{"gpu_override": gpuOverride}),
+			},
+		)
+	}
+
+	t.Run("gpu node pool", func(t *testing.T) {
+		t.Parallel()
+
+		gpuOverride := &qovery.KarpenterGpuNodePoolOverride{
+			Requirements: []qovery.KarpenterNodePoolRequirement{
+				{
+					Key:      qovery.KARPENTERNODEPOOLREQUIREMENTKEY_INSTANCE_FAMILY,
+					Operator: qovery.KARPENTERNODEPOOLREQUIREMENTOPERATOR_IN,
+					Values:   []string{"g5", "g6"},
+				},
+			},
+			Consolidation:    qovery.NewKarpenterNodePoolConsolidation(true, []qovery.WeekdayEnum{qovery.WEEKDAYENUM_SATURDAY}, "PT02:00", "PT04H00M"),
+			Limits:           qovery.NewKarpenterNodePoolLimits(true, 64, 256, 8),
+			SpotEnabled:      new(false),
+			DiskSizeInGib:    new(int32(200)),
+			DiskIops:         new(int32(6000)),
+			DiskThroughput:   new(int32(500)),
+			ConsolidateAfter: new("30m"),
+		}
+
+		result, err := extractGpuNodePoolOverrideFromTypesObject(newKarpenter(fromQoveryGpuNodePoolOverride(gpuOverride)))
+		require.NoError(t, err)
+		assert.Equal(t, gpuOverride, result)
+	})
+
+	t.Run("no gpu node pool", func(t *testing.T) {
+		t.Parallel()
+
+		value := fromQoveryGpuNodePoolOverride(nil)
+		assert.True(t, value.IsNull())
+
+		result, err := extractGpuNodePoolOverrideFromTypesObject(newKarpenter(value))
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+// TestKarpenterCronjobNodePoolOverride_RoundTrip asserts that the cronjob node pool override returned by the API
+// is converted back to the same API value.
+func TestKarpenterCronjobNodePoolOverride_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	newKarpenter := func(cronjobOverride types.Object) types.Object {
+		nodePoolsAttrTypes := map[string]attr.Type{"cronjob_override": cronjobOverride.Type(context.Background())}
+		return types.ObjectValueMust(
+			map[string]attr.Type{"qovery_node_pools": types.ObjectType{AttrTypes: nodePoolsAttrTypes}},
+			map[string]attr.Value{
+				"qovery_node_pools": types.ObjectValueMust(nodePoolsAttrTypes, map[string]attr.Value{"cronjob_override": cronjobOverride}),
+			},
+		)
+	}
+
+	t.Run("cronjob node pool override", func(t *testing.T) {
+		t.Parallel()
+
+		cronjobOverride := &qovery.KarpenterCronjobNodePoolOverride{
+			Consolidation:    qovery.NewKarpenterNodePoolConsolidation(true, []qovery.WeekdayEnum{qovery.WEEKDAYENUM_SUNDAY}, "PT01:00", "PT02H00M"),
+			Limits:           qovery.NewKarpenterNodePoolLimits(true, 32, 128, 0),
+			ConsolidateAfter: new("10m"),
+		}
+
+		result, err := extractCronjobNodePoolOverrideFromTypesObject(newKarpenter(fromQoveryCronjobNodePoolOverride(cronjobOverride)))
+		require.NoError(t, err)
+		assert.Equal(t, cronjobOverride, result)
+	})
+
+	t.Run("no cronjob node pool override", func(t *testing.T) {
+		t.Parallel()
+
+		value := fromQoveryCronjobNodePoolOverride(nil)
+		assert.True(t, value.IsNull())
+
+		result, err := extractCronjobNodePoolOverrideFromTypesObject(newKarpenter(value))
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
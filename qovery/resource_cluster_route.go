@@ -0,0 +1,238 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = &clusterRouteResource{}
+	_ resource.ResourceWithImportState    = clusterRouteResource{}
+	_ resource.ResourceWithValidateConfig = clusterRouteResource{}
+)
+
+// clusterRouteErrorMappings attaches the route domain errors to the attribute they relate to.
+var clusterRouteErrorMappings = []attributeErrorMapping{
+	{err: cluster.ErrInvalidRouteDestinationParam, path: path.Root("destination")},
+	{err: cluster.ErrInvalidRouteTargetParam, path: path.Root("target")},
+	{err: cluster.ErrRouteAlreadyExists, path: path.Root("destination"), hint: "Import the existing route, or remove it from the routing table of the cluster."},
+}
+
+type clusterRouteResource struct {
+	clusterService cluster.Service
+}
+
+func newClusterRouteResource() resource.Resource {
+	return &clusterRouteResource{}
+}
+
+func (r clusterRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_route"
+}
+
+func (r *clusterRouteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clusterService = provider.clusterService
+}
+
+func (r clusterRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Qovery cluster route resource. This can be used to manage a single route of a cluster, keeping its other routes.",
+		MarkdownDescription: "Provides a Qovery cluster route resource. This can be used to manage a single route of a cluster, keeping its other routes.\n\n" +
+			"Routes are identified by their destination, which must be unique among the routes of the cluster. " +
+			"Leave the `routing_table` attribute of the cluster unset, and do not use this resource together with `qovery_cluster_routing_table` on the same cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the cluster route, made of the cluster id and the destination of the route.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Id of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "Id of the cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description:         "Destination of the route.",
+				MarkdownDescription: "Destination CIDR block of the route (e.g., `10.1.0.0/16`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description:         "Target of the route.",
+				MarkdownDescription: "Target of the route: an IP address or the id of a gateway or endpoint (e.g., a VPC peering connection ID or NAT gateway ID).",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the route.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the destination and the target of the route at plan time.
+// The destinations of the other routes of the cluster can only be checked on apply.
+func (r clusterRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ClusterRoutingTableRoute
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !config.isKnown() {
+		return
+	}
+
+	if err := config.toDomainRoute().Validate(); err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Config.Schema, "Invalid cluster route", err, clusterRouteErrorMappings)
+	}
+}
+
+// Create qovery cluster route resource
+func (r clusterRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ClusterRoutingTableRoute
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the route to the routing table of the cluster
+	route, err := r.clusterService.AddRoute(ctx, plan.OrganizationId.ValueString(), plan.ClusterId.ValueString(), plan.toDomainRoute())
+	if err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster route create", err, clusterRouteErrorMappings)
+		return
+	}
+
+	state := convertDomainRouteToClusterRoutingTableRoute(plan.OrganizationId.ValueString(), plan.ClusterId.ValueString(), *route)
+	tflog.Trace(ctx, "created cluster route", map[string]any{"cluster_id": state.ClusterId.ValueString(), "destination": state.Destination.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery cluster route resource
+func (r clusterRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ClusterRoutingTableRoute
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get route from the API
+	route, err := r.clusterService.GetRoute(ctx, state.OrganizationId.ValueString(), state.ClusterId.ValueString(), state.Destination.ValueString())
+	if errors.Is(err, cluster.ErrRouteNotFound) {
+		tflog.Warn(ctx, "cluster route no longer present in the routing table of the cluster — removing from state",
+			map[string]any{"cluster_id": state.ClusterId.ValueString(), "destination": state.Destination.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if handleDomainReadNotFound(ctx, resp, err, "Error on cluster route read") {
+		return
+	}
+
+	state = convertDomainRouteToClusterRoutingTableRoute(state.OrganizationId.ValueString(), state.ClusterId.ValueString(), *route)
+	tflog.Trace(ctx, "read cluster route", map[string]any{"cluster_id": state.ClusterId.ValueString(), "destination": state.Destination.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update qovery cluster route resource
+func (r clusterRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state ClusterRoutingTableRoute
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the route in the routing table of the cluster
+	route, err := r.clusterService.UpdateRoute(ctx, state.OrganizationId.ValueString(), state.ClusterId.ValueString(), plan.toDomainRoute())
+	if err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster route update", err, clusterRouteErrorMappings)
+		return
+	}
+
+	state = convertDomainRouteToClusterRoutingTableRoute(state.OrganizationId.ValueString(), state.ClusterId.ValueString(), *route)
+	tflog.Trace(ctx, "updated cluster route", map[string]any{"cluster_id": state.ClusterId.ValueString(), "destination": state.Destination.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete qovery cluster route resource
+func (r clusterRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ClusterRoutingTableRoute
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the route from the routing table of the cluster
+	if err := r.clusterService.DeleteRoute(ctx, state.OrganizationId.ValueString(), state.ClusterId.ValueString(), state.Destination.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error on cluster route delete", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted cluster route", map[string]any{"cluster_id": state.ClusterId.ValueString(), "destination": state.Destination.ValueString()})
+
+	// Remove route from the state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery cluster route resource using its organization id, cluster id and destination
+func (r clusterRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id,cluster_id,destination. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), clusterRouteID(idParts[1], idParts[2]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination"), idParts[2])...)
+}
@@ -0,0 +1,48 @@
+package qovery
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// ClusterRoutingTableRoute represents the Terraform model for the cluster route resource.
+type ClusterRoutingTableRoute struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	Destination    types.String `tfsdk:"destination"`
+	Target         types.String `tfsdk:"target"`
+	Description    types.String `tfsdk:"description"`
+}
+
+// isKnown returns true if the route attributes are all known, i.e. the route can be validated.
+func (r ClusterRoutingTableRoute) isKnown() bool {
+	return !r.Destination.IsUnknown() && !r.Target.IsUnknown() && !r.Description.IsUnknown()
+}
+
+func (r ClusterRoutingTableRoute) toDomainRoute() cluster.Route {
+	return cluster.Route{
+		Description: ToString(r.Description),
+		Destination: ToString(r.Destination),
+		Target:      ToString(r.Target),
+	}
+}
+
+func convertDomainRouteToClusterRoutingTableRoute(organizationID string, clusterID string, route cluster.Route) ClusterRoutingTableRoute {
+	return ClusterRoutingTableRoute{
+		Id:             types.StringValue(clusterRouteID(clusterID, route.Destination)),
+		OrganizationId: types.StringValue(organizationID),
+		ClusterId:      types.StringValue(clusterID),
+		Destination:    FromString(route.Destination),
+		Target:         FromString(route.Target),
+		Description:    FromString(route.Description),
+	}
+}
+
+// clusterRouteID returns the id of the route of a cluster, as routes are identified by their destination.
+func clusterRouteID(clusterID string, destination string) string {
+	return fmt.Sprintf("%s,%s", clusterID, destination)
+}
@@ -0,0 +1,169 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestClusterRoute_ValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := clusterRouteResource{}
+	var s resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &s)
+	require.False(t, s.Diagnostics.HasError(), s.Diagnostics)
+
+	testCases := []struct {
+		name          string
+		destination   tftypes.Value
+		target        tftypes.Value
+		expectedError error
+		expectedPath  *path.Path
+	}{
+		{
+			name:        "valid_route",
+			destination: tftypes.NewValue(tftypes.String, "10.1.0.0/16"),
+			target:      tftypes.NewValue(tftypes.String, "pcx-0a1b2c3d"),
+		},
+		{
+			name:        "unknown_target",
+			destination: tftypes.NewValue(tftypes.String, "10.1.0.0/16"),
+			target:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		{
+			name:          "host_bits_set",
+			destination:   tftypes.NewValue(tftypes.String, "10.1.2.3/16"),
+			target:        tftypes.NewValue(tftypes.String, "pcx-0a1b2c3d"),
+			expectedError: cluster.ErrInvalidRouteDestinationParam,
+			expectedPath:  new(path.Root("destination")),
+		},
+		{
+			name:          "swapped_destination_and_target",
+			destination:   tftypes.NewValue(tftypes.String, "10.0.0.1/32"),
+			target:        tftypes.NewValue(tftypes.String, "10.1.0.0/16"),
+			expectedError: cluster.ErrInvalidRouteTargetParam,
+			expectedPath:  new(path.Root("target")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tftypes.NewValue(s.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":              tftypes.NewValue(tftypes.String, nil),
+				"organization_id": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+				"cluster_id":      tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000002"),
+				"destination":     tc.destination,
+				"target":          tc.target,
+				"description":     tftypes.NewValue(tftypes.String, "peering"),
+			})
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: config},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if tc.expectedError == nil {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.expectedError.Error())
+			assert.Equal(t, tc.expectedPath.String(), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+		})
+	}
+}
+
+func TestClusterRoutingTable_ValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := clusterRoutingTableResource{}
+	var s resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &s)
+	require.False(t, s.Diagnostics.HasError(), s.Diagnostics)
+
+	routeType := s.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["routes"].(tftypes.Set).ElementType
+	newRoute := func(destination string, target string) tftypes.Value {
+		return tftypes.NewValue(routeType, map[string]tftypes.Value{
+			"description": tftypes.NewValue(tftypes.String, "peering"),
+			"destination": tftypes.NewValue(tftypes.String, destination),
+			"target":      tftypes.NewValue(tftypes.String, target),
+		})
+	}
+
+	testCases := []struct {
+		name             string
+		routes           []tftypes.Value
+		expectedError    error
+		expectedWarnings int
+	}{
+		{
+			name:   "no_routes",
+			routes: []tftypes.Value{},
+		},
+		{
+			name:   "valid_routes",
+			routes: []tftypes.Value{newRoute("10.1.0.0/16", "pcx-0a1b2c3d"), newRoute("10.2.0.0/16", "10.0.0.1")},
+		},
+		{
+			name:             "overlapping_routes",
+			routes:           []tftypes.Value{newRoute("10.1.0.0/16", "pcx-0a1b2c3d"), newRoute("10.1.128.0/17", "pcx-4e5f6a7b")},
+			expectedWarnings: 1,
+		},
+		{
+			name:          "duplicate_destinations",
+			routes:        []tftypes.Value{newRoute("10.1.0.0/16", "pcx-0a1b2c3d"), newRoute("10.1.0.0/16", "pcx-4e5f6a7b")},
+			expectedError: cluster.ErrDuplicateRouteDestination,
+		},
+		{
+			name:          "invalid_target",
+			routes:        []tftypes.Value{newRoute("10.1.0.0/16", "not a target")},
+			expectedError: cluster.ErrInvalidRouteTargetParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tftypes.NewValue(s.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":              tftypes.NewValue(tftypes.String, nil),
+				"organization_id": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+				"cluster_id":      tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000002"),
+				"routes":          tftypes.NewValue(tftypes.Set{ElementType: routeType}, tc.routes),
+			})
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s.Schema, Raw: config},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if tc.expectedError == nil {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				assert.Equal(t, tc.expectedWarnings, resp.Diagnostics.WarningsCount(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.expectedError.Error())
+			assert.Equal(t, path.Root("routes").String(), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.ResourceWithConfigure      = &clusterRoutingTableResource{}
+	_ resource.ResourceWithImportState    = clusterRoutingTableResource{}
+	_ resource.ResourceWithValidateConfig = clusterRoutingTableResource{}
+)
+
+// clusterRoutingTableErrorMappings attaches the routing table domain errors to the routes attribute.
+var clusterRoutingTableErrorMappings = []attributeErrorMapping{
+	{err: cluster.ErrInvalidRoute, path: path.Root("routes")},
+	{err: cluster.ErrInvalidRouteDestinationParam, path: path.Root("routes")},
+	{err: cluster.ErrInvalidRouteTargetParam, path: path.Root("routes")},
+	{err: cluster.ErrDuplicateRouteDestination, path: path.Root("routes")},
+}
+
+type clusterRoutingTableResource struct {
+	clusterService cluster.Service
+}
+
+func newClusterRoutingTableResource() resource.Resource {
+	return &clusterRoutingTableResource{}
+}
+
+func (r clusterRoutingTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_routing_table"
+}
+
+func (r *clusterRoutingTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clusterService = provider.clusterService
+}
+
+func (r clusterRoutingTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Qovery cluster routing table resource. This can be used to manage all the routes of a cluster separately from the cluster.",
+		MarkdownDescription: "Provides a Qovery cluster routing table resource. This can be used to manage all the routes of a cluster separately from the cluster.\n\n" +
+			"This resource is authoritative: the routes that are not declared in `routes` are removed from the cluster, and all the routes are removed when the resource is destroyed. " +
+			"Use `qovery_cluster_route` instead to manage some routes only.\n\n" +
+			"Leave the `routing_table` attribute of the cluster unset, and do not use this resource together with `qovery_cluster_route` on the same cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the cluster routing table. This is the cluster id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Id of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "Id of the cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfKnownChange(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description:         "List of routes of the cluster.",
+				MarkdownDescription: "Routes of the cluster. Route destinations must be unique: overlapping destinations, e.g. `10.0.0.0/8` and `10.1.0.0/16`, are reported as a warning at plan time, the traffic being routed by the most specific route.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description: "Description of the route.",
+							Required:    true,
+						},
+						"destination": schema.StringAttribute{
+							Description:         "Destination of the route.",
+							MarkdownDescription: "Destination CIDR block of the route (e.g., `10.1.0.0/16`).",
+							Required:            true,
+						},
+						"target": schema.StringAttribute{
+							Description:         "Target of the route.",
+							MarkdownDescription: "Target of the route: an IP address or the id of a gateway or endpoint (e.g., a VPC peering connection ID or NAT gateway ID).",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the routes at plan time.
+func (r clusterRoutingTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ClusterRoutingTable
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateClusterRoutesConfig(path.Root("routes"), config.Routes)...)
+}
+
+// Create qovery cluster routing table resource
+func (r clusterRoutingTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ClusterRoutingTable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the routing table of the cluster
+	routingTable, err := r.clusterService.EditRoutingTable(ctx, plan.OrganizationId.ValueString(), plan.ClusterId.ValueString(), plan.toDomainRoutingTable())
+	if err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster routing table create", err, clusterRoutingTableErrorMappings)
+		return
+	}
+
+	state, diags := convertDomainRoutingTableToClusterRoutingTable(plan.OrganizationId.ValueString(), plan.ClusterId.ValueString(), routingTable)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "created cluster routing table", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery cluster routing table resource
+func (r clusterRoutingTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ClusterRoutingTable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get routing table from the API
+	routingTable, err := r.clusterService.GetRoutingTable(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+	if handleDomainReadNotFound(ctx, resp, err, "Error on cluster routing table read") {
+		return
+	}
+
+	state, diags := convertDomainRoutingTableToClusterRoutingTable(state.OrganizationId.ValueString(), state.Id.ValueString(), routingTable)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "read cluster routing table", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update qovery cluster routing table resource
+func (r clusterRoutingTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state ClusterRoutingTable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the routing table of the cluster
+	routingTable, err := r.clusterService.EditRoutingTable(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), plan.toDomainRoutingTable())
+	if err != nil {
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on cluster routing table update", err, clusterRoutingTableErrorMappings)
+		return
+	}
+
+	state, diags := convertDomainRoutingTableToClusterRoutingTable(state.OrganizationId.ValueString(), state.Id.ValueString(), routingTable)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "updated cluster routing table", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete qovery cluster routing table resource
+func (r clusterRoutingTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ClusterRoutingTable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove all the routes of the cluster
+	if _, err := r.clusterService.EditRoutingTable(ctx, state.OrganizationId.ValueString(), state.Id.ValueString(), cluster.RoutingTable{}); err != nil {
+		resp.Diagnostics.AddError("Error on cluster routing table delete", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted cluster routing table", map[string]any{"cluster_id": state.ClusterId.ValueString()})
+
+	// Remove routing table from the state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery cluster routing table resource using its organization id and cluster id
+func (r clusterRoutingTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id,cluster_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+}
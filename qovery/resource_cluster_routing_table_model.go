@@ -0,0 +1,31 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// ClusterRoutingTable represents the Terraform model for the cluster routing table resource.
+type ClusterRoutingTable struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	Routes         types.Set    `tfsdk:"routes"`
+}
+
+func (rt ClusterRoutingTable) toDomainRoutingTable() cluster.RoutingTable {
+	return toClusterRouteList(rt.Routes).toDomainRoutingTable()
+}
+
+func convertDomainRoutingTableToClusterRoutingTable(organizationID string, clusterID string, routingTable cluster.RoutingTable) (ClusterRoutingTable, diag.Diagnostics) {
+	routes, diags := newClusterRouteSet(routingTable)
+
+	return ClusterRoutingTable{
+		Id:             types.StringValue(clusterID),
+		OrganizationId: types.StringValue(organizationID),
+		ClusterId:      types.StringValue(clusterID),
+		Routes:         routes,
+	}, diags
+}
@@ -12,8 +12,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 )
 
 // TestAcc_Cluster is the main AWS EKS lifecycle test using Karpenter and READY state
@@ -361,6 +362,43 @@ func TestAcc_ClusterWithReadyState(t *testing.T) {
 	}
 }
 
+// TestAcc_ScalewayClusterMovedFromCluster verifies that a qovery_cluster is moved to
+// qovery_scaleway_cluster with a moved block, without recreating the cluster.
+func TestAcc_ScalewayClusterMovedFromCluster(t *testing.T) {
+	t.Parallel()
+	testName := "scaleway-cluster-moved"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryClusterDestroy("qovery_scaleway_cluster.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSCWReadyConfig(testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryClusterExists("qovery_cluster.test"),
+				),
+			},
+			{
+				Config: testAccScalewayClusterMovedConfig(testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryClusterExists("qovery_scaleway_cluster.test"),
+					resource.TestCheckResourceAttr("qovery_scaleway_cluster.test", "name", generateTestName(testName)),
+					resource.TestCheckResourceAttr("qovery_scaleway_cluster.test", "region", "pl-waw-1"),
+					resource.TestCheckResourceAttr("qovery_scaleway_cluster.test", "state", "READY"),
+					resource.TestCheckNoResourceAttr("qovery_scaleway_cluster.test", "cloud_provider"),
+				),
+			},
+			{
+				ResourceName:            "qovery_scaleway_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s,", getTestOrganizationID()),
+				ImportStateVerifyIgnore: []string{"advanced_settings_json"},
+			},
+		},
+	})
+}
+
 // TestAcc_ClusterGcpNatGateways verifies the value-based semantics of
 // features.nat_gateways against the real API, on a GCP cluster in READY state
 // (no cloud infra provisioned). It pins the Terraform-visible invariants
@@ -508,15 +546,15 @@ func testAccQoveryClusterExists(resourceName string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("cluster.id not found")
 		}
-		var apiErr *apierrors.APIError
+		var err error
 		for attempt := 0; attempt < 3; attempt++ {
-			_, apiErr = apiClient.GetCluster(context.TODO(), getTestOrganizationID(), rs.Primary.ID, "{}", false)
-			if apiErr == nil {
+			_, err = qoveryServices.Cluster.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID, "{}", false)
+			if err == nil {
 				return nil
 			}
 			time.Sleep(3 * time.Second)
 		}
-		return apiErr
+		return err
 	}
 }
 
@@ -531,18 +569,18 @@ func testAccQoveryClusterDestroy(resourceName string) resource.TestCheckFunc {
 		}
 		// Retry to handle transient non-404 API responses that some cloud providers
 		// (e.g. GCP) briefly return right after cluster deletion.
-		var lastErr *apierrors.APIError
+		var lastErr error
 		for attempt := 0; attempt < 3; attempt++ {
-			_, lastErr = apiClient.GetCluster(context.TODO(), getTestOrganizationID(), rs.Primary.ID, "{}", false)
+			_, lastErr = qoveryServices.Cluster.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID, "{}", false)
 			if lastErr == nil {
 				return fmt.Errorf("found cluster but expected it to be deleted")
 			}
-			if apierrors.IsNotFound(lastErr) {
+			if apierrors.IsErrNotFound(errors.Cause(lastErr)) {
 				return nil
 			}
 			time.Sleep(3 * time.Second)
 		}
-		return fmt.Errorf("unexpected error checking for deleted cluster: %s", lastErr.Summary())
+		return fmt.Errorf("unexpected error checking for deleted cluster: %s", lastErr.Error())
 	}
 }
 
@@ -818,6 +856,26 @@ resource "qovery_cluster" "test" {
 `, getTestScalewayCredentialsID(), getTestOrganizationID(), generateTestName(testName))
 }
 
+func testAccScalewayClusterMovedConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "qovery_scaleway_cluster" "test" {
+  credentials_id    = "%s"
+  organization_id   = "%s"
+  name              = "%s"
+  region            = "pl-waw-1"
+  instance_type     = "DEV1-L"
+  min_running_nodes = 3
+  max_running_nodes = 3
+  state             = "READY"
+}
+
+moved {
+  from = qovery_cluster.test
+  to   = qovery_scaleway_cluster.test
+}
+`, getTestScalewayCredentialsID(), getTestOrganizationID(), generateTestName(testName))
+}
+
 func testAccClusterAzureReadyConfig(testName string) string {
 	return fmt.Sprintf(`
 resource "qovery_cluster" "test" {
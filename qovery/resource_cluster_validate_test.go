@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
 // makeValidateFeatures builds a features types.Object suitable for validateNatGatewaysConfig.
@@ -292,3 +294,107 @@ func TestValidateGkeKmsKeyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateClusterKubernetesVersionConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, validateClusterKubernetesVersionConfig(types.StringNull()).HasError())
+	assert.False(t, validateClusterKubernetesVersionConfig(types.StringUnknown()).HasError())
+	assert.False(t, validateClusterKubernetesVersionConfig(types.StringValue("1.32")).HasError())
+	assert.True(t, validateClusterKubernetesVersionConfig(types.StringValue("latest")).HasError())
+}
+
+func TestValidateClusterKubernetesVersionCreation(t *testing.T) {
+	t.Parallel()
+
+	supported := cluster.SupportedKubernetesVersions[cluster.CloudProviderGCP]
+
+	assert.Empty(t, validateClusterKubernetesVersionCreation(types.StringValue("GCP"), types.StringUnknown()))
+	assert.Empty(t, validateClusterKubernetesVersionCreation(types.StringUnknown(), types.StringValue(supported[0])))
+	assert.Empty(t, validateClusterKubernetesVersionCreation(types.StringValue("GCP"), types.StringValue(supported[len(supported)-1])))
+
+	diags := validateClusterKubernetesVersionCreation(types.StringValue("GCP"), types.StringValue(supported[0]))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+
+	diags = validateClusterKubernetesVersionCreation(types.StringValue("GCP"), types.StringValue("1.20"))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+}
+
+func TestValidateClusterKubernetesVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		stateVersion types.String
+		planVersion  types.String
+		planState    types.String
+		wantError    bool
+	}{
+		{
+			name:         "same minor version → no error",
+			stateVersion: types.StringValue("1.31.4"),
+			planVersion:  types.StringValue("1.31"),
+			planState:    types.StringValue("STOPPED"),
+		},
+		{
+			name:         "next minor version → no error",
+			stateVersion: types.StringValue("1.31"),
+			planVersion:  types.StringValue("1.32"),
+			planState:    types.StringValue("DEPLOYED"),
+		},
+		{
+			name:         "plan version unknown → no error",
+			stateVersion: types.StringValue("1.31"),
+			planVersion:  types.StringUnknown(),
+			planState:    types.StringValue("DEPLOYED"),
+		},
+		{
+			name:         "state version null → no error",
+			stateVersion: types.StringNull(),
+			planVersion:  types.StringValue("1.29"),
+			planState:    types.StringValue("DEPLOYED"),
+		},
+		{
+			name:         "downgrade → error",
+			stateVersion: types.StringValue("1.31"),
+			planVersion:  types.StringValue("1.30"),
+			planState:    types.StringValue("DEPLOYED"),
+			wantError:    true,
+		},
+		{
+			name:         "skip minor version → error",
+			stateVersion: types.StringValue("1.31"),
+			planVersion:  types.StringValue("1.33"),
+			planState:    types.StringValue("DEPLOYED"),
+			wantError:    true,
+		},
+		{
+			name:         "upgrade of a stopped cluster → error",
+			stateVersion: types.StringValue("1.31"),
+			planVersion:  types.StringValue("1.32"),
+			planState:    types.StringValue("STOPPED"),
+			wantError:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateClusterKubernetesVersionUpgrade(tc.stateVersion, tc.planVersion, tc.planState)
+			assert.Equal(t, tc.wantError, diags.HasError())
+		})
+	}
+}
+
+func TestFromClusterKubernetesVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, fromClusterKubernetesVersion(nil, types.StringValue("1.31")).IsNull())
+	assert.Equal(t, types.StringValue("1.31"), fromClusterKubernetesVersion(ptr("1.31.4"), types.StringValue("1.31")))
+	assert.Equal(t, types.StringValue("1.32.1"), fromClusterKubernetesVersion(ptr("1.32.1"), types.StringValue("1.31")))
+	assert.Equal(t, types.StringValue("1.31.4"), fromClusterKubernetesVersion(ptr("1.31.4"), types.StringUnknown()))
+}
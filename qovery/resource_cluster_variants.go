@@ -0,0 +1,466 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// clusterVariant describes a cloud-specific cluster resource, e.g. qovery_aws_cluster.
+// Its schema is derived from the qovery_cluster schema: cloud_provider and kubernetes_mode are fixed, and only the
+// attributes and features supported by the cloud provider are kept. Its state is converted from and to the Cluster
+// model, so that the cloud-specific resources share the implementation of qovery_cluster.
+type clusterVariant struct {
+	// name is the type name of the resource without the provider prefix, e.g. `aws_cluster`.
+	name                    string
+	displayName             string
+	cloudProvider           string
+	kubernetesMode          string
+	credentialsResource     string
+	markdownDescription     string
+	regionDescription       string
+	instanceTypeDescription string
+	// defaultRegion makes the region optional when set.
+	defaultRegion string
+	// features are the features of the cluster, the features attribute being removed when empty.
+	features              []string
+	unsupportedAttributes []string
+	requiredAttributes    []string
+}
+
+var (
+	awsClusterVariant = &clusterVariant{
+		name:                    "aws_cluster",
+		displayName:             "AWS",
+		cloudProvider:           cluster.CloudProviderAWS.String(),
+		kubernetesMode:          cluster.KubernetesModeManaged.String(),
+		credentialsResource:     "qovery_aws_credentials",
+		markdownDescription:     "Provides a Qovery AWS cluster resource. This is used to create and manage EKS clusters through Qovery, optionally deployed on an **existing VPC** or using **Karpenter** for automatic node provisioning.",
+		regionDescription:       "AWS region where the cluster will be deployed (e.g., `us-east-2`).",
+		instanceTypeDescription: "EC2 instance type of the cluster nodes (e.g., `t3a.xlarge`, `m5.large`). Not required when Karpenter is enabled.",
+		features:                []string{featureKeyVpcSubnet, featureKeyStaticIP, featureKeyExistingVpc, featureKeyKarpenter},
+		unsupportedAttributes:   []string{"kubeconfig", "infrastructure_charts_parameters"},
+	}
+	gcpClusterVariant = &clusterVariant{
+		name:                    "gcp_cluster",
+		displayName:             "GCP",
+		cloudProvider:           cluster.CloudProviderGCP.String(),
+		kubernetesMode:          cluster.KubernetesModeManaged.String(),
+		credentialsResource:     "qovery_gcp_credentials",
+		markdownDescription:     "Provides a Qovery GCP cluster resource. This is used to create and manage GKE clusters through Qovery, optionally in **Autopilot** mode or deployed on an **existing VPC**.",
+		regionDescription:       "GCP region where the cluster will be deployed (e.g., `europe-west9`).",
+		instanceTypeDescription: "Machine type of the cluster nodes, or `AUTO_PILOT` for GKE Autopilot mode.",
+		features:                []string{featureKeyStaticIP, featureKeyNatGateways, featureKeyGcpExistingVpc, featureKeyGkeKmsKey},
+		unsupportedAttributes:   []string{"kubeconfig", "infrastructure_charts_parameters", "labels_group_ids"},
+	}
+	scalewayClusterVariant = &clusterVariant{
+		name:                "scaleway_cluster",
+		displayName:         "Scaleway",
+		cloudProvider:       cluster.CloudProviderSCW.String(),
+		kubernetesMode:      cluster.KubernetesModeManaged.String(),
+		credentialsResource: "qovery_scaleway_credentials",
+		markdownDescription: "Provides a Qovery Scaleway cluster resource. This is used to create and manage Kapsule clusters through Qovery. " +
+			"The Qovery API does not support deploying a Scaleway cluster into an existing private network yet: Qovery creates the network of the cluster.",
+		regionDescription:       "Scaleway zone where the cluster will be deployed (e.g., `fr-par-1`, `pl-waw-1`).",
+		instanceTypeDescription: "Node type of the cluster nodes (e.g., `DEV1-L`, `GP1-S`).",
+		features:                []string{featureKeyVpcSubnet, featureKeyStaticIP},
+		unsupportedAttributes:   []string{"kubeconfig", "infrastructure_charts_parameters", "labels_group_ids"},
+	}
+	azureClusterVariant = &clusterVariant{
+		name:                "azure_cluster",
+		displayName:         "Azure",
+		cloudProvider:       cluster.CloudProviderAzure.String(),
+		kubernetesMode:      cluster.KubernetesModeManaged.String(),
+		credentialsResource: "qovery_azure_credentials",
+		markdownDescription: "Provides a Qovery Azure cluster resource. This is used to create and manage AKS clusters through Qovery. " +
+			"The Qovery API does not support deploying an Azure cluster into an existing VNet yet: Qovery creates the network of the cluster.",
+		regionDescription:       "Azure region where the cluster will be deployed (e.g., `westeurope`).",
+		instanceTypeDescription: "VM size of the cluster nodes (e.g., `Standard_B2s_v2`, `Standard_D4s_v3`).",
+		features:                []string{featureKeyVpcSubnet, featureKeyStaticIP},
+		unsupportedAttributes:   []string{"kubeconfig", "infrastructure_charts_parameters", "labels_group_ids"},
+	}
+	eksAnywhereClusterVariant = &clusterVariant{
+		name:                "eks_anywhere_cluster",
+		displayName:         "EKS Anywhere",
+		cloudProvider:       cluster.CloudProviderAWS.String(),
+		kubernetesMode:      cluster.KubernetesModePartiallyManaged.String(),
+		credentialsResource: "qovery_aws_credentials",
+		markdownDescription: "Provides a Qovery EKS Anywhere cluster resource. This is used to manage on-premise EKS Anywhere clusters through Qovery: the cluster is reached with its `kubeconfig`, and Qovery installs its infrastructure charts on it.",
+		regionDescription:   "Region of the cluster. Default: `on-premise`.",
+		defaultRegion:       "on-premise",
+		unsupportedAttributes: []string{
+			"instance_type", "disk_size", "min_running_nodes", "max_running_nodes", "kubernetes_version",
+			"keda", "routing_table", "labels_group_ids", "infrastructure_outputs",
+		},
+		requiredAttributes: []string{"kubeconfig", "infrastructure_charts_parameters"},
+	}
+
+	// clusterVariants are the cloud-specific cluster resources.
+	clusterVariants = []*clusterVariant{
+		awsClusterVariant,
+		gcpClusterVariant,
+		scalewayClusterVariant,
+		azureClusterVariant,
+		eksAnywhereClusterVariant,
+	}
+)
+
+func newAwsClusterResource() resource.Resource {
+	return &clusterResource{variant: awsClusterVariant}
+}
+
+func newGcpClusterResource() resource.Resource {
+	return &clusterResource{variant: gcpClusterVariant}
+}
+
+func newScalewayClusterResource() resource.Resource {
+	return &clusterResource{variant: scalewayClusterVariant}
+}
+
+func newAzureClusterResource() resource.Resource {
+	return &clusterResource{variant: azureClusterVariant}
+}
+
+func newEksAnywhereClusterResource() resource.Resource {
+	return &clusterResource{variant: eksAnywhereClusterVariant}
+}
+
+// typeName returns the type name of the resource, e.g. `qovery_aws_cluster`.
+func (v clusterVariant) typeName() string {
+	return "qovery_" + v.name
+}
+
+// schema returns the schema of the resource, derived from the qovery_cluster schema.
+func (v clusterVariant) schema() schema.Schema {
+	clusterAttributes := clusterSchema().Attributes
+	attributes := make(map[string]schema.Attribute, len(clusterAttributes))
+	for name, attribute := range clusterAttributes {
+		attributes[name] = attribute
+	}
+
+	delete(attributes, "cloud_provider")
+	delete(attributes, "kubernetes_mode")
+	for _, name := range v.unsupportedAttributes {
+		delete(attributes, name)
+	}
+	for _, name := range v.requiredAttributes {
+		attributes[name] = requiredClusterAttribute(attributes[name])
+	}
+
+	if len(v.features) == 0 {
+		delete(attributes, "features")
+	} else {
+		features := attributes["features"].(schema.SingleNestedAttribute)
+		featureAttributes := make(map[string]schema.Attribute, len(v.features))
+		for _, name := range v.features {
+			featureAttributes[name] = features.Attributes[name]
+		}
+		features.Attributes = featureAttributes
+		features.Description = fmt.Sprintf("Features of the %s cluster.", v.displayName)
+		features.MarkdownDescription = fmt.Sprintf("Optional features of the %s cluster.", v.displayName)
+		attributes["features"] = features
+	}
+
+	credentialsID := attributes["credentials_id"].(schema.StringAttribute)
+	credentialsID.Description = fmt.Sprintf("Id of the %s.", v.credentialsResource)
+	credentialsID.MarkdownDescription = fmt.Sprintf("ID of the `%s` to use for this cluster.", v.credentialsResource)
+	attributes["credentials_id"] = credentialsID
+
+	region := attributes["region"].(schema.StringAttribute)
+	region.Description = v.regionDescription
+	region.MarkdownDescription = v.regionDescription
+	if v.defaultRegion != "" {
+		region.Required = false
+		region.Optional = true
+		region.Computed = true
+		region.Default = stringdefault.StaticString(v.defaultRegion)
+	}
+	attributes["region"] = region
+
+	if attribute, ok := attributes["instance_type"].(schema.StringAttribute); ok {
+		attribute.Description = v.instanceTypeDescription
+		attribute.MarkdownDescription = v.instanceTypeDescription + "\n\n" +
+			"The instance types that are not available in the region of the cluster are rejected at plan time. Use the `qovery_cluster_instance_types` data source to list them."
+		attributes["instance_type"] = attribute
+	}
+
+	return schema.Schema{
+		Description: fmt.Sprintf("Provides a Qovery %s cluster resource.", v.displayName),
+		MarkdownDescription: v.markdownDescription + "\n\n" +
+			"An existing `qovery_cluster` of this kind can be migrated to this resource without being recreated, with a `moved` block (Terraform 1.8 or later).",
+		Attributes: attributes,
+	}
+}
+
+// requiredClusterAttribute returns the attribute made required.
+func requiredClusterAttribute(attribute schema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+		return a
+	case schema.SingleNestedAttribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+		return a
+	default:
+		return attribute
+	}
+}
+
+// checkCluster returns an error when a cluster of the given cloud provider and kubernetes mode cannot be managed with this resource.
+func (v clusterVariant) checkCluster(cloudProvider string, kubernetesMode string) error {
+	if cloudProvider == v.cloudProvider && kubernetesMode == v.kubernetesMode {
+		return nil
+	}
+
+	for _, other := range clusterVariants {
+		if cloudProvider == other.cloudProvider && kubernetesMode == other.kubernetesMode {
+			return fmt.Errorf("the cluster is a %s cluster in %s mode, it must be managed with %s instead of %s", cloudProvider, kubernetesMode, other.typeName(), v.typeName())
+		}
+	}
+	return fmt.Errorf("the cluster is a %s cluster in %s mode, it can only be managed with qovery_cluster", cloudProvider, kubernetesMode)
+}
+
+// clusterKubernetesModeOf returns the kubernetes mode of the cluster, MANAGED when it is not set.
+func clusterKubernetesModeOf(c *cluster.Cluster) string {
+	if c.KubernetesMode == nil {
+		return clusterKubernetesModeDefault
+	}
+	return c.KubernetesMode.String()
+}
+
+// attrTypes returns the attribute types of the resource.
+func (v clusterVariant) attrTypes() map[string]attr.Type {
+	return v.schema().Type().(types.ObjectType).AttrTypes
+}
+
+// toCluster converts a value of the resource to the Cluster model. The attributes the resource does not have are null,
+// except cloud_provider and kubernetes_mode that are set to the values of the resource.
+// The features keep the attributes of the resource, as they are read by name.
+func (v clusterVariant) toCluster(ctx context.Context, value types.Object) (Cluster, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	clusterAttrTypes := clusterSchema().Type().(types.ObjectType).AttrTypes
+	attributes := value.Attributes()
+
+	values := make(map[string]attr.Value, len(clusterAttrTypes))
+	for name, attrType := range clusterAttrTypes {
+		if name == "features" {
+			values[name] = types.ObjectNull(attrType.(types.ObjectType).AttrTypes)
+			continue
+		}
+		v, d := projectClusterAttrValue(ctx, attributes[name], attrType)
+		diags.Append(d...)
+		values[name] = v
+	}
+	if diags.HasError() {
+		return Cluster{}, diags
+	}
+
+	object, d := types.ObjectValue(clusterAttrTypes, values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return Cluster{}, diags
+	}
+
+	var c Cluster
+	diags.Append(object.As(ctx, &c, basetypes.ObjectAsOptions{})...)
+	if features, ok := attributes["features"].(types.Object); ok {
+		c.Features = features
+	}
+	c.CloudProvider = types.StringValue(v.cloudProvider)
+	c.KubernetesMode = types.StringValue(v.kubernetesMode)
+
+	return c, diags
+}
+
+// fromCluster converts the Cluster model to a value of the resource, dropping the attributes and features the resource does not have.
+func (v clusterVariant) fromCluster(ctx context.Context, c Cluster) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	clusterAttrTypes := clusterSchema().Type().(types.ObjectType).AttrTypes
+	attrTypes := v.attrTypes()
+
+	features := c.Features
+	c.Features = types.ObjectNull(clusterAttrTypes["features"].(types.ObjectType).AttrTypes)
+	object, d := types.ObjectValueFrom(ctx, clusterAttrTypes, c)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(attrTypes), diags
+	}
+	attributes := object.Attributes()
+	attributes["features"] = features
+
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		v, d := projectClusterAttrValue(ctx, attributes[name], attrType)
+		diags.Append(d...)
+		values[name] = v
+	}
+	if diags.HasError() {
+		return types.ObjectNull(attrTypes), diags
+	}
+
+	value, d := types.ObjectValue(attrTypes, values)
+	diags.Append(d...)
+	return value, diags
+}
+
+// projectClusterAttrValue converts the value to the given type, adding the missing object attributes as null and
+// dropping the extra ones. A nil value is converted to null.
+func projectClusterAttrValue(ctx context.Context, value attr.Value, attrType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		null, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Error on cluster conversion", err.Error())
+		}
+		return null, diags
+	}
+	if value.Type(ctx).Equal(attrType) {
+		return value, diags
+	}
+
+	objectType, isObjectType := attrType.(types.ObjectType)
+	object, isObject := value.(types.Object)
+	if !isObjectType || !isObject {
+		diags.AddError("Error on cluster conversion", fmt.Sprintf("cannot convert a value of type %s to %s", value.Type(ctx), attrType))
+		return value, diags
+	}
+	if object.IsNull() {
+		return types.ObjectNull(objectType.AttrTypes), diags
+	}
+	if object.IsUnknown() {
+		return types.ObjectUnknown(objectType.AttrTypes), diags
+	}
+
+	values := make(map[string]attr.Value, len(objectType.AttrTypes))
+	for name, t := range objectType.AttrTypes {
+		v, d := projectClusterAttrValue(ctx, object.Attributes()[name], t)
+		diags.Append(d...)
+		values[name] = v
+	}
+	if diags.HasError() {
+		return value, diags
+	}
+
+	projected, d := types.ObjectValue(objectType.AttrTypes, values)
+	diags.Append(d...)
+	return projected, diags
+}
+
+// clusterModelSource is a plan, a state or a config the Cluster model is read from.
+type clusterModelSource interface {
+	Get(ctx context.Context, target any) diag.Diagnostics
+}
+
+// getModel reads the Cluster model from the source, converting it from the value of the resource for the cloud-specific resources.
+func (r clusterResource) getModel(ctx context.Context, source clusterModelSource, target *Cluster) diag.Diagnostics {
+	if r.variant == nil {
+		return source.Get(ctx, target)
+	}
+
+	var value types.Object
+	diags := source.Get(ctx, &value)
+	if diags.HasError() {
+		return diags
+	}
+
+	c, d := r.variant.toCluster(ctx, value)
+	diags.Append(d...)
+	*target = c
+	return diags
+}
+
+// setModel writes the Cluster model into the state, converting it to the value of the resource for the cloud-specific resources.
+func (r clusterResource) setModel(ctx context.Context, state *tfsdk.State, c Cluster) diag.Diagnostics {
+	if r.variant == nil {
+		return state.Set(ctx, &c)
+	}
+
+	value, diags := r.variant.fromCluster(ctx, c)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.Set(ctx, value)...)
+	return diags
+}
+
+// stateValue returns the value of the Cluster model to save into the state.
+func (r clusterResource) stateValue(ctx context.Context, c Cluster) any {
+	if r.variant == nil {
+		return c
+	}
+
+	value, diags := r.variant.fromCluster(ctx, c)
+	if diags.HasError() {
+		tflog.Warn(ctx, "failed to convert cluster", map[string]any{"cluster_id": c.Id.ValueString(), "error": fmt.Sprint(diags.Errors())})
+	}
+	return value
+}
+
+// MoveState moves the state of a qovery_cluster to the cloud-specific cluster resource matching its cloud provider
+// and kubernetes mode, e.g. with a `moved` block.
+func (r clusterResource) MoveState(_ context.Context) []resource.StateMover {
+	if r.variant == nil {
+		return nil
+	}
+
+	sourceSchema := clusterSchema()
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover:   r.variant.moveClusterState,
+		},
+	}
+}
+
+func (v clusterVariant) moveClusterState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	// Other resources are left unhandled, so that the framework refuses to move them.
+	if req.SourceTypeName != "qovery_cluster" || !strings.HasSuffix(req.SourceProviderAddress, "qovery/qovery") {
+		return
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to move cluster state",
+			"The qovery_cluster state could not be read. Refresh it with the current version of the provider before moving it.",
+		)
+		return
+	}
+
+	var source Cluster
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubernetesMode := source.KubernetesMode.ValueString()
+	if kubernetesMode == "" {
+		kubernetesMode = clusterKubernetesModeDefault
+	}
+	if err := v.checkCluster(source.CloudProvider.ValueString(), kubernetesMode); err != nil {
+		resp.Diagnostics.AddError("Unable to move cluster state", err.Error())
+		return
+	}
+
+	value, diags := v.fromCluster(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, value)...)
+
+	tflog.Trace(ctx, "moved cluster state", map[string]any{"cluster_id": source.Id.ValueString(), "type": v.typeName()})
+}
@@ -0,0 +1,246 @@
+//go:build unit && !integration
+// +build unit,!integration
+
+package qovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClusterModel returns a Cluster whose attributes are all null, except the given ones.
+func newTestClusterModel(t *testing.T, values map[string]attr.Value) Cluster {
+	t.Helper()
+	ctx := context.Background()
+
+	attrTypes := clusterSchema().Type().(types.ObjectType).AttrTypes
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		value, diags := projectClusterAttrValue(ctx, values[name], attrType)
+		require.False(t, diags.HasError(), diags)
+		attributes[name] = value
+	}
+	object, diags := types.ObjectValue(attrTypes, attributes)
+	require.False(t, diags.HasError(), diags)
+
+	var c Cluster
+	diags = object.As(ctx, &c, basetypes.ObjectAsOptions{})
+	require.False(t, diags.HasError(), diags)
+	return c
+}
+
+func TestClusterVariant_Schema(t *testing.T) {
+	t.Parallel()
+
+	clusterAttributes := clusterSchema().Attributes
+	for _, v := range clusterVariants {
+		v := v
+		t.Run(v.typeName(), func(t *testing.T) {
+			t.Parallel()
+
+			attributes := v.schema().Attributes
+			assert.NotContains(t, attributes, "cloud_provider")
+			assert.NotContains(t, attributes, "kubernetes_mode")
+			for name, attribute := range attributes {
+				require.Contains(t, clusterAttributes, name)
+				if name == "features" {
+					continue
+				}
+				assert.Equal(t, clusterAttributes[name].GetType(), attribute.GetType(), name)
+			}
+			for _, name := range v.unsupportedAttributes {
+				assert.NotContains(t, attributes, name)
+			}
+			for _, name := range v.requiredAttributes {
+				assert.True(t, attributes[name].IsRequired(), name)
+			}
+
+			if len(v.features) == 0 {
+				assert.NotContains(t, attributes, "features")
+				return
+			}
+			features := attributes["features"].(schema.SingleNestedAttribute)
+			assert.Len(t, features.Attributes, len(v.features))
+			for _, name := range v.features {
+				assert.Contains(t, features.Attributes, name)
+			}
+		})
+	}
+}
+
+func TestClusterVariant_CheckCluster(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		variant        *clusterVariant
+		cloudProvider  string
+		kubernetesMode string
+		expectedError  string
+	}{
+		{
+			name:           "aws_managed",
+			variant:        awsClusterVariant,
+			cloudProvider:  "AWS",
+			kubernetesMode: "MANAGED",
+		},
+		{
+			name:           "eks_anywhere",
+			variant:        eksAnywhereClusterVariant,
+			cloudProvider:  "AWS",
+			kubernetesMode: "PARTIALLY_MANAGED",
+		},
+		{
+			name:           "other_cloud_provider",
+			variant:        awsClusterVariant,
+			cloudProvider:  "GCP",
+			kubernetesMode: "MANAGED",
+			expectedError:  "the cluster is a GCP cluster in MANAGED mode, it must be managed with qovery_gcp_cluster instead of qovery_aws_cluster",
+		},
+		{
+			name:           "other_kubernetes_mode",
+			variant:        awsClusterVariant,
+			cloudProvider:  "AWS",
+			kubernetesMode: "PARTIALLY_MANAGED",
+			expectedError:  "the cluster is a AWS cluster in PARTIALLY_MANAGED mode, it must be managed with qovery_eks_anywhere_cluster instead of qovery_aws_cluster",
+		},
+		{
+			name:           "self_managed",
+			variant:        scalewayClusterVariant,
+			cloudProvider:  "ON_PREMISE",
+			kubernetesMode: "SELF_MANAGED",
+			expectedError:  "the cluster is a ON_PREMISE cluster in SELF_MANAGED mode, it can only be managed with qovery_cluster",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.variant.checkCluster(tc.cloudProvider, tc.kubernetesMode)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestClusterVariant_Conversion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	attrTypes := gcpClusterVariant.attrTypes()
+	featuresAttrTypes := attrTypes["features"].(types.ObjectType).AttrTypes
+	features, diags := types.ObjectValue(featuresAttrTypes, map[string]attr.Value{
+		featureKeyStaticIP:       types.BoolValue(true),
+		featureKeyGkeKmsKey:      types.StringNull(),
+		featureKeyNatGateways:    types.ObjectNull(featuresAttrTypes[featureKeyNatGateways].(types.ObjectType).AttrTypes),
+		featureKeyGcpExistingVpc: types.ObjectNull(featuresAttrTypes[featureKeyGcpExistingVpc].(types.ObjectType).AttrTypes),
+	})
+	require.False(t, diags.HasError(), diags)
+
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		value, diags := projectClusterAttrValue(ctx, nil, attrType)
+		require.False(t, diags.HasError(), diags)
+		values[name] = value
+	}
+	values["name"] = types.StringValue("my-cluster")
+	values["region"] = types.StringValue("europe-west9")
+	values["features"] = features
+	value, diags := types.ObjectValue(attrTypes, values)
+	require.False(t, diags.HasError(), diags)
+
+	c, diags := gcpClusterVariant.toCluster(ctx, value)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("GCP"), c.CloudProvider)
+	assert.Equal(t, types.StringValue("MANAGED"), c.KubernetesMode)
+	assert.Equal(t, types.StringValue("my-cluster"), c.Name)
+	assert.True(t, c.Kubeconfig.IsNull())
+	assert.Equal(t, types.BoolValue(true), c.Features.Attributes()[featureKeyStaticIP])
+
+	roundTrip, diags := gcpClusterVariant.fromCluster(ctx, c)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, value.Equal(roundTrip), "expected %s, got %s", value, roundTrip)
+}
+
+func TestClusterVariant_MoveState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newSourceState := func(t *testing.T, cloudProvider string, kubernetesMode string) *tfsdk.State {
+		source := newTestClusterModel(t, map[string]attr.Value{
+			"id":              types.StringValue("00000000-0000-0000-0000-000000000001"),
+			"name":            types.StringValue("my-cluster"),
+			"cloud_provider":  types.StringValue(cloudProvider),
+			"kubernetes_mode": types.StringValue(kubernetesMode),
+			"region":          types.StringValue("eu-west-3"),
+			"kubeconfig":      types.StringValue("apiVersion: v1"),
+		})
+		state := tfsdk.State{
+			Schema: clusterSchema(),
+			Raw:    tftypes.NewValue(clusterSchema().Type().TerraformType(ctx), nil),
+		}
+		diags := state.Set(ctx, source)
+		require.False(t, diags.HasError(), diags)
+		return &state
+	}
+	move := func(v *clusterVariant, sourceTypeName string, sourceState *tfsdk.State) *resource.MoveStateResponse {
+		targetSchema := v.schema()
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: targetSchema,
+				Raw:    tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil),
+			},
+		}
+		v.moveClusterState(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/qovery/qovery",
+			SourceTypeName:        sourceTypeName,
+			SourceState:           sourceState,
+		}, resp)
+		return resp
+	}
+
+	t.Run("moves_matching_cluster", func(t *testing.T) {
+		t.Parallel()
+
+		resp := move(eksAnywhereClusterVariant, "qovery_cluster", newSourceState(t, "AWS", "PARTIALLY_MANAGED"))
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var name, kubeconfig types.String
+		resp.TargetState.GetAttribute(ctx, path.Root("name"), &name)
+		resp.TargetState.GetAttribute(ctx, path.Root("kubeconfig"), &kubeconfig)
+		assert.Equal(t, "my-cluster", name.ValueString())
+		assert.Equal(t, "apiVersion: v1", kubeconfig.ValueString())
+	})
+
+	t.Run("refuses_other_cluster", func(t *testing.T) {
+		t.Parallel()
+
+		resp := move(awsClusterVariant, "qovery_cluster", newSourceState(t, "AWS", "PARTIALLY_MANAGED"))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "qovery_eks_anywhere_cluster")
+	})
+
+	t.Run("ignores_other_resource", func(t *testing.T) {
+		t.Parallel()
+
+		resp := move(awsClusterVariant, "qovery_database", nil)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.TargetState.Raw.IsNull())
+	})
+}
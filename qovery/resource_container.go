@@ -24,6 +24,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -35,6 +36,18 @@ var (
 	_ resource.ResourceWithModifyPlan  = containerResource{}
 )
 
+// containerErrorMappings attaches the container domain errors to the attribute they originate from.
+// Port errors come first as the port and container name errors share the same message.
+var containerErrorMappings = []attributeErrorMapping{
+	{err: port.ErrInvalidExternalPortParam, path: path.Root("ports"), hint: attributeHints["external_port"]},
+	{err: port.ErrInvalidInternalPortParam, path: path.Root("ports"), hint: attributeHints["internal_port"]},
+	{err: port.ErrInvalidPorts, path: path.Root("ports")},
+	{err: container.ErrInvalidImageNameParam, path: path.Root("image_name")},
+	{err: container.ErrInvalidTagParam, path: path.Root("tag")},
+	{err: container.ErrInvalidRegistryIDParam, path: path.Root("registry_id")},
+	{err: container.ErrInvalidNameParam, path: path.Root("name")},
+}
+
 type containerResource struct {
 	containerService        container.Service
 	advancedSettingsService *advanced_settings.ServiceAdvancedSettingsService
@@ -694,7 +707,10 @@ func (r containerResource) Create(ctx context.Context, req resource.CreateReques
 	request := plan.toUpsertServiceRequest(nil)
 	cont, err := r.containerService.Create(ctx, plan.EnvironmentID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container create", err.Error())
+		savePartiallyCreatedState(ctx, resp, err, "container", func(created *container.Container) Container {
+			return convertDomainContainerToContainer(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on container create", err, containerErrorMappings)
 		return
 	}
 
@@ -750,7 +766,10 @@ func (r containerResource) Update(ctx context.Context, req resource.UpdateReques
 	request := plan.toUpsertServiceRequest(&state)
 	cont, err := r.containerService.Update(ctx, state.ID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container update", err.Error())
+		savePartiallyUpdatedState(ctx, resp, err, "container", func(updated *container.Container, report *variable.DiffApplyError) Container {
+			return convertDomainContainerToContainer(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on container update", err, containerErrorMappings)
 		return
 	}
 
@@ -795,4 +814,5 @@ func (r containerResource) ImportState(ctx context.Context, req resource.ImportS
 func (r containerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	validateAutoscalingPlan(ctx, req.Plan, req.State, &resp.Diagnostics)
 	warnUnknownAdvancedSettings(ctx, r.advancedSettingsService, domain.CONTAINER, req.Config, &resp.Diagnostics)
+	validateAdvancedSettingsValues(ctx, r.advancedSettingsService, domain.CONTAINER, req.Config, &resp.Diagnostics)
 }
@@ -55,6 +55,16 @@ type Container struct {
 	Autoscaling                  types.Object  `tfsdk:"autoscaling"`
 }
 
+// withAppliedSecrets returns the container with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (c Container) withAppliedSecrets(ctx context.Context, state Container, report *variable.DiffApplyError) Container {
+	c.Secrets = appliedSecrets(ctx, c.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	c.SecretAliases = appliedSecrets(ctx, c.SecretAliases, state.SecretAliases, report.AppliedKeys(variable.DiffKindAlias))
+	c.SecretOverrides = appliedSecrets(ctx, c.SecretOverrides, state.SecretOverrides, report.AppliedKeys(variable.DiffKindOverride))
+	c.SecretFiles = appliedSecrets(ctx, c.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return c
+}
+
 func (cont Container) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(cont.EnvironmentVariables)
 }
@@ -18,7 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -53,8 +53,17 @@ var (
 	databaseStorageDefault int64 = 10
 )
 
+// databaseErrorMappings attaches the database domain errors to the attribute they originate from.
+var databaseErrorMappings = []attributeErrorMapping{
+	{err: database.ErrInvalidTypeParam, path: path.Root("type")},
+	{err: database.ErrInvalidModeParam, path: path.Root("mode")},
+	{err: database.ErrInvalidAccessibilityParam, path: path.Root("accessibility")},
+	{err: database.ErrInvalidVersionParam, path: path.Root("version")},
+	{err: database.ErrInvalidNameParam, path: path.Root("name")},
+}
+
 type databaseResource struct {
-	client *client.Client
+	databaseService database.Service
 }
 
 func newDatabaseResource() resource.Resource {
@@ -80,7 +89,7 @@ func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = provider.client
+	r.databaseService = provider.databaseService
 }
 
 func (r databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -277,6 +286,7 @@ func (r databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"deletion_protection": deletionProtectionResourceAttribute("database"),
 		},
 	}
 }
@@ -291,19 +301,17 @@ func (r databaseResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create new database
-	request, err := plan.toCreateDatabaseRequest()
+	db, err := r.databaseService.Create(ctx, plan.EnvironmentId.ValueString(), plan.toUpsertRepositoryRequest())
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
-	}
-	database, apiErr := r.client.CreateDatabase(ctx, plan.EnvironmentId.ValueString(), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+		savePartiallyCreatedState(ctx, resp, err, "database", func(created *database.Database) Database {
+			return convertDomainDatabaseToDatabase(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on database create", err, databaseErrorMappings)
 		return
 	}
 
 	// Initialize state values
-	state := convertResponseToDatabase(ctx, plan, database)
+	state := convertDomainDatabaseToDatabase(ctx, plan, db)
 	tflog.Trace(ctx, "created database", map[string]any{"database_id": state.Id.ValueString()})
 
 	// Set state
@@ -320,13 +328,13 @@ func (r databaseResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get database from the API
-	database, apiErr := r.client.GetDatabase(ctx, state.Id.ValueString())
-	if handleReadNotFound(ctx, resp, apiErr) {
+	db, err := r.databaseService.Get(ctx, state.Id.ValueString())
+	if handleDomainReadNotFound(ctx, resp, err, "Error on database read") {
 		return
 	}
 
 	// Refresh state values
-	state = convertResponseToDatabase(ctx, state, database)
+	state = convertDomainDatabaseToDatabase(ctx, state, db)
 	tflog.Trace(ctx, "read database", map[string]any{"database_id": state.Id.ValueString()})
 
 	// Set state
@@ -344,19 +352,14 @@ func (r databaseResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update database in the backend
-	request, err := plan.toUpdateDatabaseRequest()
+	db, err := r.databaseService.Update(ctx, state.Id.ValueString(), plan.toUpsertRepositoryRequest())
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
-	}
-	database, apiErr := r.client.UpdateDatabase(ctx, state.Id.ValueString(), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on database update", err, databaseErrorMappings)
 		return
 	}
 
 	// Update state values
-	state = convertResponseToDatabase(ctx, plan, database)
+	state = convertDomainDatabaseToDatabase(ctx, plan, db)
 	tflog.Trace(ctx, "updated database", map[string]any{"database_id": state.Id.ValueString()})
 
 	// Set state
@@ -372,10 +375,14 @@ func (r databaseResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if checkDeletionProtection(&resp.Diagnostics, state.DeletionProtection, "database", state.Id.ValueString()) {
+		return
+	}
+
 	// Delete database
-	apiErr := r.client.DeleteDatabase(ctx, state.Id.ValueString())
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	err := r.databaseService.Delete(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error on database delete", err.Error())
 		return
 	}
 
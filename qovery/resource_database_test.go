@@ -11,8 +11,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/qovery"
 )
 
@@ -546,9 +547,9 @@ func testAccQoveryDatabaseExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("database.id not found")
 		}
 
-		_, apiErr := apiClient.GetDatabase(context.TODO(), rs.Primary.ID)
-		if apiErr != nil {
-			return apiErr
+		_, err := qoveryServices.Database.Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
 		}
 		return nil
 	}
@@ -565,12 +566,12 @@ func testAccQoveryDatabaseDestroy(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("database.id not found")
 		}
 
-		_, apiErr := apiClient.GetDatabase(context.TODO(), rs.Primary.ID)
-		if apiErr == nil {
+		_, err := qoveryServices.Database.Get(context.TODO(), rs.Primary.ID)
+		if err == nil {
 			return fmt.Errorf("found database but expected it to be deleted")
 		}
-		if !apierrors.IsNotFound(apiErr) {
-			return fmt.Errorf("unexpected error checking for deleted database: %s", apiErr.Summary())
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted database: %s", err.Error())
 		}
 		return nil
 	}
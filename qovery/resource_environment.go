@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -319,6 +320,7 @@ func (r environmentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"secret_files":               secretFilesSchemaAttribute("environment"),
 			"external_secrets":           externalSecretsSchemaAttribute("environment"),
 			"external_secret_files":      externalSecretFilesSchemaAttribute("environment"),
+			"deletion_protection":        deletionProtectionResourceAttribute("environment"),
 		},
 	}
 }
@@ -341,6 +343,9 @@ func (r environmentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	env, err := r.environmentService.Create(ctx, plan.ProjectId.ValueString(), *request)
 	if err != nil {
+		savePartiallyCreatedState(ctx, resp, err, "environment", func(created *environment.Environment) Environment {
+			return convertDomainEnvironmentToEnvironment(ctx, plan, created)
+		})
 		resp.Diagnostics.AddError("Error on environment create", err.Error())
 		return
 	}
@@ -395,6 +400,9 @@ func (r environmentResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update environment in the backend
 	env, err := r.environmentService.Update(ctx, state.Id.ValueString(), *request)
 	if err != nil {
+		savePartiallyUpdatedState(ctx, resp, err, "environment", func(updated *environment.Environment, report *variable.DiffApplyError) Environment {
+			return convertDomainEnvironmentToEnvironment(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
 		resp.Diagnostics.AddError("Error on environment update", err.Error())
 		return
 	}
@@ -416,6 +424,10 @@ func (r environmentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if checkDeletionProtection(&resp.Diagnostics, state.DeletionProtection, "environment", state.Id.ValueString()) {
+		return
+	}
+
 	// Delete environment
 	err := r.environmentService.Delete(ctx, state.Id.ValueString())
 	if err != nil {
@@ -26,6 +26,17 @@ type Environment struct {
 	SecretFiles                  types.Set    `tfsdk:"secret_files"`
 	ExternalSecrets              types.Set    `tfsdk:"external_secrets"`
 	ExternalSecretFiles          types.Set    `tfsdk:"external_secret_files"`
+	DeletionProtection           types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// withAppliedSecrets returns the environment with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (e Environment) withAppliedSecrets(ctx context.Context, state Environment, report *variable.DiffApplyError) Environment {
+	e.Secrets = appliedSecrets(ctx, e.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	e.SecretAliases = appliedSecrets(ctx, e.SecretAliases, state.SecretAliases, report.AppliedKeys(variable.DiffKindAlias))
+	e.SecretOverrides = appliedSecrets(ctx, e.SecretOverrides, state.SecretOverrides, report.AppliedKeys(variable.DiffKindOverride))
+	e.SecretFiles = appliedSecrets(ctx, e.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return e
 }
 
 func (e Environment) EnvironmentVariableList() EnvironmentVariableList {
@@ -143,5 +154,6 @@ func convertDomainEnvironmentToEnvironment(ctx context.Context, state Environmen
 		SecretFiles:                  convertDomainSecretsToSecretFileList(state.SecretFiles, env.Secrets, variable.ScopeEnvironment).toTerraformSet(ctx),
 		ExternalSecrets:              convertDomainExternalSecretsToExternalSecretList(env.ExternalSecrets, state.ExternalSecrets, variable.ScopeEnvironment).toTerraformSet(ctx),
 		ExternalSecretFiles:          convertDomainExternalSecretFilesToExternalSecretFileList(env.ExternalSecretFiles, state.ExternalSecretFiles, variable.ScopeEnvironment).toTerraformSet(ctx),
+		DeletionProtection:           deletionProtectionFromState(state.DeletionProtection),
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/helm"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -725,12 +726,15 @@ func (r helmResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Create new helm
 	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on helm create", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on helm create", err, nil)
 		return
 	}
 	newHelm, err := r.helmService.Create(ctx, plan.EnvironmentID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on helm create", err.Error())
+		savePartiallyCreatedState(ctx, resp, err, "helm", func(created *helm.Helm) Helm {
+			return convertDomainHelmToHelm(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on helm create", err, nil)
 		return
 	}
 
@@ -785,12 +789,15 @@ func (r helmResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Update helm in the backend
 	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on helm create", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on helm update", err, nil)
 		return
 	}
 	newHelm, err := r.helmService.Update(ctx, state.ID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on helm update", err.Error())
+		savePartiallyUpdatedState(ctx, resp, err, "helm", func(updated *helm.Helm, report *variable.DiffApplyError) Helm {
+			return convertDomainHelmToHelm(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on helm update", err, nil)
 		return
 	}
 
@@ -831,4 +838,5 @@ func (r helmResource) ImportState(ctx context.Context, req resource.ImportStateR
 
 func (r helmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	warnUnknownAdvancedSettings(ctx, r.advancedSettingsService, domain.HELM, req.Config, &resp.Diagnostics)
+	validateAdvancedSettingsValues(ctx, r.advancedSettingsService, domain.HELM, req.Config, &resp.Diagnostics)
 }
@@ -52,6 +52,16 @@ type Helm struct {
 	BlueprintID                  types.String         `tfsdk:"blueprint_id"`
 }
 
+// withAppliedSecrets returns the helm with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (h Helm) withAppliedSecrets(ctx context.Context, state Helm, report *variable.DiffApplyError) Helm {
+	h.Secrets = appliedSecrets(ctx, h.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	h.SecretAliases = appliedSecrets(ctx, h.SecretAliases, state.SecretAliases, report.AppliedKeys(variable.DiffKindAlias))
+	h.SecretOverrides = appliedSecrets(ctx, h.SecretOverrides, state.SecretOverrides, report.AppliedKeys(variable.DiffKindOverride))
+	h.SecretFiles = appliedSecrets(ctx, h.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return h
+}
+
 type HelmSource struct {
 	HelmSourceHelmRepository *HelmSourceHelmRepository `tfsdk:"helm_repository"`
 	HelmSourceGitRepository  *HelmSourceGitRepository  `tfsdk:"git_repository"`
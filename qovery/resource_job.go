@@ -24,6 +24,7 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/advanced_settings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
 )
@@ -35,6 +36,24 @@ var (
 	_ resource.ResourceWithModifyPlan  = jobResource{}
 )
 
+// jobErrorMappings attaches the job domain errors to the attribute they originate from.
+// The most specific errors come first as their messages are included in the wrapping errors.
+var jobErrorMappings = []attributeErrorMapping{
+	{err: job.ErrInvalidJobScheduleCronScheduleParam, path: path.Root("schedule").AtName("cronjob").AtName("schedule"), hint: attributeHints["schedule"]},
+	{err: job.ErrInvalidJobScheduleCronCommandParam, path: path.Root("schedule").AtName("cronjob").AtName("command")},
+	{err: job.ErrInvalidJobScheduleCronParam, path: path.Root("schedule").AtName("cronjob"), hint: attributeHints["schedule"]},
+	{err: job.ErrInvalidJobLifecycleType, path: path.Root("schedule").AtName("lifecycle_type"), hint: "`lifecycle_type` can only be set for lifecycle jobs, remove it from the `cronjob` schedule."},
+	{err: job.ErrInvalidJobScheduleOnStartParam, path: path.Root("schedule").AtName("on_start")},
+	{err: job.ErrInvalidJobScheduleOnStopParam, path: path.Root("schedule").AtName("on_stop")},
+	{err: job.ErrInvalidJobScheduleOnDeleteParam, path: path.Root("schedule").AtName("on_delete")},
+	{err: job.ErrInvalidJobScheduleMissingRequiredParams, path: path.Root("schedule"), hint: "Set either `cronjob`, or at least one of `on_start`, `on_stop` and `on_delete`."},
+	{err: job.ErrInvalidJobScheduleWrongScheduleParams, path: path.Root("schedule"), hint: "Set either `cronjob`, or at least one of `on_start`, `on_stop` and `on_delete`, but not both."},
+	{err: job.ErrInvalidJobCPUTooLowParam, path: path.Root("cpu"), hint: attributeHints["cpu"]},
+	{err: job.ErrInvalidJobMemoryTooLowParam, path: path.Root("memory"), hint: attributeHints["memory"]},
+	{err: job.ErrInvalidJobPortParam, path: path.Root("port")},
+	{err: job.ErrInvalidJobSourceParam, path: path.Root("source")},
+}
+
 type jobResource struct {
 	jobService              job.Service
 	advancedSettingsService *advanced_settings.ServiceAdvancedSettingsService
@@ -735,12 +754,15 @@ func (r jobResource) Create(ctx context.Context, req resource.CreateRequest, res
 	// Create new job
 	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on job create", err, jobErrorMappings)
 		return
 	}
 	cont, err := r.jobService.Create(ctx, plan.EnvironmentID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		savePartiallyCreatedState(ctx, resp, err, "job", func(created *job.Job) Job {
+			return convertDomainJobToJob(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on job create", err, jobErrorMappings)
 		return
 	}
 
@@ -795,12 +817,15 @@ func (r jobResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	// Update job in the backend
 	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on job update", err, jobErrorMappings)
 		return
 	}
 	cont, err := r.jobService.Update(ctx, state.ID.ValueString(), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job update", err.Error())
+		savePartiallyUpdatedState(ctx, resp, err, "job", func(updated *job.Job, report *variable.DiffApplyError) Job {
+			return convertDomainJobToJob(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on job update", err, jobErrorMappings)
 		return
 	}
 
@@ -841,4 +866,5 @@ func (r jobResource) ImportState(ctx context.Context, req resource.ImportStateRe
 
 func (r jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	warnUnknownAdvancedSettings(ctx, r.advancedSettingsService, domain.JOB, req.Config, &resp.Diagnostics)
+	validateAdvancedSettingsValues(ctx, r.advancedSettingsService, domain.JOB, req.Config, &resp.Diagnostics)
 }
@@ -287,6 +287,16 @@ type Job struct {
 	LabelssGroupIds              types.Set     `tfsdk:"labels_group_ids"`
 }
 
+// withAppliedSecrets returns the job with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (j Job) withAppliedSecrets(ctx context.Context, state Job, report *variable.DiffApplyError) Job {
+	j.Secrets = appliedSecrets(ctx, j.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	j.SecretAliases = appliedSecrets(ctx, j.SecretAliases, state.SecretAliases, report.AppliedKeys(variable.DiffKindAlias))
+	j.SecretOverrides = appliedSecrets(ctx, j.SecretOverrides, state.SecretOverrides, report.AppliedKeys(variable.DiffKindOverride))
+	j.SecretFiles = appliedSecrets(ctx, j.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return j
+}
+
 func (j Job) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(j.EnvironmentVariables)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
 // Ensure provider defined types fully satisfy terraform framework interfaces.
@@ -239,6 +240,7 @@ func (r projectResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"environment_variable_files": environmentVariableFilesSchemaAttribute("project"),
 			"secret_files":               secretFilesSchemaAttribute("project"),
+			"deletion_protection":        deletionProtectionResourceAttribute("project"),
 		},
 	}
 }
@@ -256,6 +258,9 @@ func (r projectResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	proj, err := r.projectService.Create(ctx, plan.OrganizationId.ValueString(), plan.toCreateServiceRequest())
 	if err != nil {
+		savePartiallyCreatedState(ctx, resp, err, "project", func(created *project.Project) Project {
+			return convertDomainProjectToProject(ctx, plan, created)
+		})
 		resp.Diagnostics.AddError("Error on project create", err.Error())
 		return
 	}
@@ -304,6 +309,9 @@ func (r projectResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update project in the backend
 	proj, err := r.projectService.Update(ctx, state.Id.ValueString(), plan.toUpdateServiceRequest(state))
 	if err != nil {
+		savePartiallyUpdatedState(ctx, resp, err, "project", func(updated *project.Project, report *variable.DiffApplyError) Project {
+			return convertDomainProjectToProject(ctx, plan.withAppliedSecrets(ctx, state, report), updated)
+		})
 		resp.Diagnostics.AddError("Error on project update", err.Error())
 		return
 	}
@@ -325,6 +333,10 @@ func (r projectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if checkDeletionProtection(&resp.Diagnostics, state.DeletionProtection, "project", state.Id.ValueString()) {
+		return
+	}
+
 	// Delete project
 	err := r.projectService.Delete(ctx, state.Id.ValueString())
 	if err != nil {
@@ -21,6 +21,16 @@ type Project struct {
 	SecretAliases               types.Set    `tfsdk:"secret_aliases"`
 	EnvironmentVariableFiles    types.Set    `tfsdk:"environment_variable_files"`
 	SecretFiles                 types.Set    `tfsdk:"secret_files"`
+	DeletionProtection          types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// withAppliedSecrets returns the project with the secrets of state for the keys that a partially applied update
+// did not apply, each set being filtered by the keys applied by its own diff.
+func (p Project) withAppliedSecrets(ctx context.Context, state Project, report *variable.DiffApplyError) Project {
+	p.Secrets = appliedSecrets(ctx, p.Secrets, state.Secrets, report.AppliedKeys(variable.DiffKindValue))
+	p.SecretAliases = appliedSecrets(ctx, p.SecretAliases, state.SecretAliases, report.AppliedKeys(variable.DiffKindAlias))
+	p.SecretFiles = appliedSecrets(ctx, p.SecretFiles, state.SecretFiles, report.AppliedKeys(variable.DiffKindFile))
+	return p
 }
 
 func (p Project) EnvironmentVariableList() EnvironmentVariableList {
@@ -94,5 +104,6 @@ func convertDomainProjectToProject(ctx context.Context, state Project, res *proj
 		SecretAliases:               convertDomainSecretsToSecretList(state.SecretAliases, res.Secrets, variable.ScopeProject, "ALIAS").toTerraformSet(ctx),
 		EnvironmentVariableFiles:    convertDomainVariablesToEnvironmentVariableFileListWithNullableInitialState(ctx, state.EnvironmentVariableFiles, res.EnvironmentVariables, variable.ScopeProject).toTerraformSet(ctx),
 		SecretFiles:                 convertDomainSecretsToSecretFileList(state.SecretFiles, res.Secrets, variable.ScopeProject).toTerraformSet(ctx),
+		DeletionProtection:          deletionProtectionFromState(state.DeletionProtection),
 	}
 }
@@ -5,6 +5,7 @@ package qovery_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -379,6 +380,40 @@ func TestAcc_ProjectImport(t *testing.T) {
 	})
 }
 
+func TestAcc_ProjectDeletionProtection(t *testing.T) {
+	t.Parallel()
+	testName := "project-deletion-protection"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryProjectDestroy("qovery_project.test"),
+		Steps: []resource.TestStep{
+			// Create a protected project
+			{
+				Config: testAccProjectDefaultConfigWithDeletionProtection(testName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryProjectExists("qovery_project.test"),
+					resource.TestCheckResourceAttr("qovery_project.test", "deletion_protection", "true"),
+				),
+			},
+			// Destroy is refused while the protection is enabled
+			{
+				Config:      testAccProjectDefaultConfigWithDeletionProtection(testName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			// Disable the protection so that the project can be destroyed
+			{
+				Config: testAccProjectDefaultConfigWithDeletionProtection(testName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryProjectExists("qovery_project.test"),
+					resource.TestCheckResourceAttr("qovery_project.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccQoveryProjectExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -441,6 +476,17 @@ resource "qovery_project" "test" {
 	)
 }
 
+func testAccProjectDefaultConfigWithDeletionProtection(testName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "qovery_project" "test" {
+  organization_id = "%s"
+  name = "%s"
+  deletion_protection = %t
+}
+`, getTestOrganizationID(), generateTestName(testName), deletionProtection,
+	)
+}
+
 func testAccProjectDefaultConfigWithEnvironmentVariables(testName string, environmentVariables map[string]string) string {
 	return fmt.Sprintf(`
 resource "qovery_project" "test" {
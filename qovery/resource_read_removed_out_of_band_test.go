@@ -10,27 +10,24 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/qovery"
 )
 
-// Out-of-band removal tests for client-layer resources.
+// Out-of-band removal tests for the application, database and cluster resources.
 //
-// These "disappears" acceptance tests delete the resource out-of-band (directly via the
-// Qovery API, bypassing Terraform) and then assert that the next refresh/plan does NOT
-// error: the resource must be dropped from state (handleReadNotFound → RemoveResource) so
+// These "disappears" acceptance tests delete the resource out-of-band (through the domain
+// services, bypassing Terraform) and then assert that the next refresh/plan does NOT error:
+// the resource must be dropped from state (handleDomainReadNotFound → RemoveResource) so
 // Terraform plans a re-create. `ExpectNonEmptyPlan: true` captures exactly that — the
 // post-apply refresh removes the resource and the plan becomes non-empty (a re-create).
 //
-// Before the fix, the out-of-band delete made Read return a hard diagnostic, so the
-// post-apply refresh errored and the step failed.
-//
-// Coverage note: cluster_dns_provider is the fourth client-layer resource. It has no standalone
+// Coverage note: cluster_dns_provider is still a client-layer resource. It has no standalone
 // acceptance scaffolding and no independent delete (it lives inside a cluster), so it is not
-// given a bespoke disappears test here. It calls the same handleReadNotFound helper as the
-// resources below, and that helper is exhaustively covered by the unit test in
-// read_not_found_test.go.
+// given a bespoke disappears test here. It calls handleReadNotFound, which is exhaustively
+// covered by the unit test in read_not_found_test.go.
 
 // TestAcc_DatabaseRemovedOutOfBand is runnable in the normal loop: a REDIS container
 // database is cheap and fast to provision.
@@ -53,9 +50,9 @@ func TestAcc_DatabaseRemovedOutOfBand(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_database.test", func(id string) *apierrors.APIError {
-			_, apiErr := apiClient.GetDatabase(context.TODO(), id)
-			return apiErr
+		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_database.test", func(id string) error {
+			_, err := qoveryServices.Database.Get(context.TODO(), id)
+			return err
 		}),
 		Steps: []resource.TestStep{
 			{
@@ -68,8 +65,8 @@ func TestAcc_DatabaseRemovedOutOfBand(t *testing.T) {
 				// Delete the database out-of-band, then expect the next plan to re-create it.
 				Config: GetDatabaseConfigFromModel(testName, dbModel),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccQoveryDisappears("qovery_database.test", func(id string) *apierrors.APIError {
-						return apiClient.DeleteDatabase(context.TODO(), id)
+					testAccQoveryDisappears("qovery_database.test", func(id string) error {
+						return qoveryServices.Database.Delete(context.TODO(), id)
 					}),
 				),
 				ExpectNonEmptyPlan: true,
@@ -86,9 +83,9 @@ func TestAcc_ApplicationRemovedOutOfBand(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_application.test", func(id string) *apierrors.APIError {
-			_, apiErr := apiClient.GetApplication(context.TODO(), id, "{}", false)
-			return apiErr
+		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_application.test", func(id string) error {
+			_, err := qoveryServices.Application.Get(context.TODO(), id, "{}", false)
+			return err
 		}),
 		Steps: []resource.TestStep{
 			{
@@ -100,8 +97,8 @@ func TestAcc_ApplicationRemovedOutOfBand(t *testing.T) {
 			{
 				Config: testAccApplicationDefaultConfig(testName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccQoveryDisappears("qovery_application.test", func(id string) *apierrors.APIError {
-						return apiClient.DeleteApplication(context.TODO(), id)
+					testAccQoveryDisappears("qovery_application.test", func(id string) error {
+						return qoveryServices.Application.Delete(context.TODO(), id)
 					}),
 				),
 				ExpectNonEmptyPlan: true,
@@ -119,9 +116,9 @@ func TestAcc_ClusterRemovedOutOfBand(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_cluster.test", func(id string) *apierrors.APIError {
-			_, apiErr := apiClient.GetCluster(context.TODO(), getTestOrganizationID(), id, "{}", false)
-			return apiErr
+		CheckDestroy: testAccCheckRemovedOutOfBand("qovery_cluster.test", func(id string) error {
+			_, err := qoveryServices.Cluster.Get(context.TODO(), getTestOrganizationID(), id, "{}", false)
+			return err
 		}),
 		Steps: []resource.TestStep{
 			{
@@ -133,8 +130,8 @@ func TestAcc_ClusterRemovedOutOfBand(t *testing.T) {
 			{
 				Config: testAccClusterConfigWithKeda(testName, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccQoveryDisappears("qovery_cluster.test", func(id string) *apierrors.APIError {
-						return apiClient.DeleteCluster(context.TODO(), getTestOrganizationID(), id)
+					testAccQoveryDisappears("qovery_cluster.test", func(id string) error {
+						return qoveryServices.Cluster.Delete(context.TODO(), getTestOrganizationID(), id)
 					}),
 				),
 				ExpectNonEmptyPlan: true,
@@ -145,35 +142,35 @@ func TestAcc_ClusterRemovedOutOfBand(t *testing.T) {
 
 // testAccQoveryDisappears deletes a resource out-of-band via the given delete closure,
 // mirroring the get-closure shape already used by testAccCheckRemovedOutOfBand.
-func testAccQoveryDisappears(resourceName string, del func(id string) *apierrors.APIError) resource.TestCheckFunc {
+func testAccQoveryDisappears(resourceName string, del func(id string) error) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok || rs.Primary.ID == "" {
 			return fmt.Errorf("%s: id not found in state", resourceName)
 		}
-		if apiErr := del(rs.Primary.ID); apiErr != nil {
-			return fmt.Errorf("%s: failed to delete out-of-band: %s", resourceName, apiErr.Detail())
+		if err := del(rs.Primary.ID); err != nil {
+			return fmt.Errorf("%s: failed to delete out-of-band: %s", resourceName, err.Error())
 		}
 		return nil
 	}
 }
 
 // testAccCheckRemovedOutOfBand is the CheckDestroy for the "disappears" tests. The resource
-// is deleted out-of-band mid-test and then dropped from Terraform state by handleReadNotFound,
+// is deleted out-of-band mid-test and then dropped from Terraform state by handleDomainReadNotFound,
 // so its absence from state is the expected clean end-state — not a dangling-resource failure.
 // When the resource is still tracked in state, it confirms the API reports it as deleted.
-func testAccCheckRemovedOutOfBand(resourceName string, get func(id string) *apierrors.APIError) resource.TestCheckFunc {
+func testAccCheckRemovedOutOfBand(resourceName string, get func(id string) error) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok || rs.Primary.ID == "" {
 			return nil
 		}
-		apiErr := get(rs.Primary.ID)
-		if apiErr == nil {
+		err := get(rs.Primary.ID)
+		if err == nil {
 			return fmt.Errorf("%s: found resource but expected it to be deleted", resourceName)
 		}
-		if !apierrors.IsNotFound(apiErr) {
-			return fmt.Errorf("%s: unexpected error checking deletion: %s", resourceName, apiErr.Summary())
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("%s: unexpected error checking deletion: %s", resourceName, err.Error())
 		}
 		return nil
 	}
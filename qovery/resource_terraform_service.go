@@ -424,14 +424,17 @@ func (r terraformServiceResource) Create(ctx context.Context, req resource.Creat
 	// Create API request from plan
 	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on terraform service create", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on terraform service create", err, nil)
 		return
 	}
 
 	// Create new terraform service
 	terraformSvc, err := r.terraformServiceService.Create(ctx, ToString(plan.EnvironmentID), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on terraform service create", err.Error())
+		savePartiallyCreatedState(ctx, resp, err, "terraform service", func(created *terraformservice.TerraformService) TerraformService {
+			return convertDomainTerraformServiceToTerraformService(ctx, plan, created)
+		})
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on terraform service create", err, nil)
 		return
 	}
 
@@ -484,14 +487,14 @@ func (r terraformServiceResource) Update(ctx context.Context, req resource.Updat
 	// Create API request from plan
 	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on terraform service update", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on terraform service update", err, nil)
 		return
 	}
 
 	// Update terraform service
 	terraformSvc, err := r.terraformServiceService.Update(ctx, ToString(state.ID), *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on terraform service update", err.Error())
+		addErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, "Error on terraform service update", err, nil)
 		return
 	}
 
@@ -527,6 +530,7 @@ func (r terraformServiceResource) ImportState(ctx context.Context, req resource.
 
 func (r terraformServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	warnUnknownAdvancedSettings(ctx, r.advancedSettingsService, domain.TERRAFORM, req.Config, &resp.Diagnostics)
+	validateAdvancedSettingsValues(ctx, r.advancedSettingsService, domain.TERRAFORM, req.Config, &resp.Diagnostics)
 	// Prevent storage reduction
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
@@ -6,9 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
@@ -91,36 +89,6 @@ func (ss SecretFileList) diffRequest(old SecretFileList) secret.DiffRequest {
 	return diff
 }
 
-func (ss SecretFileList) diff(old SecretFileList) client.SecretsDiff {
-	diff := client.SecretsDiff{
-		Create: []client.SecretCreateRequest{},
-		Update: []client.SecretUpdateRequest{},
-		Delete: []client.SecretDeleteRequest{},
-	}
-
-	for _, s := range old {
-		if updatedVar := ss.find(ToString(s.Key)); updatedVar != nil {
-			if updatedVar.MountPath != s.MountPath {
-				// mount_path changed — delete + recreate
-				diff.Delete = append(diff.Delete, client.SecretDeleteRequest{Id: ToString(s.Id)})
-				diff.Create = append(diff.Create, updatedVar.toCreateRequest())
-			} else if updatedVar.Value != s.Value || updatedVar.Description != s.Description {
-				diff.Update = append(diff.Update, s.toUpdateRequest(*updatedVar))
-			}
-		} else {
-			diff.Delete = append(diff.Delete, client.SecretDeleteRequest{Id: ToString(s.Id)})
-		}
-	}
-
-	for _, s := range ss {
-		if !old.contains(s) {
-			diff.Create = append(diff.Create, s.toCreateRequest())
-		}
-	}
-
-	return diff
-}
-
 type SecretFile struct {
 	Id          types.String `tfsdk:"id"`
 	Key         types.String `tfsdk:"key"`
@@ -144,21 +112,6 @@ func (s SecretFile) toTerraformObject() types.Object {
 	return terraformObjectValue
 }
 
-func (s SecretFile) toCreateRequest() client.SecretCreateRequest {
-	req := client.SecretCreateRequest{
-		SecretRequest: qovery.SecretRequest{
-			Key:         ToString(s.Key),
-			Value:       ToStringPointer(s.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(s.Description)),
-		},
-	}
-	mountPath := ToString(s.MountPath)
-	if mountPath != "" {
-		req.SecretRequest.MountPath = *qovery.NewNullableString(&mountPath)
-	}
-	return req
-}
-
 func (s SecretFile) toDiffCreateRequest() secret.DiffCreateRequest {
 	return secret.DiffCreateRequest{
 		UpsertRequest: secret.UpsertRequest{
@@ -170,18 +123,6 @@ func (s SecretFile) toDiffCreateRequest() secret.DiffCreateRequest {
 	}
 }
 
-func (s SecretFile) toUpdateRequest(new SecretFile) client.SecretUpdateRequest {
-	// SecretEditRequest does NOT have MountPath — only set key/value/description
-	return client.SecretUpdateRequest{
-		Id: ToString(s.Id),
-		SecretEditRequest: qovery.SecretEditRequest{
-			Key:         ToString(s.Key),
-			Value:       ToStringPointer(new.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(new.Description)),
-		},
-	}
-}
-
 func (s SecretFile) toDiffUpdateRequest(new SecretFile) secret.DiffUpdateRequest {
 	return secret.DiffUpdateRequest{
 		SecretID: ToString(s.Id),
@@ -197,6 +138,7 @@ func (s SecretFile) toDiffUpdateRequest(new SecretFile) secret.DiffUpdateRequest
 func (s SecretFile) toDiffDeleteRequest() secret.DiffDeleteRequest {
 	return secret.DiffDeleteRequest{
 		SecretID: ToString(s.Id),
+		Key:      ToString(s.Key),
 	}
 }
 
@@ -266,42 +208,3 @@ func convertDomainSecretToSecretFile(s secret.Secret, state *SecretFile) SecretF
 	}
 	return sec
 }
-
-func fromSecretFileList(initialState types.Set, secrets []*qovery.Secret, scope qovery.APIVariableScopeEnum, secretType string) SecretFileList {
-	stateList := toSecretFileList(initialState)
-
-	list := make([]SecretFile, 0, len(secrets))
-	for _, s := range secrets {
-		if s.Scope != scope || string(*s.VariableType) != secretType {
-			continue
-		}
-		state := stateList.find(s.GetKey())
-		sec := SecretFile{
-			Id:          FromString(s.Id),
-			Key:         FromString(s.Key),
-			Description: FromNullableString(s.Description),
-		}
-		if state != nil {
-			// Preserve Value from state (Secret API doesn't return values)
-			sec.Value = state.Value
-			if state.Description.IsNull() && !initialState.IsNull() {
-				sec.Description = basetypes.NewStringNull()
-			}
-			// CRITICAL: qovery.Secret does NOT have MountPath — must come from state
-			sec.MountPath = state.MountPath
-		} else {
-			// qovery.Secret has no MountPath field — on import, mount_path will need to be
-			// re-specified in config. This is a known limitation of the Secret API.
-			sec.MountPath = FromString("")
-		}
-
-		list = append(list, sec)
-	}
-
-	// Return nil only if list is empty and original state list is nil
-	if len(list) == 0 && initialState.IsNull() {
-		return nil
-	}
-	// Otherwise return the list, even empty (`[]` in the terraform file)
-	return list
-}
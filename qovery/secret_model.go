@@ -7,9 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
@@ -61,32 +59,6 @@ func (ss SecretList) find(key string) *Secret {
 	return nil
 }
 
-func (ss SecretList) diff(old SecretList) client.SecretsDiff {
-	diff := client.SecretsDiff{
-		Create: []client.SecretCreateRequest{},
-		Update: []client.SecretUpdateRequest{},
-		Delete: []client.SecretDeleteRequest{},
-	}
-
-	for _, s := range old {
-		if updatedVar := ss.find(ToString(s.Key)); updatedVar != nil {
-			if updatedVar.Value != s.Value {
-				diff.Update = append(diff.Update, s.toUpdateRequest(*updatedVar))
-			}
-		} else {
-			diff.Delete = append(diff.Delete, s.toDeleteRequest())
-		}
-	}
-
-	for _, s := range ss {
-		if !old.contains(s) {
-			diff.Create = append(diff.Create, s.toCreateRequest())
-		}
-	}
-
-	return diff
-}
-
 func (ss SecretList) diffRequest(old SecretList) secret.DiffRequest {
 	diff := secret.DiffRequest{
 		Create: []secret.DiffCreateRequest{},
@@ -134,16 +106,6 @@ func (s Secret) toTerraformObject() types.Object {
 	return terraformObjectValue
 }
 
-func (s Secret) toCreateRequest() client.SecretCreateRequest {
-	return client.SecretCreateRequest{
-		SecretRequest: qovery.SecretRequest{
-			Key:         ToString(s.Key),
-			Value:       ToStringPointer(s.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(s.Description)),
-		},
-	}
-}
-
 func (s Secret) toDiffCreateRequest() secret.DiffCreateRequest {
 	return secret.DiffCreateRequest{
 		UpsertRequest: secret.UpsertRequest{
@@ -154,17 +116,6 @@ func (s Secret) toDiffCreateRequest() secret.DiffCreateRequest {
 	}
 }
 
-func (s Secret) toUpdateRequest(new Secret) client.SecretUpdateRequest {
-	return client.SecretUpdateRequest{
-		Id: ToString(s.Id),
-		SecretEditRequest: qovery.SecretEditRequest{
-			Key:         ToString(s.Key),
-			Value:       ToStringPointer(new.Value),
-			Description: *qovery.NewNullableString(ToStringPointer(s.Description)),
-		},
-	}
-}
-
 func (s Secret) toDiffUpdateRequest(new Secret) secret.DiffUpdateRequest {
 	return secret.DiffUpdateRequest{
 		SecretID: ToString(s.Id),
@@ -176,57 +127,13 @@ func (s Secret) toDiffUpdateRequest(new Secret) secret.DiffUpdateRequest {
 	}
 }
 
-func (s Secret) toDeleteRequest() client.SecretDeleteRequest {
-	return client.SecretDeleteRequest{
-		Id: ToString(s.Id),
-	}
-}
-
 func (s Secret) toDiffDeleteRequest() secret.DiffDeleteRequest {
 	return secret.DiffDeleteRequest{
 		SecretID: ToString(s.Id),
+		Key:      ToString(s.Key),
 	}
 }
 
-func fromSecret(v *qovery.Secret, state *Secret) Secret {
-	sec := Secret{
-		Id:          FromString(v.Id),
-		Key:         FromString(v.Key),
-		Description: FromNullableString(v.Description),
-	}
-	if state != nil {
-		sec.Value = state.Value
-		if state.Description.IsNull() {
-			sec.Description = basetypes.NewStringNull()
-		}
-	}
-	return sec
-}
-
-func fromSecretList(initialState types.Set, secrets []*qovery.Secret, scope qovery.APIVariableScopeEnum, secretType string) SecretList {
-	stateByKey := make(map[string]Secret)
-	state := ToSecretList(initialState)
-
-	for _, s := range state {
-		stateByKey[ToString(s.Key)] = s
-	}
-
-	list := make([]Secret, 0, len(secrets))
-	for _, s := range secrets {
-		if s.Scope != scope || string(*s.VariableType) != secretType {
-			continue
-		}
-		list = append(list, fromSecret(s, state.find(s.GetKey())))
-	}
-
-	// Return nil only if list is empty and original state list is nil
-	if len(list) == 0 && initialState.IsNull() {
-		return nil
-	}
-	// Otherwise return the list, even empty (`[]` in the terraform file)
-	return list
-}
-
 func convertDomainSecretsToSecretList(initialState types.Set, secrets secret.Secrets, scope variable.Scope, variableType string) SecretList {
 	stateByKey := make(map[string]Secret)
 	state := ToSecretList(initialState)
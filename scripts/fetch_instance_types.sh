@@ -1,5 +1,43 @@
 #!/bin/bash
+# Refreshes the cluster instance types catalog embedded in the provider, keyed by cloud provider and region.
+set -o errexit
 set -o pipefail
-curl -f -s -H "Accept: application/json" -H "Authorization: Token $QOVERY_API_TOKEN" https://api.qovery.com/aws/instanceType | jq "[.results[] | .type] | sort" | jq -rc tostring | tr -d '\n' > qovery/data/cluster_instance_types/aws.json
-curl -f -s -H "Accept: application/json" -H "Authorization: Token $QOVERY_API_TOKEN" https://api.qovery.com/digitalOcean/instanceType | jq "[.results[] | .type] | sort" | jq -rc tostring | tr -d '\n' > qovery/data/cluster_instance_types/digital_ocean.json
-curl -f -s -H "Accept: application/json" -H "Authorization: Token $QOVERY_API_TOKEN" https://api.qovery.com/scaleway/instanceType | jq "[.results[] | .type] | sort" | jq -rc tostring | tr -d '\n' > qovery/data/cluster_instance_types/scaleway.json
+
+API_URL="https://api.qovery.com"
+OUTPUT_DIR="qovery/data/cluster_instance_types"
+
+function api() {
+  curl -f -s -H "Accept: application/json" -H "Authorization: Token $QOVERY_API_TOKEN" "$API_URL$1"
+}
+
+# fetch_catalog <output file> <instance types path> <location>...
+# Writes the instance types of each location into the output file, as {"<location>": [{"type", "cpu", "ram_in_gb", "architecture"}]}.
+function fetch_catalog() {
+  local output=$1
+  local path=$2
+  shift 2
+
+  local catalog="{}"
+  for location in "$@"; do
+    local instance_types
+    instance_types=$(api "$path/$location" | jq '[.results[] | {type, cpu, ram_in_gb, architecture: (.architecture // "AMD64")}] | sort_by(.type)')
+    catalog=$(jq --arg location "$location" --argjson instance_types "$instance_types" '. + {($location): $instance_types}' <<<"$catalog")
+  done
+
+  jq -S . <<<"$catalog" > "$OUTPUT_DIR/$output"
+}
+
+mkdir -p "$OUTPUT_DIR"
+
+mapfile -t aws_regions < <(api /aws/region | jq -r '.results[].name')
+fetch_catalog aws.json /aws/eks/instanceType "${aws_regions[@]}"
+
+mapfile -t gcp_regions < <(api /gcp/region | jq -r '.results[].name')
+fetch_catalog gcp.json /gcp/instanceType "${gcp_regions[@]}"
+
+# Scaleway clusters are deployed in a zone rather than a region.
+mapfile -t scaleway_zones < <(api /scaleway/region | jq -r '.results[].zones[]')
+fetch_catalog scaleway.json /scaleway/instanceType "${scaleway_zones[@]}"
+
+mapfile -t azure_regions < <(api /azure/region | jq -r '.results[].name')
+fetch_catalog azure.json /azure/aks/instanceType "${azure_regions[@]}"